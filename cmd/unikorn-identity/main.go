@@ -29,11 +29,10 @@ import (
 
 	"github.com/spf13/pflag"
 
-	"github.com/unikorn-cloud/core/pkg/client"
-	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/identity/pkg/constants"
 	"github.com/unikorn-cloud/identity/pkg/server"
 
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -63,14 +62,22 @@ func start() {
 		return
 	}
 
-	client, err := client.New(ctx, unikornv1.AddToScheme)
+	// This uses the usual kubeconfig resolution, so works both in and out of cluster.
+	config, err := config.GetConfig()
+	if err != nil {
+		logger.Error(err, "failed to get client configuration")
+
+		return
+	}
+
+	client, informers, err := s.GetClient(ctx, config)
 	if err != nil {
 		logger.Error(err, "failed to create client")
 
 		return
 	}
 
-	server, err := s.GetServer(client)
+	server, err := s.GetServer(ctx, config, client, informers)
 	if err != nil {
 		logger.Error(err, "failed to setup Handler")
 
@@ -58,6 +58,11 @@ type GroupSpec struct {
 	ServiceAccountIDs []string `json:"serviceAccountIDs,omitempty"`
 	// RoleIDs are a list of roles users of the group inherit.
 	RoleIDs []string `json:"roleIDs,omitempty"`
+	// ProviderGroups are a list of identity provider groups that map to
+	// this group e.g. Google group email addresses, Microsoft Entra group
+	// object IDs or GitHub teams as "organization/team-slug".  When set,
+	// user membership is synchronized with the identity provider at login.
+	ProviderGroups []string `json:"providerGroups,omitempty"`
 }
 
 // GroupStatus defines the status of the group.
@@ -18,7 +18,7 @@ package v1alpha1
 
 import (
 	"errors"
-	"slices"
+	"time"
 
 	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
 
@@ -55,14 +55,45 @@ func (c *OAuth2Client) ResourceLabels() (labels.Set, error) {
 	return nil, nil
 }
 
-func (u *User) Session(clientID string) (*UserSession, error) {
-	index := slices.IndexFunc(u.Spec.Sessions, func(session UserSession) bool {
-		return session.ClientID == clientID
-	})
+// SecretName returns the name of the Kubernetes secret the client secret is
+// written to.
+func (c *OAuth2Client) SecretName() string {
+	if c.Spec.SecretName != nil {
+		return *c.Spec.SecretName
+	}
+
+	return c.Name
+}
+
+// SecretHashes returns the hashes of all secrets that are currently valid for
+// the client.
+func (c *OAuth2Client) SecretHashes() []string {
+	var hashes []string
+
+	if c.Status.SecretHash != "" {
+		hashes = append(hashes, c.Status.SecretHash)
+	}
+
+	for _, secret := range c.Status.PreviousSecrets {
+		if time.Now().Before(secret.Expiry.Time) {
+			hashes = append(hashes, secret.Hash)
+		}
+	}
+
+	return hashes
+}
+
+// NextSecretExpiry returns when the next previous secret will expire, if any.
+func (c *OAuth2Client) NextSecretExpiry() *time.Time {
+	var next *time.Time
+
+	for i := range c.Status.PreviousSecrets {
+		expiry := c.Status.PreviousSecrets[i].Expiry.Time
 
-	if index < 0 {
-		return nil, ErrReference
+		if next == nil || expiry.Before(*next) {
+			next = &expiry
+		}
 	}
 
-	return &u.Spec.Sessions[index], nil
+	return next
 }
@@ -83,6 +83,12 @@ type OrganizationProviderOptions struct {
 	// If the referenced provider is set to "google" then the following
 	// parameters should be specified.
 	Google *OrganizationProviderGoogleSpec `json:"google,omitempty"`
+	// If the referenced provider is set to "microsoft" then the following
+	// parameters should be specified.
+	Microsoft *OrganizationProviderMicrosoftSpec `json:"microsoft,omitempty"`
+	// If the referenced provider is set to "github" then the following
+	// parameters should be specified.
+	GitHub *OrganizationProviderGitHubSpec `json:"github,omitempty"`
 }
 
 type OrganizationProviderGoogleSpec struct {
@@ -92,6 +98,22 @@ type OrganizationProviderGoogleSpec struct {
 	CustomerID *string `json:"customerId,omitempty"`
 }
 
+type OrganizationProviderMicrosoftSpec struct {
+	// TenantID is retrieved from the "Overview" page of Microsoft Entra ID
+	// on https://entra.microsoft.com for your organisation and is required
+	// to lookup user security groups for fine-grained RBAC.
+	TenantID *string `json:"tenantId,omitempty"`
+}
+
+type OrganizationProviderGitHubSpec struct {
+	// Organization is the login name of the GitHub organization.  When set,
+	// only active members of the GitHub organization may login, and teams
+	// within it may be mapped to groups as "organization/team-slug".  Members
+	// of the organization must login via its email domain, so that membership
+	// of the GitHub organization can be checked, other logins are denied.
+	Organization *string `json:"organization,omitempty"`
+}
+
 // OrganizationStatus defines the status of the server.
 type OrganizationStatus struct {
 	// Namespace defines the namespace an organization's child resources reside in.
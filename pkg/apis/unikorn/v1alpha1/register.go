@@ -55,6 +55,9 @@ func init() {
 	SchemeBuilder.Register(&Project{}, &ProjectList{})
 	SchemeBuilder.Register(&SigningKey{}, &SigningKeyList{})
 	SchemeBuilder.Register(&User{}, &UserList{})
+	SchemeBuilder.Register(&UserSession{}, &UserSessionList{})
+	SchemeBuilder.Register(&DeviceAuthorization{}, &DeviceAuthorizationList{})
+	SchemeBuilder.Register(&OAuth2ClientAssertion{}, &OAuth2ClientAssertionList{})
 	SchemeBuilder.Register(&OrganizationUser{}, &OrganizationUserList{})
 	SchemeBuilder.Register(&ServiceAccount{}, &ServiceAccountList{})
 	SchemeBuilder.Register(&QuotaMetadata{}, &QuotaMetadataList{})
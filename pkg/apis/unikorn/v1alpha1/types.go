@@ -26,13 +26,35 @@ import (
 
 // IdentityProviderType defines the type of identity provider, and in turn
 // that defines the required configuration and API interfaces.
-// +kubebuilder:validation:Enum=custom;google;microsoft;github
+// +kubebuilder:validation:Enum=custom;google;microsoft;github;saml
 type IdentityProviderType string
 
 const (
 	GoogleIdentity IdentityProviderType = "google"
 	MicrosoftEntra IdentityProviderType = "microsoft"
 	GitHub         IdentityProviderType = "github"
+	// SAML providers federate with a SAML 2.0 identity provider rather
+	// than an OAuth2 one.
+	SAML IdentityProviderType = "saml"
+)
+
+// OAuth2ClientAuthenticationMethod defines how a client authenticates with
+// the token endpoint.
+// +kubebuilder:validation:Enum=client_secret_basic;client_secret_post;client_secret_jwt;private_key_jwt
+type OAuth2ClientAuthenticationMethod string
+
+const (
+	ClientSecretBasic OAuth2ClientAuthenticationMethod = "client_secret_basic"
+	ClientSecretPost  OAuth2ClientAuthenticationMethod = "client_secret_post"
+	ClientSecretJWT   OAuth2ClientAuthenticationMethod = "client_secret_jwt"
+	PrivateKeyJWT     OAuth2ClientAuthenticationMethod = "private_key_jwt"
+)
+
+const (
+	// OAuth2ClientIDKey is the client secret key containing the client ID.
+	OAuth2ClientIDKey = "client-id"
+	// OAuth2ClientSecretKey is the client secret key containing the client secret.
+	OAuth2ClientSecretKey = "client-secret"
 )
 
 // OAuth2ClientList is a typed list of frontend clients.
@@ -50,7 +72,6 @@ type OAuth2ClientList struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="display name",type="string",JSONPath=".metadata.labels['unikorn-cloud\\.org/name']"
 // +kubebuilder:printcolumn:name="redirect uri",type="string",JSONPath=".spec.redirectUri"
-// +kubebuilder:printcolumn:name="secret",type="string",JSONPath=".status.secret"
 // +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
 type OAuth2Client struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -64,7 +85,26 @@ type OAuth2ClientSpec struct {
 	// Tags are aribrary user data.
 	Tags unikornv1core.TagList `json:"tags,omitempty"`
 	// RedirectURI is the URI to pass control back to the client.
-	RedirectURI string `json:"redirectUri"`
+	// Deprecated: use RedirectURIs.
+	RedirectURI string `json:"redirectUri,omitempty"`
+	// RedirectURIs are the URIs the client may ask to pass control back to,
+	// these must match exactly.
+	RedirectURIs []string `json:"redirectUris,omitempty"`
+	// RedirectURIPatterns opt in to matching redirect URIs with a pattern.
+	// A loopback IP literal without a port matches any port, for native
+	// applications as defined by RFC 8252 e.g. "http://127.0.0.1/callback".
+	// A wildcard leftmost host label matches exactly one label, for preview
+	// environments e.g. "https://*.preview.acme.org/callback".
+	RedirectURIPatterns []string `json:"redirectUriPatterns,omitempty"`
+	// RequirePushedAuthorizationRequests rejects authorization requests
+	// that haven't been pushed to the server by the client first.
+	RequirePushedAuthorizationRequests bool `json:"requirePushedAuthorizationRequests,omitempty"`
+	// PostLogoutRedirectURIs are the URIs the client may pass control back to
+	// after RP-initiated logout.
+	PostLogoutRedirectURIs []string `json:"postLogoutRedirectUris,omitempty"`
+	// BackchannelLogoutURI is where to send logout tokens when a user's
+	// session is terminated by the server.
+	BackchannelLogoutURI *string `json:"backchannelLogoutUri,omitempty"`
 	// HomeURI is a URI to pass control to get to the console.
 	HomeURI *string `json:"homeUri,omitempty"`
 	// LoginURI is a URI to pass control to for login dialogs.
@@ -73,12 +113,74 @@ type OAuth2ClientSpec struct {
 	ErrorURI *string `json:"errorUri,omitempty"`
 	// OnboardingURI is a URI to pass control to for the onboarding dialogs.
 	OnboardingURI *string `json:"onboardingUri,omitempty"`
+	// JWKS is an inline JSON web key set used to verify JWTs signed by the
+	// client e.g. request objects and private_key_jwt client assertions.
+	JWKS *string `json:"jwks,omitempty"`
+	// JWKSURI is where to fetch the client's JSON web key set from, this
+	// is ignored if JWKS is set.
+	JWKSURI *string `json:"jwksUri,omitempty"`
+	// TokenEndpointAuthMethod restricts how the client may authenticate with
+	// the token endpoint, if not set any method is accepted.
+	TokenEndpointAuthMethod *OAuth2ClientAuthenticationMethod `json:"tokenEndpointAuthMethod,omitempty"`
+	// Registration is set when the client was created via dynamic client
+	// registration, and allows the registrant to manage it.
+	Registration *OAuth2ClientRegistration `json:"registration,omitempty"`
+	// SecretGeneration requests a secret rotation when it is incremented
+	// beyond the generation recorded in the status.
+	SecretGeneration int64 `json:"secretGeneration,omitempty"`
+	// SecretOverlap is how long previous secrets remain valid after a
+	// rotation, allowing clients to be updated without downtime.  This
+	// defaults to 24 hours.
+	SecretOverlap *metav1.Duration `json:"secretOverlap,omitempty"`
+	// SecretName is the Kubernetes secret, in the same namespace, that
+	// the plain text client secret is written to for consumption by
+	// the client.  This defaults to the client's name.
+	SecretName *string `json:"secretName,omitempty"`
+	// AccessTokenLifetime overrides the server's default access token
+	// lifetime for this client.
+	AccessTokenLifetime *metav1.Duration `json:"accessTokenLifetime,omitempty"`
+	// RefreshTokenLifetime overrides the server's default refresh token
+	// lifetime for this client, this cannot exceed the server's default
+	// as that is bounded by signing key rotation.
+	RefreshTokenLifetime *metav1.Duration `json:"refreshTokenLifetime,omitempty"`
+	// RefreshTokenIdleTimeout is how long a refresh token can go unused
+	// before it expires, and the user must log in again.
+	RefreshTokenIdleTimeout *metav1.Duration `json:"refreshTokenIdleTimeout,omitempty"`
+	// AbsoluteSessionLifetime is the maximum time a session can last from
+	// when the user logged in, regardless of refresh token rotation, after
+	// which the user must log in again.
+	AbsoluteSessionLifetime *metav1.Duration `json:"absoluteSessionLifetime,omitempty"`
+}
+
+// OAuth2ClientRegistration records dynamic client registration state.
+type OAuth2ClientRegistration struct {
+	// AccessTokenID identifies the current registration access token, any
+	// other token is rejected.
+	AccessTokenID string `json:"accessTokenId"`
+}
+
+// OAuth2ClientSecret is a client secret that has been rotated out.
+type OAuth2ClientSecret struct {
+	// Hash is the argon2id hash of the client secret.
+	Hash string `json:"hash"`
+	// Expiry is when the secret is no longer valid.
+	Expiry metav1.Time `json:"expiry"`
 }
 
 // OAuth2ClientStatus defines the status of the client.
 type OAuth2ClientStatus struct {
 	// Secret is the generated client secret.
+	// Deprecated: secrets are stored as a hash, and this is migrated by
+	// the controller.
 	Secret string `json:"secret,omitempty"`
+	// SecretHash is the argon2id hash of the generated client secret,
+	// the secret itself is written to a Kubernetes secret.
+	SecretHash string `json:"secretHash,omitempty"`
+	// SecretGeneration is the secret generation that has been provisioned.
+	SecretGeneration int64 `json:"secretGeneration,omitempty"`
+	// PreviousSecrets are secrets that have been rotated out, but are still
+	// valid until they expire.
+	PreviousSecrets []OAuth2ClientSecret `json:"previousSecrets,omitempty"`
 	// Current service state of the resource.
 	Conditions []unikornv1core.Condition `json:"conditions,omitempty"`
 }
@@ -120,6 +222,7 @@ type OAuth2ProviderSpec struct {
 	// The issuer is typically provided by the identity provider as an
 	// OIDC discovery endpoint e.g. https://accounts.google.com.
 	// This will be used to verify issued JWTs have the same "iss" claim.
+	// For SAML providers this is the identity provider's metadata URL.
 	Issuer string `json:"issuer"`
 	// ClientID is the assigned client identifier.
 	ClientID string `json:"clientID"`
@@ -129,6 +232,45 @@ type OAuth2ProviderSpec struct {
 	AuthorizationURI *string `json:"authorizatonURI,omitempty"`
 	// TokenURI is used when OIDC (discovery) is not available.
 	TokenURI *string `json:"tokenURI,omitempty"`
+	// APIBaseURI is used by providers that require API access to retrieve
+	// user information when OIDC is not available.  For example, GitHub
+	// Enterprise Server would use https://github.example.com/api/v3.
+	APIBaseURI *string `json:"apiBaseURI,omitempty"`
+	// GroupMappings grant group membership based on ID token claims at
+	// login.  These only apply to organization scoped providers, and
+	// reference groups in the same organization.
+	GroupMappings []OAuth2ProviderGroupMapping `json:"groupMappings,omitempty"`
+	// SAML defines SAML specific options.
+	SAML *OAuth2ProviderSAMLSpec `json:"saml,omitempty"`
+}
+
+// OAuth2ProviderSAMLSpec defines how SAML assertion attributes are mapped
+// onto user information.  When not specified, common attribute names and
+// ADFS claim URIs are tried in turn.
+type OAuth2ProviderSAMLSpec struct {
+	// EmailAttribute is the attribute containing the user's email address.
+	// When not specified, and no default attribute is present, the subject
+	// is used if it is an email address.
+	EmailAttribute *string `json:"emailAttribute,omitempty"`
+	// NameAttribute is the attribute containing the user's display name.
+	NameAttribute *string `json:"nameAttribute,omitempty"`
+	// GivenNameAttribute is the attribute containing the user's given name.
+	GivenNameAttribute *string `json:"givenNameAttribute,omitempty"`
+	// FamilyNameAttribute is the attribute containing the user's family name.
+	FamilyNameAttribute *string `json:"familyNameAttribute,omitempty"`
+}
+
+// OAuth2ProviderGroupMapping maps an ID token claim value to a group.
+type OAuth2ProviderGroupMapping struct {
+	// Claim is the path to the claim in the ID token, nested claims
+	// are separated by periods e.g. "realm_access.roles".
+	Claim string `json:"claim"`
+	// Value must equal the claim, or be a member of it when the claim
+	// is a list.
+	Value string `json:"value"`
+	// GroupID is the group users are added to when the claim matches,
+	// and removed from when none of the group's mappings match.
+	GroupID string `json:"groupID"`
 }
 
 // OAuth2ProviderStatus defines the status of the server.
@@ -294,9 +436,10 @@ type UserSpec struct {
 	// Signup is set when the user is being verified.
 	Signup *UserSignup `json:"signup,omitempty"`
 	// Sessions record active user sessions.
-	// +listType=map
-	// +listMapKey=clientID
-	Sessions []UserSession `json:"sessions,omitempty"`
+	// Deprecated: sessions are stored as UserSession resources, and these
+	// are migrated automatically.
+	// +listType=atomic
+	Sessions []UserSessionSpec `json:"sessions,omitempty"`
 }
 
 type UserSignup struct {
@@ -310,9 +453,45 @@ type UserSignup struct {
 	ClientID string `json:"clientID"`
 }
 
+// UserSessionList is a typed list of user sessions.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type UserSessionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserSession `json:"items"`
+}
+
+// UserSession records an active session for a user with a client, a user may
+// have multiple sessions per client e.g. when logged in on multiple devices.
+// Sessions are updated on every token issue and refresh, so are kept separate
+// from the user.  Sessions are linked to their user with a label.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="user",type="string",JSONPath=".metadata.labels['unikorn-cloud\\.org/user']"
+// +kubebuilder:printcolumn:name="client",type="string",JSONPath=".spec.clientID"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories=unikorn
 type UserSession struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              UserSessionSpec `json:"spec"`
+}
+
+type UserSessionSpec struct {
+	// ID uniquely identifies the session, and is carried in the tokens
+	// issued for it.  Sessions created before this was introduced have
+	// no ID, and are unique per client.
+	ID string `json:"id,omitempty"`
 	// ClientID is the client the session is bound to.
 	ClientID string `json:"clientID"`
+	// Created is when the session was created.
+	Created *metav1.Time `json:"created,omitempty"`
+	// Issued is when tokens were last issued for the session.
+	Issued *metav1.Time `json:"issued,omitempty"`
+	// OAuth2Provider is the provider the user authenticated with.
+	OAuth2Provider string `json:"oauth2Provider,omitempty"`
+	// SourceIP is the address the user logged in from.
+	SourceIP string `json:"sourceIP,omitempty"`
 	// AuthorizationCodeID is the authorization code ID used to generate
 	// the tokens.
 	AuthorizationCodeID string `json:"authorizationCodeID"`
@@ -322,11 +501,118 @@ type UserSession struct {
 	// RefreshToken is the single-use refresh token currently
 	// issued for the session.
 	RefreshToken string `json:"refreshToken"`
+	// RefreshTokenFamily identifies the chain of refresh tokens issued
+	// from a single login.
+	RefreshTokenFamily string `json:"refreshTokenFamily,omitempty"`
+	// PreviousRefreshTokens are the most recent refresh tokens in the
+	// family that have been superseded.
+	PreviousRefreshTokens []UserSessionRefreshToken `json:"previousRefreshTokens,omitempty"`
 	// LastAuthentication records when the user last authenticated.
 	LastAuthentication *metav1.Time `json:"lastAuthentication,omitempty"`
 }
 
+// UserSessionRefreshToken records a superseded refresh token.
+type UserSessionRefreshToken struct {
+	// Hash is the SHA-256 hash of the refresh token.
+	Hash string `json:"hash"`
+	// Rotated is when the refresh token was superseded.
+	Rotated metav1.Time `json:"rotated"`
+}
+
+// DeviceAuthorizationList is a typed list of device authorizations.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type DeviceAuthorizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeviceAuthorization `json:"items"`
+}
+
+// DeviceAuthorization records a pending device authorization grant, so the client
+// can be presented to the user when they enter the user code, and the user's
+// decision once made.  These are named after the user code, so devices can look
+// up their approval directly, and are deleted when collected.  Decisions are
+// linked to their user with a label.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="user",type="string",JSONPath=".metadata.labels['unikorn-cloud\\.org/user']"
+// +kubebuilder:printcolumn:name="expiry",type="string",JSONPath=".spec.expiry"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories=unikorn
+type DeviceAuthorization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              DeviceAuthorizationSpec `json:"spec"`
+}
+
+type DeviceAuthorizationSpec struct {
+	// ClientID is the client that started the device authorization.
+	ClientID string `json:"clientId"`
+	// OAuth2Provider is the provider the user authenticated with, set
+	// once the user has made a decision.
+	OAuth2Provider string `json:"oauth2Provider,omitempty"`
+	// SourceIP is the address the user made the decision from.
+	SourceIP string `json:"sourceIP,omitempty"`
+	// Denied is set when the user refused to approve the device.
+	Denied bool `json:"denied,omitempty"`
+	// Expiry is when the approval can no longer be collected.
+	Expiry metav1.Time `json:"expiry"`
+}
+
+// OAuth2ClientAssertionList is a typed list of client assertions.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type OAuth2ClientAssertionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OAuth2ClientAssertion `json:"items"`
+}
+
+// OAuth2ClientAssertion records a client assertion that has been used to
+// authenticate a client, so it cannot be replayed against any replica.  These
+// are named after a digest of the client ID and assertion ID, so creation fails
+// on reuse, and are deleted once the assertion expires.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="client",type="string",JSONPath=".spec.clientID"
+// +kubebuilder:printcolumn:name="expiry",type="string",JSONPath=".spec.expiry"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories=unikorn
+type OAuth2ClientAssertion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              OAuth2ClientAssertionSpec `json:"spec"`
+}
+
+type OAuth2ClientAssertionSpec struct {
+	// ClientID is the client that presented the assertion.
+	ClientID string `json:"clientID"`
+	// Expiry is when the assertion expires, and the record can be deleted.
+	Expiry metav1.Time `json:"expiry"`
+}
+
 type UserStatus struct {
+	// BackchannelLogouts records back-channel logout notifications that are
+	// pending delivery, and the outcome of the most recent ones sent to each
+	// client.
+	BackchannelLogouts []UserBackchannelLogout `json:"backchannelLogouts,omitempty"`
+}
+
+type UserBackchannelLogout struct {
+	// ClientID is the client to notify.
+	ClientID string `json:"clientID"`
+	// SessionID is the session that was terminated.
+	SessionID string `json:"sessionID,omitempty"`
+	// Issuer is the issuer of the logout token.
+	Issuer string `json:"issuer"`
+	// Created is when the session was terminated.
+	Created metav1.Time `json:"created"`
+	// Time is when the last delivery attempt was made.
+	Time *metav1.Time `json:"time,omitempty"`
+	// Attempts is the number of delivery attempts made.
+	Attempts int `json:"attempts"`
+	// Delivered is true when the client acknowledged the logout token.
+	Delivered bool `json:"delivered"`
+	// Error records the last delivery error, if any.
+	Error *string `json:"error,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	unikornv1alpha1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -131,6 +132,83 @@ func (in *AllocationStatus) DeepCopy() *AllocationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAuthorization) DeepCopyInto(out *DeviceAuthorization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAuthorization.
+func (in *DeviceAuthorization) DeepCopy() *DeviceAuthorization {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAuthorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceAuthorization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAuthorizationList) DeepCopyInto(out *DeviceAuthorizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeviceAuthorization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAuthorizationList.
+func (in *DeviceAuthorizationList) DeepCopy() *DeviceAuthorizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAuthorizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceAuthorizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAuthorizationSpec) DeepCopyInto(out *DeviceAuthorizationSpec) {
+	*out = *in
+	in.Expiry.DeepCopyInto(&out.Expiry)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAuthorizationSpec.
+func (in *DeviceAuthorizationSpec) DeepCopy() *DeviceAuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAuthorizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
@@ -220,6 +298,11 @@ func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProviderGroups != nil {
+		in, out := &in.ProviderGroups, &out.ProviderGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -277,6 +360,83 @@ func (in *OAuth2Client) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ClientAssertion) DeepCopyInto(out *OAuth2ClientAssertion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ClientAssertion.
+func (in *OAuth2ClientAssertion) DeepCopy() *OAuth2ClientAssertion {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ClientAssertion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OAuth2ClientAssertion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ClientAssertionList) DeepCopyInto(out *OAuth2ClientAssertionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OAuth2ClientAssertion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ClientAssertionList.
+func (in *OAuth2ClientAssertionList) DeepCopy() *OAuth2ClientAssertionList {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ClientAssertionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OAuth2ClientAssertionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ClientAssertionSpec) DeepCopyInto(out *OAuth2ClientAssertionSpec) {
+	*out = *in
+	in.Expiry.DeepCopyInto(&out.Expiry)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ClientAssertionSpec.
+func (in *OAuth2ClientAssertionSpec) DeepCopy() *OAuth2ClientAssertionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ClientAssertionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuth2ClientList) DeepCopyInto(out *OAuth2ClientList) {
 	*out = *in
@@ -310,6 +470,39 @@ func (in *OAuth2ClientList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ClientRegistration) DeepCopyInto(out *OAuth2ClientRegistration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ClientRegistration.
+func (in *OAuth2ClientRegistration) DeepCopy() *OAuth2ClientRegistration {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ClientRegistration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ClientSecret) DeepCopyInto(out *OAuth2ClientSecret) {
+	*out = *in
+	in.Expiry.DeepCopyInto(&out.Expiry)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ClientSecret.
+func (in *OAuth2ClientSecret) DeepCopy() *OAuth2ClientSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ClientSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuth2ClientSpec) DeepCopyInto(out *OAuth2ClientSpec) {
 	*out = *in
@@ -318,6 +511,26 @@ func (in *OAuth2ClientSpec) DeepCopyInto(out *OAuth2ClientSpec) {
 		*out = make(unikornv1alpha1.TagList, len(*in))
 		copy(*out, *in)
 	}
+	if in.RedirectURIs != nil {
+		in, out := &in.RedirectURIs, &out.RedirectURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RedirectURIPatterns != nil {
+		in, out := &in.RedirectURIPatterns, &out.RedirectURIPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostLogoutRedirectURIs != nil {
+		in, out := &in.PostLogoutRedirectURIs, &out.PostLogoutRedirectURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackchannelLogoutURI != nil {
+		in, out := &in.BackchannelLogoutURI, &out.BackchannelLogoutURI
+		*out = new(string)
+		**out = **in
+	}
 	if in.HomeURI != nil {
 		in, out := &in.HomeURI, &out.HomeURI
 		*out = new(string)
@@ -338,6 +551,56 @@ func (in *OAuth2ClientSpec) DeepCopyInto(out *OAuth2ClientSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.JWKS != nil {
+		in, out := &in.JWKS, &out.JWKS
+		*out = new(string)
+		**out = **in
+	}
+	if in.JWKSURI != nil {
+		in, out := &in.JWKSURI, &out.JWKSURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenEndpointAuthMethod != nil {
+		in, out := &in.TokenEndpointAuthMethod, &out.TokenEndpointAuthMethod
+		*out = new(OAuth2ClientAuthenticationMethod)
+		**out = **in
+	}
+	if in.Registration != nil {
+		in, out := &in.Registration, &out.Registration
+		*out = new(OAuth2ClientRegistration)
+		**out = **in
+	}
+	if in.SecretOverlap != nil {
+		in, out := &in.SecretOverlap, &out.SecretOverlap
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessTokenLifetime != nil {
+		in, out := &in.AccessTokenLifetime, &out.AccessTokenLifetime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RefreshTokenLifetime != nil {
+		in, out := &in.RefreshTokenLifetime, &out.RefreshTokenLifetime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RefreshTokenIdleTimeout != nil {
+		in, out := &in.RefreshTokenIdleTimeout, &out.RefreshTokenIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AbsoluteSessionLifetime != nil {
+		in, out := &in.AbsoluteSessionLifetime, &out.AbsoluteSessionLifetime
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -354,6 +617,13 @@ func (in *OAuth2ClientSpec) DeepCopy() *OAuth2ClientSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuth2ClientStatus) DeepCopyInto(out *OAuth2ClientStatus) {
 	*out = *in
+	if in.PreviousSecrets != nil {
+		in, out := &in.PreviousSecrets, &out.PreviousSecrets
+		*out = make([]OAuth2ClientSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]unikornv1alpha1.Condition, len(*in))
@@ -402,6 +672,22 @@ func (in *OAuth2Provider) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ProviderGroupMapping) DeepCopyInto(out *OAuth2ProviderGroupMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ProviderGroupMapping.
+func (in *OAuth2ProviderGroupMapping) DeepCopy() *OAuth2ProviderGroupMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ProviderGroupMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuth2ProviderList) DeepCopyInto(out *OAuth2ProviderList) {
 	*out = *in
@@ -435,6 +721,42 @@ func (in *OAuth2ProviderList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ProviderSAMLSpec) DeepCopyInto(out *OAuth2ProviderSAMLSpec) {
+	*out = *in
+	if in.EmailAttribute != nil {
+		in, out := &in.EmailAttribute, &out.EmailAttribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameAttribute != nil {
+		in, out := &in.NameAttribute, &out.NameAttribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.GivenNameAttribute != nil {
+		in, out := &in.GivenNameAttribute, &out.GivenNameAttribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.FamilyNameAttribute != nil {
+		in, out := &in.FamilyNameAttribute, &out.FamilyNameAttribute
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ProviderSAMLSpec.
+func (in *OAuth2ProviderSAMLSpec) DeepCopy() *OAuth2ProviderSAMLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ProviderSAMLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuth2ProviderSpec) DeepCopyInto(out *OAuth2ProviderSpec) {
 	*out = *in
@@ -458,6 +780,21 @@ func (in *OAuth2ProviderSpec) DeepCopyInto(out *OAuth2ProviderSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.APIBaseURI != nil {
+		in, out := &in.APIBaseURI, &out.APIBaseURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupMappings != nil {
+		in, out := &in.GroupMappings, &out.GroupMappings
+		*out = make([]OAuth2ProviderGroupMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.SAML != nil {
+		in, out := &in.SAML, &out.SAML
+		*out = new(OAuth2ProviderSAMLSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -548,6 +885,27 @@ func (in *OrganizationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationProviderGitHubSpec) DeepCopyInto(out *OrganizationProviderGitHubSpec) {
+	*out = *in
+	if in.Organization != nil {
+		in, out := &in.Organization, &out.Organization
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationProviderGitHubSpec.
+func (in *OrganizationProviderGitHubSpec) DeepCopy() *OrganizationProviderGitHubSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationProviderGitHubSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrganizationProviderGoogleSpec) DeepCopyInto(out *OrganizationProviderGoogleSpec) {
 	*out = *in
@@ -569,6 +927,27 @@ func (in *OrganizationProviderGoogleSpec) DeepCopy() *OrganizationProviderGoogle
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationProviderMicrosoftSpec) DeepCopyInto(out *OrganizationProviderMicrosoftSpec) {
+	*out = *in
+	if in.TenantID != nil {
+		in, out := &in.TenantID, &out.TenantID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationProviderMicrosoftSpec.
+func (in *OrganizationProviderMicrosoftSpec) DeepCopy() *OrganizationProviderMicrosoftSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationProviderMicrosoftSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrganizationProviderOptions) DeepCopyInto(out *OrganizationProviderOptions) {
 	*out = *in
@@ -577,6 +956,16 @@ func (in *OrganizationProviderOptions) DeepCopyInto(out *OrganizationProviderOpt
 		*out = new(OrganizationProviderGoogleSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Microsoft != nil {
+		in, out := &in.Microsoft, &out.Microsoft
+		*out = new(OrganizationProviderMicrosoftSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GitHub != nil {
+		in, out := &in.GitHub, &out.GitHub
+		*out = new(OrganizationProviderGitHubSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1493,7 +1882,7 @@ func (in *User) DeepCopyInto(out *User) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1515,6 +1904,32 @@ func (in *User) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserBackchannelLogout) DeepCopyInto(out *UserBackchannelLogout) {
+	*out = *in
+	in.Created.DeepCopyInto(&out.Created)
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserBackchannelLogout.
+func (in *UserBackchannelLogout) DeepCopy() *UserBackchannelLogout {
+	if in == nil {
+		return nil
+	}
+	out := new(UserBackchannelLogout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserList) DeepCopyInto(out *UserList) {
 	*out = *in
@@ -1551,6 +1966,98 @@ func (in *UserList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserSession) DeepCopyInto(out *UserSession) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSession.
+func (in *UserSession) DeepCopy() *UserSession {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserSession) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSessionList) DeepCopyInto(out *UserSessionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UserSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSessionList.
+func (in *UserSessionList) DeepCopy() *UserSessionList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSessionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserSessionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSessionRefreshToken) DeepCopyInto(out *UserSessionRefreshToken) {
+	*out = *in
+	in.Rotated.DeepCopyInto(&out.Rotated)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSessionRefreshToken.
+func (in *UserSessionRefreshToken) DeepCopy() *UserSessionRefreshToken {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSessionRefreshToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSessionSpec) DeepCopyInto(out *UserSessionSpec) {
+	*out = *in
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
+	if in.Issued != nil {
+		in, out := &in.Issued, &out.Issued
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousRefreshTokens != nil {
+		in, out := &in.PreviousRefreshTokens, &out.PreviousRefreshTokens
+		*out = make([]UserSessionRefreshToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LastAuthentication != nil {
 		in, out := &in.LastAuthentication, &out.LastAuthentication
 		*out = (*in).DeepCopy()
@@ -1558,12 +2065,12 @@ func (in *UserSession) DeepCopyInto(out *UserSession) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSession.
-func (in *UserSession) DeepCopy() *UserSession {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSessionSpec.
+func (in *UserSessionSpec) DeepCopy() *UserSessionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(UserSession)
+	out := new(UserSessionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1599,7 +2106,7 @@ func (in *UserSpec) DeepCopyInto(out *UserSpec) {
 	}
 	if in.Sessions != nil {
 		in, out := &in.Sessions, &out.Sessions
-		*out = make([]UserSession, len(*in))
+		*out = make([]UserSessionSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1620,6 +2127,13 @@ func (in *UserSpec) DeepCopy() *UserSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 	*out = *in
+	if in.BackchannelLogouts != nil {
+		in, out := &in.BackchannelLogouts, &out.BackchannelLogouts
+		*out = make([]UserBackchannelLogout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
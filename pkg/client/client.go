@@ -25,6 +25,7 @@ import (
 
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/dpop"
 	"github.com/unikorn-cloud/identity/pkg/openapi"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -77,17 +78,32 @@ func (c *Client) HTTPClient(ctx context.Context) (*http.Client, error) {
 	return client, nil
 }
 
+// authorizationScheme returns the DPoP scheme when forwarding the DPoP bound
+// token the caller authenticated with, and bearer otherwise e.g. when a service
+// is using its own token.
+func authorizationScheme(ctx context.Context, token string) string {
+	info, err := authorization.FromContext(ctx)
+	if err != nil || info.Token != token || info.DPoPThumbprint == "" {
+		return "bearer"
+	}
+
+	return dpop.Scheme
+}
+
 // RequestMutator implements OAuth2 bearer token authorization.
 func RequestMutator(accessToken AccessTokener) func(context.Context, *http.Request) error {
 	return func(ctx context.Context, req *http.Request) error {
 		// NOTE: this can legitimately not be set e.g. if we are actually getting
 		// an access token, which makes the error checking somewhat useless!
 		if accessToken != nil {
-			req.Header.Set("Authorization", "bearer "+accessToken.Get())
+			token := accessToken.Get()
+
+			req.Header.Set("Authorization", authorizationScheme(ctx, token)+" "+token)
 		}
 
 		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
 		authorization.InjectClientCert(ctx, req.Header)
+		authorization.InjectDPoPThumbprint(ctx, req.Header)
 
 		return nil
 	}
@@ -17,9 +17,13 @@ limitations under the License.
 package oauth2client
 
 import (
+	"context"
+	"time"
+
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	coremanager "github.com/unikorn-cloud/core/pkg/manager"
 	"github.com/unikorn-cloud/core/pkg/manager/options"
+	"github.com/unikorn-cloud/core/pkg/provisioners"
 	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/identity/pkg/constants"
 	"github.com/unikorn-cloud/identity/pkg/provisioners/oauth2client"
@@ -50,7 +54,41 @@ func (*Factory) Options() coremanager.ControllerOptions {
 
 // Reconciler returns a new reconciler instance.
 func (*Factory) Reconciler(options *options.Options, controllerOptions coremanager.ControllerOptions, manager manager.Manager) reconcile.Reconciler {
-	return coremanager.NewReconciler(options, controllerOptions, manager, oauth2client.New)
+	createProvisioner := func(controllerOptions coremanager.ControllerOptions) provisioners.ManagerProvisioner {
+		return oauth2client.New(controllerOptions, manager.GetAPIReader())
+	}
+
+	return &secretExpiryReconciler{
+		Reconciler: coremanager.NewReconciler(options, controllerOptions, manager, createProvisioner),
+		client:     manager.GetClient(),
+	}
+}
+
+// secretExpiryReconciler wraps the generic reconciler and requeues the client when
+// a previous secret expires, so it gets pruned without any external trigger.
+type secretExpiryReconciler struct {
+	reconcile.Reconciler
+
+	client client.Client
+}
+
+func (r *secretExpiryReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	result, err := r.Reconciler.Reconcile(ctx, request)
+	if err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	resource := &unikornv1.OAuth2Client{}
+
+	if err := r.client.Get(ctx, request.NamespacedName, resource); err != nil {
+		return result, client.IgnoreNotFound(err)
+	}
+
+	if expiry := resource.NextSecretExpiry(); expiry != nil {
+		result.RequeueAfter = max(time.Until(*expiry), time.Second)
+	}
+
+	return result, nil
 }
 
 // RegisterWatches adds any watches that would trigger a reconcile.
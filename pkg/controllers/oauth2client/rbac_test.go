@@ -0,0 +1,68 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2client_test
+
+import (
+	"os"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"sigs.k8s.io/yaml"
+)
+
+// templateDirective matches lines that are pure template directives, these
+// carry no rules so can be dropped to leave valid YAML.
+var templateDirective = regexp.MustCompile(`(?m)^\s*\{\{.*\}\}\s*$`)
+
+// clusterRole loads the controller's cluster role from the chart.
+func clusterRole(t *testing.T) *rbacv1.ClusterRole {
+	t.Helper()
+
+	data, err := os.ReadFile("../../../charts/identity/templates/oauth2client-controller/clusterrole.yaml")
+	require.NoError(t, err)
+
+	role := &rbacv1.ClusterRole{}
+	require.NoError(t, yaml.Unmarshal(templateDirective.ReplaceAll(data, nil), role))
+
+	return role
+}
+
+// allowed checks whether any rule grants the verb on the resource.
+func allowed(role *rbacv1.ClusterRole, group, resource, verb string) bool {
+	return slices.ContainsFunc(role.Rules, func(rule rbacv1.PolicyRule) bool {
+		return slices.Contains(rule.APIGroups, group) && slices.Contains(rule.Resources, resource) && slices.Contains(rule.Verbs, verb)
+	})
+}
+
+// TestClusterRole checks the controller is allowed to do everything the
+// provisioner does, for clients in any namespace.
+func TestClusterRole(t *testing.T) {
+	t.Parallel()
+
+	role := clusterRole(t)
+
+	require.True(t, allowed(role, "identity.unikorn-cloud.org", "oauth2clients", "watch"))
+	require.True(t, allowed(role, "identity.unikorn-cloud.org", "oauth2clients/status", "update"))
+	require.True(t, allowed(role, "", "secrets", "get"))
+	require.True(t, allowed(role, "", "secrets", "create"))
+	require.True(t, allowed(role, "", "secrets", "update"))
+}
@@ -71,6 +71,10 @@ func convert(in *unikornv1.Group) *openapi.GroupRead {
 		out.Spec.ServiceAccountIDs = in.Spec.ServiceAccountIDs
 	}
 
+	if in.Spec.ProviderGroups != nil {
+		out.Spec.ProviderGroups = &in.Spec.ProviderGroups
+	}
+
 	return out
 }
 
@@ -173,6 +177,10 @@ func (c *Client) generate(ctx context.Context, organization *organizations.Meta,
 		},
 	}
 
+	if in.Spec.ProviderGroups != nil {
+		out.Spec.ProviderGroups = *in.Spec.ProviderGroups
+	}
+
 	return out, nil
 }
 
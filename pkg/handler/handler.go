@@ -19,6 +19,7 @@ limitations under the License.
 package handler
 
 import (
+	goerrors "errors"
 	"fmt"
 	"net/http"
 	"slices"
@@ -28,19 +29,24 @@ import (
 	"github.com/unikorn-cloud/core/pkg/server/util"
 	"github.com/unikorn-cloud/identity/pkg/handler/allocations"
 	"github.com/unikorn-cloud/identity/pkg/handler/groups"
+	"github.com/unikorn-cloud/identity/pkg/handler/oauth2clients"
 	"github.com/unikorn-cloud/identity/pkg/handler/oauth2providers"
 	"github.com/unikorn-cloud/identity/pkg/handler/organizations"
 	"github.com/unikorn-cloud/identity/pkg/handler/projects"
 	"github.com/unikorn-cloud/identity/pkg/handler/quotas"
 	"github.com/unikorn-cloud/identity/pkg/handler/roles"
 	"github.com/unikorn-cloud/identity/pkg/handler/serviceaccounts"
+	"github.com/unikorn-cloud/identity/pkg/handler/sessions"
 	"github.com/unikorn-cloud/identity/pkg/handler/users"
 	"github.com/unikorn-cloud/identity/pkg/jose"
 	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
 	"github.com/unikorn-cloud/identity/pkg/oauth2"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/dpop"
 	"github.com/unikorn-cloud/identity/pkg/openapi"
 	"github.com/unikorn-cloud/identity/pkg/rbac"
 
+	"k8s.io/utils/ptr"
+
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -92,13 +98,30 @@ func (h *Handler) setUncacheableNoStore(w http.ResponseWriter) {
 	w.Header().Add("Cache-Control", "no-store")
 }
 
+// dpopSigningAlgorithms returns the algorithms accepted for DPoP proofs.
+func dpopSigningAlgorithms() []string {
+	algorithms := make([]string, len(dpop.SigningAlgorithms))
+
+	for i := range dpop.SigningAlgorithms {
+		algorithms[i] = string(dpop.SigningAlgorithms[i])
+	}
+
+	return algorithms
+}
+
 func (h *Handler) GetWellKnownOpenidConfiguration(w http.ResponseWriter, r *http.Request) {
 	result := &openapi.OpenidConfiguration{
-		Issuer:                h.options.Host,
-		AuthorizationEndpoint: fmt.Sprintf("%s/oauth2/v2/authorization", h.options.Host),
-		TokenEndpoint:         fmt.Sprintf("%s/oauth2/v2/token", h.options.Host),
-		UserinfoEndpoint:      fmt.Sprintf("%s/oauth2/v2/userinfo", h.options.Host),
-		JwksUri:               fmt.Sprintf("%s/oauth2/v2/jwks", h.options.Host),
+		Issuer:                             h.options.Host,
+		AuthorizationEndpoint:              fmt.Sprintf("%s/oauth2/v2/authorization", h.options.Host),
+		TokenEndpoint:                      fmt.Sprintf("%s/oauth2/v2/token", h.options.Host),
+		UserinfoEndpoint:                   fmt.Sprintf("%s/oauth2/v2/userinfo", h.options.Host),
+		JwksUri:                            fmt.Sprintf("%s/oauth2/v2/jwks", h.options.Host),
+		RevocationEndpoint:                 fmt.Sprintf("%s/oauth2/v2/revoke", h.options.Host),
+		IntrospectionEndpoint:              fmt.Sprintf("%s/oauth2/v2/introspect", h.options.Host),
+		DeviceAuthorizationEndpoint:        fmt.Sprintf("%s/oauth2/v2/device_authorization", h.options.Host),
+		EndSessionEndpoint:                 fmt.Sprintf("%s/oauth2/v2/logout", h.options.Host),
+		RegistrationEndpoint:               fmt.Sprintf("%s/oauth2/v2/register", h.options.Host),
+		PushedAuthorizationRequestEndpoint: fmt.Sprintf("%s/oauth2/v2/par", h.options.Host),
 		ScopesSupported: []openapi.Scope{
 			openapi.ScopeEmail,
 			openapi.ScopeOpenid,
@@ -122,10 +145,24 @@ func (h *Handler) GetWellKnownOpenidConfiguration(w http.ResponseWriter, r *http
 			openapi.ResponseTypeCode,
 			openapi.ResponseTypeIdToken,
 		},
+		RequestParameterSupported:                  true,
+		DpopSigningAlgValuesSupported:              ptr.To(dpopSigningAlgorithms()),
+		TokenEndpointAuthSigningAlgValuesSupported: ptr.To(oauth2.ClientAssertionSigningAlgorithms()),
 		ResponseModesSupported: []openapi.ResponseMode{
 			openapi.Query,
 		},
 		TokenEndpointAuthMethodsSupported: []openapi.AuthMethod{
+			openapi.ClientSecretBasic,
+			openapi.ClientSecretPost,
+			openapi.ClientSecretJwt,
+			openapi.PrivateKeyJwt,
+			openapi.TlsClientAuth,
+		},
+		RevocationEndpointAuthMethodsSupported: []openapi.AuthMethod{
+			openapi.ClientSecretBasic,
+			openapi.ClientSecretPost,
+		},
+		IntrospectionEndpointAuthMethodsSupported: []openapi.AuthMethod{
 			openapi.ClientSecretBasic,
 			openapi.ClientSecretPost,
 			openapi.TlsClientAuth,
@@ -134,6 +171,8 @@ func (h *Handler) GetWellKnownOpenidConfiguration(w http.ResponseWriter, r *http
 			openapi.AuthorizationCode,
 			openapi.ClientCredentials,
 			openapi.RefreshToken,
+			openapi.UrnIetfParamsOauthGrantTypeDeviceCode,
+			openapi.UrnIetfParamsOauthGrantTypeTokenExchange,
 		},
 		IdTokenSigningAlgValuesSupported: []openapi.SigningAlgorithm{
 			openapi.ES512,
@@ -159,14 +198,46 @@ func (h *Handler) PostOauth2V2Login(w http.ResponseWriter, r *http.Request) {
 	h.oauth2.Login(w, r)
 }
 
+func (h *Handler) GetOauth2V2Logout(w http.ResponseWriter, r *http.Request) {
+	h.oauth2.Logout(w, r)
+}
+
+func (h *Handler) PostOauth2V2Logout(w http.ResponseWriter, r *http.Request) {
+	h.oauth2.Logout(w, r)
+}
+
 func (h *Handler) PostOauth2V2Onboard(w http.ResponseWriter, r *http.Request) {
 	h.oauth2.Onboard(w, r)
 }
 
+// handleOAuth2Error handles errors defined by oauth2 extensions that the generic
+// error types don't cater for, e.g. device polling, before falling back to them.
+func handleOAuth2Error(w http.ResponseWriter, r *http.Request, err error) {
+	var protocolErr *oauth2.ProtocolError
+
+	if goerrors.As(err, &protocolErr) {
+		protocolErr.Write(w, r)
+		return
+	}
+
+	errors.HandleError(w, r, err)
+}
+
+func (h *Handler) PostOauth2V2Par(w http.ResponseWriter, r *http.Request) {
+	result, err := h.oauth2.PushedAuthorization(w, r)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheableNoStore(w)
+	util.WriteJSONResponse(w, r, http.StatusCreated, result)
+}
+
 func (h *Handler) PostOauth2V2Token(w http.ResponseWriter, r *http.Request) {
 	result, err := h.oauth2.Token(w, r)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		handleOAuth2Error(w, r, err)
 		return
 	}
 
@@ -175,6 +246,107 @@ func (h *Handler) PostOauth2V2Token(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
+func (h *Handler) PostOauth2V2Register(w http.ResponseWriter, r *http.Request) {
+	request := &openapi.ClientRegistrationRequest{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.oauth2.Register(r, request)
+	if err != nil {
+		handleOAuth2Error(w, r, err)
+		return
+	}
+
+	h.setUncacheableNoStore(w)
+	util.WriteJSONResponse(w, r, http.StatusCreated, result)
+}
+
+func (h *Handler) GetOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request, clientID openapi.ClientIDParameter) {
+	result, err := h.oauth2.RegistrationRead(r, clientID)
+	if err != nil {
+		handleOAuth2Error(w, r, err)
+		return
+	}
+
+	h.setUncacheableNoStore(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PutOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request, clientID openapi.ClientIDParameter) {
+	request := &openapi.ClientRegistrationRequest{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.oauth2.RegistrationUpdate(r, clientID, request)
+	if err != nil {
+		handleOAuth2Error(w, r, err)
+		return
+	}
+
+	h.setUncacheableNoStore(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) DeleteOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request, clientID openapi.ClientIDParameter) {
+	if err := h.oauth2.RegistrationDelete(r, clientID); err != nil {
+		handleOAuth2Error(w, r, err)
+		return
+	}
+
+	h.setUncacheableNoStore(w)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) PostOauth2V2Revoke(w http.ResponseWriter, r *http.Request) {
+	if err := h.oauth2.Revoke(w, r); err != nil {
+		handleOAuth2Error(w, r, err)
+		return
+	}
+
+	h.setUncacheableNoStore(w)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) PostOauth2V2Introspect(w http.ResponseWriter, r *http.Request) {
+	result, err := h.oauth2.Introspect(w, r)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheableNoStore(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostOauth2V2DeviceAuthorization(w http.ResponseWriter, r *http.Request) {
+	result, err := h.oauth2.DeviceAuthorization(w, r)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheableNoStore(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetOauth2V2Device(w http.ResponseWriter, r *http.Request, params openapi.GetOauth2V2DeviceParams) {
+	h.oauth2.Device(w, r)
+}
+
+func (h *Handler) PostOauth2V2Device(w http.ResponseWriter, r *http.Request) {
+	h.oauth2.DeviceLogin(w, r)
+}
+
+func (h *Handler) PostOauth2V2DeviceApprove(w http.ResponseWriter, r *http.Request) {
+	h.oauth2.DeviceApprove(w, r)
+}
+
 func (h *Handler) GetOauth2V2Userinfo(w http.ResponseWriter, r *http.Request) {
 	info, err := authorization.FromContext(r.Context())
 	if err != nil {
@@ -200,12 +372,17 @@ func (h *Handler) PostOauth2V2Userinfo(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		userinfo, _, err := h.oauth2.GetUserinfo(r.Context(), r, parts[1])
+		userinfo, claims, err := h.oauth2.GetUserinfo(r.Context(), r, parts[1])
 		if err != nil {
 			errors.HandleError(w, r, errors.OAuth2AccessDenied("access token is invalid").WithError(err))
 			return
 		}
 
+		if claims.Confirmation != nil {
+			errors.HandleError(w, r, errors.OAuth2AccessDenied("DPoP bound token presented as a bearer token"))
+			return
+		}
+
 		h.setUncacheable(w)
 		util.WriteJSONResponse(w, r, http.StatusOK, userinfo)
 
@@ -217,12 +394,17 @@ func (h *Handler) PostOauth2V2Userinfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userinfo, _, err := h.oauth2.GetUserinfo(r.Context(), r, r.Form.Get("access_token"))
+	userinfo, claims, err := h.oauth2.GetUserinfo(r.Context(), r, r.Form.Get("access_token"))
 	if err != nil {
 		errors.HandleError(w, r, errors.OAuth2AccessDenied("access token is invalid").WithError(err))
 		return
 	}
 
+	if claims.Confirmation != nil {
+		errors.HandleError(w, r, errors.OAuth2AccessDenied("DPoP bound token presented as a bearer token"))
+		return
+	}
+
 	h.setUncacheable(w)
 	util.WriteJSONResponse(w, r, http.StatusOK, userinfo)
 }
@@ -242,6 +424,14 @@ func (h *Handler) GetOidcCallback(w http.ResponseWriter, r *http.Request) {
 	h.oauth2.Callback(w, r)
 }
 
+func (h *Handler) GetSamlMetadata(w http.ResponseWriter, r *http.Request) {
+	h.oauth2.SAMLMetadata(w, r)
+}
+
+func (h *Handler) PostSamlAcs(w http.ResponseWriter, r *http.Request) {
+	h.oauth2.SAMLAssertionConsumer(w, r)
+}
+
 func (h *Handler) GetApiV1Oauth2providers(w http.ResponseWriter, r *http.Request) {
 	result, err := oauth2providers.New(h.client, h.namespace).ListGlobal(r.Context())
 	if err != nil {
@@ -253,6 +443,22 @@ func (h *Handler) GetApiV1Oauth2providers(w http.ResponseWriter, r *http.Request
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
+func (h *Handler) PostApiV1Oauth2clientsClientIDRotate(w http.ResponseWriter, r *http.Request, clientID openapi.ClientIDParameter) {
+	if err := rbac.AllowGlobalScope(r.Context(), "identity:oauth2clients", openapi.Update); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := oauth2clients.New(h.client, h.namespace).Rotate(r.Context(), clientID)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheableNoStore(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
 func (h *Handler) GetApiV1Acl(w http.ResponseWriter, r *http.Request) {
 	// The middleware will populate this from the URL, and thus not have access to any
 	// scoping information, so just return anything at the global scope.
@@ -362,6 +568,29 @@ func (h *Handler) DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderI
 	w.WriteHeader(http.StatusOK)
 }
 
+func (h *Handler) PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, providerID openapi.Oauth2ProvderIDParameter) {
+	if err := rbac.AllowOrganizationScope(r.Context(), "identity:oauth2providers", openapi.Read, organizationID); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	request := &openapi.GroupMappingDryRun{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := oauth2providers.New(h.client, h.namespace).GroupMappingDryRun(r.Context(), organizationID, providerID, request)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
 func (h *Handler) GetApiV1Organizations(w http.ResponseWriter, r *http.Request, params openapi.GetApiV1OrganizationsParams) {
 	result, err := organizations.New(h.client, h.namespace).List(r.Context(), h.rbac, params.Email)
 	if err != nil {
@@ -711,7 +940,7 @@ func (h *Handler) PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAcco
 }
 
 func (h *Handler) usersClient(r *http.Request) *users.Client {
-	return users.New(r.Host, h.client, h.namespace, h.issuer, &h.options.Users)
+	return users.New(r.Host, h.client, h.namespace, h.issuer, h.oauth2, &h.options.Users)
 }
 
 func (h *Handler) GetApiV1Signup(w http.ResponseWriter, r *http.Request) {
@@ -795,6 +1024,99 @@ func (h *Handler) PutApiV1OrganizationsOrganizationIDUsersUserID(w http.Response
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
+func (h *Handler) GetApiV1Revocations(w http.ResponseWriter, r *http.Request, params openapi.GetApiV1RevocationsParams) {
+	if err := rbac.AllowGlobalScope(r.Context(), "identity:revocations", openapi.Read); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result := h.oauth2.Revocations(params.Since)
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) sessionsClient(r *http.Request) *sessions.Client {
+	return sessions.New(r.Host, h.client, h.namespace, h.oauth2, h.rbac)
+}
+
+func (h *Handler) GetApiV1Sessions(w http.ResponseWriter, r *http.Request) {
+	result, err := h.sessionsClient(r).List(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) DeleteApiV1Sessions(w http.ResponseWriter, r *http.Request) {
+	if err := h.sessionsClient(r).DeleteAll(r.Context()); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) DeleteApiV1SessionsSessionID(w http.ResponseWriter, r *http.Request, sessionID openapi.SessionIDParameter) {
+	if err := h.sessionsClient(r).Delete(r.Context(), sessionID); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDUsersUserIDSessions(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, userID openapi.UserIDParameter) {
+	if err := rbac.AllowOrganizationScope(r.Context(), "identity:users", openapi.Read, organizationID); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.sessionsClient(r).ListMember(r.Context(), organizationID, userID)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, userID openapi.UserIDParameter) {
+	if err := rbac.AllowOrganizationScope(r.Context(), "identity:users", openapi.Delete, organizationID); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.sessionsClient(r).DeleteAllMember(r.Context(), organizationID, userID); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, userID openapi.UserIDParameter, sessionID openapi.SessionIDParameter) {
+	if err := rbac.AllowOrganizationScope(r.Context(), "identity:users", openapi.Delete, organizationID); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.sessionsClient(r).DeleteMember(r.Context(), organizationID, userID, sessionID); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusOK)
+}
+
 func (h *Handler) quotasClient() *quotas.Client {
 	return quotas.New(h.client, h.namespace)
 }
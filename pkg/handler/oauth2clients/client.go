@@ -0,0 +1,144 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2clients
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/core/pkg/util/retry"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/oauth2"
+	"github.com/unikorn-cloud/identity/pkg/openapi"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// ErrRotation is raised when the secret has not been rotated yet.
+	ErrRotation = goerrors.New("secret rotation pending")
+)
+
+type Client struct {
+	client    client.Client
+	namespace string
+}
+
+func New(client client.Client, namespace string) *Client {
+	return &Client{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+func (c *Client) get(ctx context.Context, clientID string) (*unikornv1.OAuth2Client, error) {
+	result := &unikornv1.OAuth2Client{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: c.namespace, Name: clientID}, result); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.HTTPNotFound().WithError(err)
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get oauth2 client").WithError(err)
+	}
+
+	return result, nil
+}
+
+func convertSecret(in *unikornv1.OAuth2Client, secret string) *openapi.Oauth2ClientSecret {
+	out := &openapi.Oauth2ClientSecret{
+		Secret: secret,
+	}
+
+	for i := range in.Status.PreviousSecrets {
+		expiry := in.Status.PreviousSecrets[i].Expiry.Time
+
+		if out.PreviousSecretsExpiry == nil || expiry.After(*out.PreviousSecretsExpiry) {
+			out.PreviousSecretsExpiry = &expiry
+		}
+	}
+
+	return out
+}
+
+// waitForRotation waits for the provisioner to generate the requested secret, and
+// returns it, this is the only time the secret is returned.
+func (c *Client) waitForRotation(ctx context.Context, clientID string, generation int64) (*unikornv1.OAuth2Client, string, error) {
+	var result *unikornv1.OAuth2Client
+
+	var secret string
+
+	rotated := func() error {
+		resource, err := c.get(ctx, clientID)
+		if err != nil {
+			return err
+		}
+
+		if resource.Status.SecretGeneration < generation {
+			return fmt.Errorf("%w: waiting for generation %d", ErrRotation, generation)
+		}
+
+		s, err := oauth2.ClientSecret(ctx, c.client, resource)
+		if err != nil {
+			return err
+		}
+
+		result = resource
+		secret = s
+
+		return nil
+	}
+
+	retryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err := retry.Forever().DoWithContext(retryCtx, rotated); err != nil {
+		return nil, "", err
+	}
+
+	return result, secret, nil
+}
+
+// Rotate requests a new client secret from the provisioner, and waits for it to
+// be generated.  Previous secrets remain valid for the client's overlap period.
+func (c *Client) Rotate(ctx context.Context, clientID string) (*openapi.Oauth2ClientSecret, error) {
+	current, err := c.get(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	generation := max(current.Spec.SecretGeneration, current.Status.SecretGeneration) + 1
+
+	updated := current.DeepCopy()
+	updated.Spec.SecretGeneration = generation
+
+	if err := c.client.Patch(ctx, updated, client.MergeFrom(current)); err != nil {
+		return nil, errors.OAuth2ServerError("failed to patch oauth2 client").WithError(err)
+	}
+
+	result, secret, err := c.waitForRotation(ctx, clientID, generation)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to rotate oauth2 client secret in time").WithError(err)
+	}
+
+	return convertSecret(result, secret), nil
+}
@@ -18,6 +18,7 @@ package oauth2providers
 
 import (
 	"context"
+	"fmt"
 	"slices"
 	"strings"
 
@@ -27,6 +28,8 @@ import (
 	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/identity/pkg/handler/organizations"
 	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/groupmapping"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
 	"github.com/unikorn-cloud/identity/pkg/openapi"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -61,6 +64,20 @@ func (c *Client) get(ctx context.Context, organization *organizations.Meta, prov
 	return result, nil
 }
 
+func convertGroupMappings(in []unikornv1.OAuth2ProviderGroupMapping) openapi.Oauth2ProviderGroupMappings {
+	out := make(openapi.Oauth2ProviderGroupMappings, len(in))
+
+	for i := range in {
+		out[i] = openapi.Oauth2ProviderGroupMapping{
+			Claim:   in[i].Claim,
+			Value:   in[i].Value,
+			GroupID: in[i].GroupID,
+		}
+	}
+
+	return out
+}
+
 func convert(in *unikornv1.OAuth2Provider) *openapi.Oauth2ProviderRead {
 	out := &openapi.Oauth2ProviderRead{
 		Metadata: conversion.OrganizationScopedResourceReadMetadata(in, in.Spec.Tags, coreopenapi.ResourceProvisioningStatusProvisioned),
@@ -74,6 +91,11 @@ func convert(in *unikornv1.OAuth2Provider) *openapi.Oauth2ProviderRead {
 		out.Spec.Type = &t
 	}
 
+	if len(in.Spec.GroupMappings) != 0 {
+		groupMappings := convertGroupMappings(in.Spec.GroupMappings)
+		out.Spec.GroupMappings = &groupMappings
+	}
+
 	/*
 		// Only show sensitive details for organizations you are an admin of.
 		if showDetails(permissions) {
@@ -127,6 +149,20 @@ func (c *Client) List(ctx context.Context, organizationID string) (openapi.Oauth
 	return convertList(result), nil
 }
 
+func generateGroupMappings(in openapi.Oauth2ProviderGroupMappings) []unikornv1.OAuth2ProviderGroupMapping {
+	out := make([]unikornv1.OAuth2ProviderGroupMapping, len(in))
+
+	for i := range in {
+		out[i] = unikornv1.OAuth2ProviderGroupMapping{
+			Claim:   in[i].Claim,
+			Value:   in[i].Value,
+			GroupID: in[i].GroupID,
+		}
+	}
+
+	return out
+}
+
 func (c *Client) generate(ctx context.Context, organization *organizations.Meta, in *openapi.Oauth2ProviderWrite) (*unikornv1.OAuth2Provider, error) {
 	info, err := authorization.FromContext(ctx)
 	if err != nil {
@@ -146,6 +182,23 @@ func (c *Client) generate(ctx context.Context, organization *organizations.Meta,
 		out.Spec.ClientSecret = *in.Spec.ClientSecret
 	}
 
+	if in.Spec.GroupMappings != nil {
+		// Validate groups exist in the organization.
+		for _, mapping := range *in.Spec.GroupMappings {
+			var resource unikornv1.Group
+
+			if err := c.client.Get(ctx, client.ObjectKey{Namespace: organization.Namespace, Name: mapping.GroupID}, &resource); err != nil {
+				if kerrors.IsNotFound(err) {
+					return nil, errors.OAuth2InvalidRequest(fmt.Sprintf("group ID %s does not exist", mapping.GroupID)).WithError(err)
+				}
+
+				return nil, errors.OAuth2ServerError("failed to validate group ID").WithError(err)
+			}
+		}
+
+		out.Spec.GroupMappings = generateGroupMappings(*in.Spec.GroupMappings)
+	}
+
 	out.Spec.Tags = conversion.GenerateTagList(in.Metadata.Tags)
 
 	return out, nil
@@ -224,3 +277,36 @@ func (c *Client) Delete(ctx context.Context, organizationID, providerID string)
 
 	return nil
 }
+
+// GroupMappingDryRun evaluates a provider's group mappings against an ID token.
+func (c *Client) GroupMappingDryRun(ctx context.Context, organizationID, providerID string, request *openapi.GroupMappingDryRun) (openapi.GroupMappingResults, error) {
+	organization, err := organizations.New(c.client, c.namespace).GetMetadata(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := c.get(ctx, organization, providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := map[string]any{}
+
+	if err := oidc.UnverifiedClaims(request.IdToken, &claims); err != nil {
+		return nil, errors.OAuth2InvalidRequest("id token is malformed").WithError(err)
+	}
+
+	results := groupmapping.Evaluate(provider.Spec.GroupMappings, claims)
+
+	out := make(openapi.GroupMappingResults, len(results))
+
+	for i := range results {
+		out[i] = openapi.GroupMappingResult{
+			GroupID: results[i].GroupID,
+			Member:  results[i].Member,
+			Matched: convertGroupMappings(results[i].Matched),
+		}
+	}
+
+	return out, nil
+}
@@ -112,6 +112,14 @@ func convert(in *unikornv1.Organization) *openapi.OrganizationRead {
 		if in.Spec.ProviderOptions.Google != nil {
 			out.Spec.GoogleCustomerID = in.Spec.ProviderOptions.Google.CustomerID
 		}
+
+		if in.Spec.ProviderOptions.Microsoft != nil {
+			out.Spec.MicrosoftTenantID = in.Spec.ProviderOptions.Microsoft.TenantID
+		}
+
+		if in.Spec.ProviderOptions.GitHub != nil {
+			out.Spec.GithubOrganization = in.Spec.ProviderOptions.GitHub.Organization
+		}
 	}
 
 	return out
@@ -304,6 +312,26 @@ func (c *Client) generate(ctx context.Context, in *openapi.OrganizationWrite) (*
 				},
 			}
 		}
+
+		if in.Spec.MicrosoftTenantID != nil {
+			if out.Spec.ProviderOptions == nil {
+				out.Spec.ProviderOptions = &unikornv1.OrganizationProviderOptions{}
+			}
+
+			out.Spec.ProviderOptions.Microsoft = &unikornv1.OrganizationProviderMicrosoftSpec{
+				TenantID: in.Spec.MicrosoftTenantID,
+			}
+		}
+
+		if in.Spec.GithubOrganization != nil {
+			if out.Spec.ProviderOptions == nil {
+				out.Spec.ProviderOptions = &unikornv1.OrganizationProviderOptions{}
+			}
+
+			out.Spec.ProviderOptions.GitHub = &unikornv1.OrganizationProviderGitHubSpec{
+				Organization: in.Spec.GithubOrganization,
+			}
+		}
 	}
 
 	return out, nil
@@ -0,0 +1,312 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessions
+
+import (
+	"context"
+	goerrors "errors"
+	"slices"
+	"strings"
+
+	"github.com/unikorn-cloud/core/pkg/constants"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/handler/organizations"
+	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
+	"github.com/unikorn-cloud/identity/pkg/oauth2"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/sessions"
+	"github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/rbac"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Client is responsible for user session management.
+type Client struct {
+	// host is the hostname of this service.
+	host string
+	// client is the Kubernetes client.
+	client client.Client
+	// namespace is the namespace the identity service is running in.
+	namespace string
+	// authenticator owns the sessions.
+	authenticator *oauth2.Authenticator
+	// rbac resolves the caller to a user.
+	rbac *rbac.RBAC
+}
+
+// New creates a new session client.
+func New(host string, client client.Client, namespace string, authenticator *oauth2.Authenticator, rbac *rbac.RBAC) *Client {
+	return &Client{
+		host:          host,
+		client:        client,
+		namespace:     namespace,
+		authenticator: authenticator,
+		rbac:          rbac,
+	}
+}
+
+// self returns the global user making the request.  Only federated users have
+// sessions, service accounts are bound to a single long lived token.
+func (c *Client) self(ctx context.Context) (*unikornv1.User, error) {
+	info, err := authorization.FromContext(ctx)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to get authorization info").WithError(err)
+	}
+
+	if info.SystemAccount || info.ServiceAccount {
+		return nil, errors.HTTPForbidden("only users have sessions")
+	}
+
+	user, err := c.rbac.GetUser(ctx, info.Userinfo.Sub)
+	if err != nil {
+		if goerrors.Is(err, rbac.ErrResourceReference) {
+			return nil, errors.HTTPForbidden("user not found")
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get user").WithError(err)
+	}
+
+	return user, nil
+}
+
+// sessionPredicate selects the sessions that can be managed.
+type sessionPredicate func(*unikornv1.UserSession) bool
+
+// all selects all of the caller's own sessions.
+func all(*unikornv1.UserSession) bool {
+	return true
+}
+
+// member returns the global user for an organization user.  Users may be members
+// of many organizations, so only sessions established with the organization's
+// identity providers are selected, other sessions are none of its business.
+func (c *Client) member(ctx context.Context, organizationID, userID string) (*unikornv1.User, sessionPredicate, error) {
+	metadata, err := organizations.New(c.client, c.namespace).GetMetadata(ctx, organizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organizationUser := &unikornv1.OrganizationUser{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: metadata.Namespace, Name: userID}, organizationUser); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil, errors.HTTPNotFound().WithError(err)
+		}
+
+		return nil, nil, errors.OAuth2ServerError("failed to get user").WithError(err)
+	}
+
+	user := &unikornv1.User{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: c.namespace, Name: organizationUser.Labels[constants.UserLabel]}, user); err != nil {
+		return nil, nil, errors.OAuth2ServerError("failed to get user").WithError(err)
+	}
+
+	organization := &unikornv1.Organization{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: c.namespace, Name: organizationID}, organization); err != nil {
+		return nil, nil, errors.OAuth2ServerError("failed to get organization").WithError(err)
+	}
+
+	providers := &unikornv1.OAuth2ProviderList{}
+
+	if err := c.client.List(ctx, providers, &client.ListOptions{Namespace: metadata.Namespace}); err != nil {
+		return nil, nil, errors.OAuth2ServerError("failed to list providers").WithError(err)
+	}
+
+	providerIDs := map[string]bool{}
+
+	for i := range providers.Items {
+		providerIDs[providers.Items[i].Name] = true
+	}
+
+	// Users in a domain mapped organization must login with its provider, which
+	// may be a global one shared with other organizations, so sessions established
+	// with it belong to the organization.
+	if organization.Spec.Domain != nil && organization.Spec.ProviderID != nil {
+		if _, domain, ok := strings.Cut(user.Spec.Subject, "@"); ok && strings.EqualFold(domain, *organization.Spec.Domain) {
+			providerIDs[*organization.Spec.ProviderID] = true
+		}
+	}
+
+	predicate := func(session *unikornv1.UserSession) bool {
+		return providerIDs[session.Spec.OAuth2Provider]
+	}
+
+	return user, predicate, nil
+}
+
+// currentAccessToken returns the access token used to make the request, if any.
+func currentAccessToken(ctx context.Context) string {
+	info, err := authorization.FromContext(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return info.Token
+}
+
+func convert(in *unikornv1.UserSession, accessToken string) *openapi.Session {
+	out := &openapi.Session{
+		Id:       in.Name,
+		ClientID: in.Spec.ClientID,
+		Current:  accessToken != "" && in.Spec.AccessToken == accessToken,
+	}
+
+	if in.Spec.Created != nil {
+		out.CreationTime = &in.Spec.Created.Time
+	}
+
+	if in.Spec.Issued != nil {
+		out.IssueTime = &in.Spec.Issued.Time
+	}
+
+	if in.Spec.LastAuthentication != nil {
+		out.LastAuthenticationTime = &in.Spec.LastAuthentication.Time
+	}
+
+	if in.Spec.OAuth2Provider != "" {
+		out.Provider = &in.Spec.OAuth2Provider
+	}
+
+	if in.Spec.SourceIP != "" {
+		out.SourceIP = &in.Spec.SourceIP
+	}
+
+	return out
+}
+
+func convertList(in []unikornv1.UserSession, accessToken string) openapi.Sessions {
+	out := make(openapi.Sessions, len(in))
+
+	for i := range in {
+		out[i] = *convert(&in[i], accessToken)
+	}
+
+	slices.SortStableFunc(out, func(a, b openapi.Session) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+
+	return out
+}
+
+func (c *Client) list(ctx context.Context, user *unikornv1.User, predicate sessionPredicate) (openapi.Sessions, error) {
+	result, err := c.authenticator.ListSessions(ctx, user.Name)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to list sessions").WithError(err)
+	}
+
+	result = slices.DeleteFunc(result, func(session unikornv1.UserSession) bool {
+		return !predicate(&session)
+	})
+
+	return convertList(result, currentAccessToken(ctx)), nil
+}
+
+func (c *Client) delete(ctx context.Context, user *unikornv1.User, predicate sessionPredicate, sessionID string) error {
+	result, err := c.authenticator.ListSessions(ctx, user.Name)
+	if err != nil {
+		return errors.OAuth2ServerError("failed to list sessions").WithError(err)
+	}
+
+	if !slices.ContainsFunc(result, func(session unikornv1.UserSession) bool {
+		return session.Name == sessionID && predicate(&session)
+	}) {
+		return errors.HTTPNotFound()
+	}
+
+	if err := c.authenticator.RevokeSession(ctx, user.Name, sessionID); err != nil {
+		if goerrors.Is(err, sessions.ErrNotFound) {
+			return errors.HTTPNotFound().WithError(err)
+		}
+
+		return errors.OAuth2ServerError("failed to revoke session").WithError(err)
+	}
+
+	return nil
+}
+
+func (c *Client) deleteAll(ctx context.Context, user *unikornv1.User, predicate sessionPredicate) error {
+	if err := c.authenticator.LogoutUserSessions(ctx, "https://"+c.host, user, "", predicate); err != nil {
+		return errors.OAuth2ServerError("failed to revoke sessions").WithError(err)
+	}
+
+	return nil
+}
+
+// List returns the caller's sessions.
+func (c *Client) List(ctx context.Context) (openapi.Sessions, error) {
+	user, err := c.self(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.list(ctx, user, all)
+}
+
+// Delete revokes one of the caller's sessions.
+func (c *Client) Delete(ctx context.Context, sessionID string) error {
+	user, err := c.self(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.delete(ctx, user, all, sessionID)
+}
+
+// DeleteAll revokes all of the caller's sessions.
+func (c *Client) DeleteAll(ctx context.Context) error {
+	user, err := c.self(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.deleteAll(ctx, user, all)
+}
+
+// ListMember returns an organization member's sessions with the organization.
+func (c *Client) ListMember(ctx context.Context, organizationID, userID string) (openapi.Sessions, error) {
+	user, predicate, err := c.member(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.list(ctx, user, predicate)
+}
+
+// DeleteMember revokes one of an organization member's sessions with the organization.
+func (c *Client) DeleteMember(ctx context.Context, organizationID, userID, sessionID string) error {
+	user, predicate, err := c.member(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.delete(ctx, user, predicate, sessionID)
+}
+
+// DeleteAllMember revokes all of an organization member's sessions with the organization.
+func (c *Client) DeleteAllMember(ctx context.Context, organizationID, userID string) error {
+	user, predicate, err := c.member(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.deleteAll(ctx, user, predicate)
+}
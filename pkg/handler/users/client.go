@@ -83,6 +83,13 @@ func (o *Options) AddFlags(f *pflag.FlagSet) {
 	f.StringVar(&o.smtpCredentialsSecret, "smtp-credentials-secret", "unikorn-smtp-credentials", "Secret containing username and password keys for SMTP verification.")
 }
 
+// Authenticator is the part of the oauth2 authenticator used to terminate user
+// sessions, it's defined here to avoid an import cycle.
+type Authenticator interface {
+	// LogoutUser terminates all of a user's sessions and notifies clients.
+	LogoutUser(ctx context.Context, issuer string, user *unikornv1.User, initiatorClientID string) error
+}
+
 // Client is responsible for user management.
 type Client struct {
 	// host is the hostname of this service.
@@ -93,18 +100,21 @@ type Client struct {
 	namespace string
 	// issuer for creating signup tokens.
 	issuer *jose.JWTIssuer
+	// authenticator for terminating user sessions.
+	authenticator Authenticator
 	// options are any options to be passed to the handler.
 	options *Options
 }
 
 // New creates a new user client.
-func New(host string, client client.Client, namespace string, issuer *jose.JWTIssuer, options *Options) *Client {
+func New(host string, client client.Client, namespace string, issuer *jose.JWTIssuer, authenticator Authenticator, options *Options) *Client {
 	return &Client{
-		host:      host,
-		client:    client,
-		namespace: namespace,
-		issuer:    issuer,
-		options:   options,
+		host:          host,
+		client:        client,
+		namespace:     namespace,
+		issuer:        issuer,
+		authenticator: authenticator,
+		options:       options,
 	}
 }
 
@@ -119,6 +129,18 @@ func (c *Client) listGroups(ctx context.Context, organization *organizations.Met
 	return result, nil
 }
 
+// listSessions returns all user sessions, these are global so are kept in the
+// identity namespace alongside users.
+func (c *Client) listSessions(ctx context.Context) (*unikornv1.UserSessionList, error) {
+	result := &unikornv1.UserSessionList{}
+
+	if err := c.client.List(ctx, result, &client.ListOptions{Namespace: c.namespace}); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list user sessions").WithError(err)
+	}
+
+	return result, nil
+}
+
 // updateGroups takes a user name and a requested list of groups and adds to
 // the groups it should be a member of and removes itself from groups it shouldn't.
 func (c *Client) updateGroups(ctx context.Context, userID string, groupIDs openapi.GroupIDs, groups *unikornv1.GroupList) error {
@@ -238,34 +260,45 @@ func convertUserState(in unikornv1.UserState) openapi.UserState {
 	return ""
 }
 
-func convert(in *unikornv1.OrganizationUser, user *unikornv1.User, groups *unikornv1.GroupList) *openapi.UserRead {
-	out := &openapi.UserRead{
-		Metadata: conversion.OrganizationScopedResourceReadMetadata(in, in.Spec.Tags, coreopenapi.ResourceProvisioningStatusProvisioned),
-		Spec: openapi.UserSpec{
-			Subject:  user.Spec.Subject,
-			State:    convertUserState(in.Spec.State),
-			GroupIDs: make(openapi.GroupIDs, 0, len(groups.Items)),
-		},
-	}
-
-	var lastActive *metav1.Time
+// lastActive returns when the user last authenticated with any client.  Sessions
+// that are still embedded in the user are considered until they are migrated.
+func lastActive(user *unikornv1.User, userSessions *unikornv1.UserSessionList) *metav1.Time {
+	var result *metav1.Time
 
-	for _, session := range user.Spec.Sessions {
+	update := func(session *unikornv1.UserSessionSpec) {
 		if session.LastAuthentication == nil {
-			continue
+			return
 		}
 
-		if lastActive == nil {
-			lastActive = session.LastAuthentication
-			continue
+		if result == nil || session.LastAuthentication.Time.After(result.Time) {
+			result = session.LastAuthentication
 		}
+	}
 
-		if session.LastAuthentication.Time.After(lastActive.Time) {
-			lastActive = session.LastAuthentication
+	for i := range user.Spec.Sessions {
+		update(&user.Spec.Sessions[i])
+	}
+
+	for i := range userSessions.Items {
+		if userSessions.Items[i].Labels[constants.UserLabel] == user.Name {
+			update(&userSessions.Items[i].Spec)
 		}
 	}
 
-	if lastActive != nil {
+	return result
+}
+
+func convert(in *unikornv1.OrganizationUser, user *unikornv1.User, userSessions *unikornv1.UserSessionList, groups *unikornv1.GroupList) *openapi.UserRead {
+	out := &openapi.UserRead{
+		Metadata: conversion.OrganizationScopedResourceReadMetadata(in, in.Spec.Tags, coreopenapi.ResourceProvisioningStatusProvisioned),
+		Spec: openapi.UserSpec{
+			Subject:  user.Spec.Subject,
+			State:    convertUserState(in.Spec.State),
+			GroupIDs: make(openapi.GroupIDs, 0, len(groups.Items)),
+		},
+	}
+
+	if lastActive := lastActive(user, userSessions); lastActive != nil {
 		out.Status.LastActive = &lastActive.Time
 	}
 
@@ -278,7 +311,7 @@ func convert(in *unikornv1.OrganizationUser, user *unikornv1.User, groups *uniko
 	return out
 }
 
-func convertList(in *unikornv1.OrganizationUserList, users *unikornv1.UserList, groups *unikornv1.GroupList) (openapi.Users, error) {
+func convertList(in *unikornv1.OrganizationUserList, users *unikornv1.UserList, userSessions *unikornv1.UserSessionList, groups *unikornv1.GroupList) (openapi.Users, error) {
 	out := make(openapi.Users, len(in.Items))
 
 	for i := range in.Items {
@@ -290,7 +323,7 @@ func convertList(in *unikornv1.OrganizationUserList, users *unikornv1.UserList,
 			return nil, errors.OAuth2ServerError("failed to lookup user")
 		}
 
-		out[i] = *convert(&in.Items[i], &users.Items[index], groups)
+		out[i] = *convert(&in.Items[i], &users.Items[index], userSessions, groups)
 	}
 
 	slices.SortStableFunc(out, func(a, b openapi.UserRead) int {
@@ -676,7 +709,12 @@ func (c *Client) Create(ctx context.Context, organizationID string, request *ope
 		return nil, err
 	}
 
-	return convert(resource, user, groups), nil
+	userSessions, err := c.listSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return convert(resource, user, userSessions, groups), nil
 }
 
 // List retrieves information about all users in the organization.
@@ -698,12 +736,17 @@ func (c *Client) List(ctx context.Context, organizationID string) (openapi.Users
 		return nil, errors.OAuth2ServerError("failed to list users").WithError(err)
 	}
 
+	userSessions, err := c.listSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	groups, err := c.listGroups(ctx, organization)
 	if err != nil {
 		return nil, err
 	}
 
-	return convertList(result, users, groups)
+	return convertList(result, users, userSessions, groups)
 }
 
 // Update modifies any metadata for the user if it exists.  If a matching account
@@ -742,6 +785,14 @@ func (c *Client) Update(ctx context.Context, organizationID, userID string, requ
 		return nil, errors.OAuth2ServerError("failed to patch group").WithError(err)
 	}
 
+	// Suspension must take effect immediately, so terminate any sessions and let
+	// the clients know.
+	if current.Spec.State != unikornv1.UserStateSuspended && updated.Spec.State == unikornv1.UserStateSuspended {
+		if err := c.authenticator.LogoutUser(ctx, "https://"+c.host, user, ""); err != nil {
+			return nil, errors.OAuth2ServerError("failed to terminate user sessions").WithError(err)
+		}
+	}
+
 	groups, err := c.listGroups(ctx, organization)
 	if err != nil {
 		return nil, err
@@ -756,7 +807,12 @@ func (c *Client) Update(ctx context.Context, organizationID, userID string, requ
 		return nil, err
 	}
 
-	return convert(updated, user, groups), nil
+	userSessions, err := c.listSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return convert(updated, user, userSessions, groups), nil
 }
 
 // Delete removes the user and revokes the access token.
@@ -32,6 +32,15 @@ var (
 	//go:embed login.html.tmpl
 	loginTemplate string
 
+	// deviceTemplate defines the HTML used to acquire a device authorization
+	// user code from the end user.
+	//go:embed device.html.tmpl
+	deviceTemplate string
+
+	// logoutTemplate defines the HTML used to confirm logout to the end user.
+	//go:embed logout.html.tmpl
+	logoutTemplate string
+
 	// welcomeEmail defines the HTML used to welcome a user to an organization.
 	//go:embed welcome-email.html.tmpl
 	welcomeEmailTemplate string
@@ -78,6 +87,62 @@ func Login(state string) ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
+// Device renders a default device user code entry screen.
+func Device(userCode string) ([]byte, error) {
+	return device(map[string]interface{}{
+		"userCode": userCode,
+	})
+}
+
+// DeviceConsent renders a default device consent screen, naming the client that
+// is requesting access, the approval is posted back with the user's decision.
+func DeviceConsent(clientName, userCode, approval string) ([]byte, error) {
+	return device(map[string]interface{}{
+		"clientName": clientName,
+		"userCode":   userCode,
+		"approval":   approval,
+	})
+}
+
+// DeviceComplete renders a default device login complete screen.
+func DeviceComplete(denied bool) ([]byte, error) {
+	return device(map[string]interface{}{
+		"complete": true,
+		"denied":   denied,
+	})
+}
+
+func device(templateContext map[string]interface{}) ([]byte, error) {
+	tmpl, err := template.New("device").Parse(deviceTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+
+	if err := tmpl.Execute(&buffer, templateContext); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// Logout renders a default logout confirmation screen.
+func Logout() ([]byte, error) {
+	tmpl, err := template.New("logout").Parse(logoutTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+
+	if err := tmpl.Execute(&buffer, nil); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
 // WelcomeEmail returns a default welcome email.
 func WelcomeEmail(verifyLink string) ([]byte, error) {
 	tmpl, err := template.New("welcome").Parse(welcomeEmailTemplate)
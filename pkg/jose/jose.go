@@ -133,6 +133,16 @@ func (*InClusterCoordinationClientGetter) Client() (coordinationv1.CoordinationV
 	return coordination, nil
 }
 
+// ConfigCoordinationClientGetter creates a coordination client from an existing
+// configuration, e.g. when running outside of a cluster.
+type ConfigCoordinationClientGetter struct {
+	Config *rest.Config
+}
+
+func (g *ConfigCoordinationClientGetter) Client() (coordinationv1.CoordinationV1Interface, error) {
+	return coordinationv1.NewForConfig(g.Config)
+}
+
 // Run starts the certificate management loop.
 // The certificate itself is managed by cert-manager, as a reissue duration of N
 // and a lifetime of 2N.  Tokens may be issued for a maximum duration of N.  Tokens
@@ -506,10 +516,30 @@ const (
 	//nolint:gosec
 	TokenTypeRefreshToken TokenType = "unikorn-cloud.org/rt+jwt"
 
+	// TokenTypeDeviceCode is defined to prevent reuse in other contexts.
+	// This is only valid for device authorization grant device codes.
+	//nolint:gosec
+	TokenTypeDeviceCode TokenType = "unikorn-cloud.org/devicecode+jwt"
+
+	// TokenTypeDeviceApproval is defined to prevent reuse in other contexts.
+	// This is only valid for device authorization grant consent forms.
+	//nolint:gosec
+	TokenTypeDeviceApproval TokenType = "unikorn-cloud.org/deviceapproval+jwt"
+
 	// TokenTypeUserSignupToken is defined to prevent reuse in other contexts.
 	// This is only valid for user signup emails.
 	//nolint:gosec
 	TokenTypeUserSignupToken TokenType = "unikorn-cloud.org/userSignup+jwt"
+
+	// TokenTypeRegistrationAccessToken is defined to prevent reuse in other contexts.
+	// This is only valid for dynamic client registration management.
+	//nolint:gosec
+	TokenTypeRegistrationAccessToken TokenType = "unikorn-cloud.org/registration+jwt"
+
+	// TokenTypePushedAuthorizationRequest is defined to prevent reuse in other contexts.
+	// This is only valid for pushed authorization request URIs.
+	//nolint:gosec
+	TokenTypePushedAuthorizationRequest TokenType = "unikorn-cloud.org/par+jwt"
 )
 
 // EncodeJWEToken encodes, signs and encrypts as set of claims.
@@ -0,0 +1,38 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+)
+
+const (
+	audienceHeader = "Unikorn-Audience"
+)
+
+// PropagatedAudience returns the audience a service expects a token to be valid
+// for.  Anyone can set this header, so it must only be trusted when the caller has
+// authenticated as a system account with mTLS.
+func PropagatedAudience(header http.Header) string {
+	return header.Get(audienceHeader)
+}
+
+// InjectAudience is called by services when validating a token with the identity
+// service so delegated tokens are only accepted by the service they were issued for.
+func InjectAudience(header http.Header, audience string) {
+	header.Set(audienceHeader, audience)
+}
@@ -40,6 +40,14 @@ type Info struct {
 	SystemAccount bool
 	// ServiceAccount means this belongs explicitly to a service account.
 	ServiceAccount bool
+
+	// Actor is set when the token has been delegated to a system account
+	// via token exchange, and records that account's subject.
+	Actor string
+
+	// DPoPThumbprint is set when the token is DPoP bound, and records the
+	// thumbprint of the proof key the caller has proven possession of.
+	DPoPThumbprint string
 }
 
 type keyType int
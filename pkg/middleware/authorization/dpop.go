@@ -0,0 +1,73 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/unikorn-cloud/core/pkg/errors"
+)
+
+type dpopThumbprintKeyType int
+
+const (
+	dpopThumbprintKey dpopThumbprintKeyType = iota
+)
+
+// NewContextWithDPoPThumbprint is used to propagate the thumbprint of a verified DPoP
+// proof key to other services.  Only a service that has verified the proof should
+// set this, in the same way the client certificate is trusted by downstream services.
+func NewContextWithDPoPThumbprint(ctx context.Context, thumbprint string) context.Context {
+	return context.WithValue(ctx, dpopThumbprintKey, thumbprint)
+}
+
+func DPoPThumbprintFromContext(ctx context.Context) (string, error) {
+	if value := ctx.Value(dpopThumbprintKey); value != nil {
+		if thumbprint, ok := value.(string); ok {
+			return thumbprint, nil
+		}
+	}
+
+	return "", errors.ErrInvalidContext
+}
+
+const (
+	dpopThumbprintHeader = "Unikorn-DPoP-Thumbprint"
+)
+
+// PropagatedDPoPThumbprint returns a thumbprint verified by an upstream service.
+// Anyone can set this header, so it must only be trusted when the caller has
+// authenticated as a system account with mTLS.
+func PropagatedDPoPThumbprint(header http.Header) string {
+	return header.Get(dpopThumbprintHeader)
+}
+
+// InjectDPoPThumbprint is called by clients to propagate the thumbprint of the key
+// that the access token is bound to, and that the caller has proven possession of.
+// Returns true if the thumbprint was propagated, and the DPoP authorization scheme
+// should be used.
+func InjectDPoPThumbprint(ctx context.Context, header http.Header) bool {
+	thumbprint, err := DPoPThumbprintFromContext(ctx)
+	if err != nil || thumbprint == "" {
+		return false
+	}
+
+	header.Set(dpopThumbprintHeader, thumbprint)
+
+	return true
+}
@@ -19,7 +19,10 @@ package local
 
 import (
 	"context"
+	goerrors "errors"
+	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
@@ -27,15 +30,27 @@ import (
 	"github.com/unikorn-cloud/core/pkg/server/errors"
 	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
 	"github.com/unikorn-cloud/identity/pkg/oauth2"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/dpop"
 	"github.com/unikorn-cloud/identity/pkg/openapi"
 	"github.com/unikorn-cloud/identity/pkg/rbac"
 	"github.com/unikorn-cloud/identity/pkg/util"
 )
 
+var (
+	ErrUntrustedClient = goerrors.New("untrusted client")
+)
+
+const (
+	// dpopCacheSize is how many DPoP proofs to track for replay protection.
+	dpopCacheSize = 8192
+)
+
 // Authorizer provides OpenAPI based authorization middleware.
 type Authorizer struct {
 	authenticator *oauth2.Authenticator
 	rbac          *rbac.RBAC
+	// dpopVerifier checks proofs presented with DPoP bound tokens.
+	dpopVerifier *dpop.Verifier
 }
 
 // NewAuthorizer returns a new authorizer with required parameters.
@@ -43,6 +58,7 @@ func NewAuthorizer(authenticator *oauth2.Authenticator, rbac *rbac.RBAC) *Author
 	return &Authorizer{
 		authenticator: authenticator,
 		rbac:          rbac,
+		dpopVerifier:  dpop.NewVerifier(dpopCacheSize),
 	}
 }
 
@@ -69,7 +85,7 @@ func (a *Authorizer) authorizeOAuth2(r *http.Request) (*authorization.Info, erro
 		return nil, err
 	}
 
-	if !strings.EqualFold(authorizationScheme, "bearer") {
+	if !strings.EqualFold(authorizationScheme, "bearer") && !strings.EqualFold(authorizationScheme, dpop.Scheme) {
 		return nil, errors.OAuth2InvalidRequest("authorization scheme not allowed").WithValues("scheme", authorizationScheme)
 	}
 
@@ -78,9 +94,15 @@ func (a *Authorizer) authorizeOAuth2(r *http.Request) (*authorization.Info, erro
 		return nil, err
 	}
 
+	thumbprint, err := a.verifyDPoPBinding(r, authorizationScheme, token, claims.Confirmation)
+	if err != nil {
+		return nil, err
+	}
+
 	info := &authorization.Info{
-		Token:    token,
-		Userinfo: userinfo,
+		Token:          token,
+		Userinfo:       userinfo,
+		DPoPThumbprint: thumbprint,
 	}
 
 	switch claims.Type {
@@ -89,31 +111,167 @@ func (a *Authorizer) authorizeOAuth2(r *http.Request) (*authorization.Info, erro
 	case oauth2.TokenTypeServiceAccount:
 		info.ServiceAccount = true
 	case oauth2.TokenTypeService:
-		// All API requests will ultimately end up here as service call back
-		// into the identity service to validate the token presented to the API.
-		// If the token is bound to a certificate, we also expect the client
-		// certificate to be presented by the first client in the chain and
-		// propagated here.
-		certPEM, err := authorization.ClientCertFromContext(r.Context())
+		if err := verifyCertificateBinding(r, claims.Service.X509Thumbprint); err != nil {
+			return nil, err
+		}
+
+		info.SystemAccount = true
+	}
+
+	// Delegated tokens are bound to the actor's certificate in the same way as
+	// service tokens.
+	if claims.Actor != nil {
+		if err := verifyCertificateBinding(r, claims.Actor.X509Thumbprint); err != nil {
+			return nil, err
+		}
+
+		if err := a.verifyTargetAudience(r, claims); err != nil {
+			return nil, err
+		}
+
+		info.Actor = claims.Actor.Subject
+	}
+
+	return info, nil
+}
+
+// verifySystemAccount checks the caller itself, rather than the client that started
+// the call chain, is a registered system account authenticated with mTLS.
+func (a *Authorizer) verifySystemAccount(r *http.Request) error {
+	certPEM, err := util.GetClientCertificateHeader(r.Header)
+	if err != nil {
+		return err
+	}
+
+	certificate, err := util.GetClientCertificate(certPEM)
+	if err != nil {
+		return err
+	}
+
+	if !a.rbac.IsSystemAccount(certificate.Subject.CommonName) {
+		return fmt.Errorf("%w: '%s' is not a system account", ErrUntrustedClient, certificate.Subject.CommonName)
+	}
+
+	return nil
+}
+
+// verifyTargetAudience checks a delegated token is only used with the service it
+// was exchanged for.  Services validate tokens via the identity service, so the
+// audience is propagated by the service's system account, otherwise the token is
+// being used with the identity service directly.
+func (a *Authorizer) verifyTargetAudience(r *http.Request, claims *oauth2.Claims) error {
+	audience := r.Host
+
+	if propagated := authorization.PropagatedAudience(r.Header); propagated != "" {
+		if err := a.verifySystemAccount(r); err != nil {
+			return errors.OAuth2AccessDenied("audience propagated by an untrusted client").WithError(err)
+		}
+
+		audience = propagated
+	}
+
+	// The identity service is always an audience, as only it can decrypt the
+	// token, so isn't a valid target unless it's the only audience.
+	targets := slices.DeleteFunc(slices.Clone(claims.Audience), func(target string) bool {
+		return target == r.Host
+	})
+
+	if len(targets) == 0 {
+		targets = []string{r.Host}
+	}
+
+	if !slices.Contains(targets, audience) {
+		return errors.OAuth2AccessDenied("delegated token is not valid for this audience")
+	}
+
+	return nil
+}
+
+// propagatedDPoPThumbprint returns the key thumbprint propagated by a service that
+// has already verified a DPoP proof.  This is only trusted when the caller itself is
+// a registered system account authenticated with mTLS, otherwise anyone holding a
+// stolen token could bypass proof of possession by forging the header.
+func (a *Authorizer) propagatedDPoPThumbprint(r *http.Request) (string, error) {
+	if err := a.verifySystemAccount(r); err != nil {
+		return "", errors.OAuth2AccessDenied("DPoP proof not present for bound token").WithError(err)
+	}
+
+	thumbprint := authorization.PropagatedDPoPThumbprint(r.Header)
+	if thumbprint == "" {
+		return "", errors.OAuth2AccessDenied("DPoP proof not present for bound token")
+	}
+
+	return thumbprint, nil
+}
+
+// verifyDPoPBinding checks a DPoP bound token is presented by the key owner, either
+// with a proof, or via a system account that has already verified the proof and
+// propagated the key thumbprint, in the same way as bound certificates.  This also prevents
+// bound tokens being downgraded to bearer tokens.  Returns the verified thumbprint
+// for bound tokens.
+func (a *Authorizer) verifyDPoPBinding(r *http.Request, scheme, token string, confirmation *oauth2.ConfirmationClaims) (string, error) {
+	isDPoP := strings.EqualFold(scheme, dpop.Scheme)
+
+	if confirmation == nil {
+		if isDPoP {
+			return "", errors.OAuth2InvalidRequest("token is not DPoP bound")
+		}
+
+		return "", nil
+	}
+
+	if !isDPoP {
+		return "", errors.OAuth2AccessDenied("DPoP bound token presented as a bearer token")
+	}
+
+	var thumbprint string
+
+	if dpop.Present(r) {
+		t, err := a.dpopVerifier.Verify(r, token)
 		if err != nil {
-			return nil, errors.OAuth2AccessDenied("client certificate not present for bound token").WithError(err)
+			return "", errors.OAuth2AccessDenied("DPoP proof invalid").WithError(err)
 		}
 
-		certificate, err := util.GetClientCertificate(certPEM)
+		thumbprint = t
+	} else {
+		t, err := a.propagatedDPoPThumbprint(r)
 		if err != nil {
-			return nil, errors.OAuth2AccessDenied("client certificate parse error").WithError(err)
+			return "", err
 		}
 
-		thumbprint := util.GetClientCertiifcateThumbprint(certificate)
+		thumbprint = t
+	}
 
-		if thumbprint != claims.Service.X509Thumbprint {
-			return nil, errors.OAuth2AccessDenied("client certificate mismatch for bound token")
-		}
+	if thumbprint != confirmation.JWKThumbprint {
+		return "", errors.OAuth2AccessDenied("DPoP key mismatch for bound token")
+	}
 
-		info.SystemAccount = true
+	return thumbprint, nil
+}
+
+// verifyCertificateBinding checks a bound token is presented by the certificate owner.
+// All API requests will ultimately end up here as service call back into the identity
+// service to validate the token presented to the API.  If the token is bound to a
+// certificate, we also expect the client certificate to be presented by the first
+// client in the chain and propagated here.
+func verifyCertificateBinding(r *http.Request, expected string) error {
+	certPEM, err := authorization.ClientCertFromContext(r.Context())
+	if err != nil {
+		return errors.OAuth2AccessDenied("client certificate not present for bound token").WithError(err)
 	}
 
-	return info, nil
+	certificate, err := util.GetClientCertificate(certPEM)
+	if err != nil {
+		return errors.OAuth2AccessDenied("client certificate parse error").WithError(err)
+	}
+
+	thumbprint := util.GetClientCertiifcateThumbprint(certificate)
+
+	if thumbprint != expected {
+		return errors.OAuth2AccessDenied("client certificate mismatch for bound token")
+	}
+
+	return nil
 }
 
 // Authorize checks the request against the OpenAPI security scheme.
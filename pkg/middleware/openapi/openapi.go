@@ -198,6 +198,10 @@ func (v *Validator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		// and the ACL layer to use.
 		ctx = authorization.NewContext(ctx, v.info)
 
+		// Only propagate a DPoP thumbprint if the token is bound and the proof
+		// has been verified, this overrides anything extracted from the headers.
+		ctx = authorization.NewContextWithDPoPThumbprint(ctx, v.info.DPoPThumbprint)
+
 		// The organizationID parameter is standardized across all services.
 		// NOTE: this can legitimately be undefined, but the ACL code will handle
 		// that and only look for globally scoped roles.
@@ -19,6 +19,9 @@ package authorizer
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -33,11 +36,13 @@ import (
 	identityclient "github.com/unikorn-cloud/identity/pkg/client"
 	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
 	"github.com/unikorn-cloud/identity/pkg/middleware/openapi"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/dpop"
 	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
 
 	"k8s.io/apimachinery/pkg/util/cache"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 // Authorizer provides OpenAPI based authorization middleware.
@@ -48,6 +53,20 @@ type Authorizer struct {
 	// tokenCache is used to enhance interaction as the validation is a
 	// very expensive operation.
 	tokenCache *cache.LRUExpireCache
+	// dpopVerifier checks DPoP proofs for bound tokens.
+	dpopVerifier *dpop.Verifier
+}
+
+// tokenCacheEntry remembers the userinfo of a validated token, the
+// DPoP key thumbprint it was presented with, if any, and the audience
+// it was validated for.
+type tokenCacheEntry struct {
+	userinfo   *identityapi.Userinfo
+	thumbprint string
+	audience   string
+	// verified is when the token was verified, revocations before then
+	// are already accounted for.
+	verified time.Time
 }
 
 var _ openapi.Authorizer = &Authorizer{}
@@ -60,7 +79,8 @@ func NewAuthorizer(client client.Client, options *identityclient.Options, client
 		clientOptions: clientOptions,
 		// TODO: make this configurable, possibly even a shared flag with the
 		// authorizer to maintain consistency.
-		tokenCache: cache.NewLRUExpireCache(4096),
+		tokenCache:   cache.NewLRUExpireCache(4096),
+		dpopVerifier: dpop.NewVerifier(4096),
 	}
 }
 
@@ -127,19 +147,48 @@ func (a *Authorizer) authorizeOAuth2(r *http.Request) (*authorization.Info, erro
 		return nil, err
 	}
 
-	if !strings.EqualFold(authorizationScheme, "bearer") {
+	isDPoP := strings.EqualFold(authorizationScheme, dpop.Scheme)
+
+	if !isDPoP && !strings.EqualFold(authorizationScheme, "bearer") {
 		return nil, errors.OAuth2InvalidRequest("authorization scheme not allowed").WithValues("scheme", authorizationScheme)
 	}
 
+	// DPoP proofs are verified here, as they are bound to this request, then
+	// the thumbprint is propagated to the identity service for it to check the
+	// token binding.
+	var thumbprint string
+
+	if isDPoP {
+		t, err := a.dpopVerifier.Verify(r, rawToken)
+		if err != nil {
+			return nil, errors.OAuth2AccessDenied("DPoP proof invalid").WithError(err)
+		}
+
+		thumbprint = t
+
+		ctx = authorization.NewContextWithDPoPThumbprint(ctx, thumbprint)
+	}
+
 	if value, ok := a.tokenCache.Get(rawToken); ok {
-		claims, ok := value.(*identityapi.Userinfo)
+		entry, ok := value.(*tokenCacheEntry)
 		if !ok {
 			return nil, errors.OAuth2ServerError("invalid token cache data")
 		}
 
+		// Cached tokens must be presented in the same way as when they were
+		// validated, so bound tokens cannot be downgraded to bearer tokens.
+		if entry.thumbprint != thumbprint {
+			return nil, errors.OAuth2AccessDenied("DPoP key mismatch for token")
+		}
+
+		if entry.audience != r.Host {
+			return nil, errors.OAuth2AccessDenied("audience mismatch for token")
+		}
+
 		info := &authorization.Info{
-			Token:    rawToken,
-			Userinfo: claims,
+			Token:          rawToken,
+			Userinfo:       entry.userinfo,
+			DPoPThumbprint: entry.thumbprint,
 		}
 
 		return info, nil
@@ -154,9 +203,13 @@ func (a *Authorizer) authorizeOAuth2(r *http.Request) (*authorization.Info, erro
 	}
 
 	// NOTE: The mutation is required to do trace context propagation.
+	// Delegated tokens are restricted to a target service, so let the identity
+	// service know who we are.
 	mutator := func(req *http.Request) error {
 		mutator := identityclient.RequestMutator(nil)
 
+		authorization.InjectAudience(req.Header, r.Host)
+
 		return mutator(ctx, req)
 	}
 
@@ -201,11 +254,19 @@ func (a *Authorizer) authorizeOAuth2(r *http.Request) (*authorization.Info, erro
 	// The cache entry needs a timeout as a federated user may have had their rights
 	// recinded and we don't know about it, and long lived tokens e.g. service accounts,
 	// could still be valid for months...
-	a.tokenCache.Add(rawToken, claims, time.Hour)
+	entry := &tokenCacheEntry{
+		userinfo:   claims,
+		thumbprint: thumbprint,
+		audience:   r.Host,
+		verified:   time.Now(),
+	}
+
+	a.tokenCache.Add(rawToken, entry, time.Hour)
 
 	out := &authorization.Info{
-		Token:    rawToken,
-		Userinfo: claims,
+		Token:          rawToken,
+		Userinfo:       claims,
+		DPoPThumbprint: thumbprint,
 	}
 
 	return out, nil
@@ -263,3 +324,153 @@ func (a *Authorizer) GetACL(ctx context.Context, organizationID string) (*identi
 
 	return response.JSON200, nil
 }
+
+// tokenHash returns the hash of a token as published by the revocation feed.
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// revoke evicts cached tokens that have been revoked.  Clocks may differ slightly
+// so partial resets are widened by the overlap.
+func (a *Authorizer) revoke(revocations *identityapi.Revocations, overlap time.Duration) {
+	if revocations.Reset {
+		if revocations.Start == nil {
+			a.tokenCache.RemoveAll(func(any) bool {
+				return true
+			})
+
+			return
+		}
+
+		start := revocations.Start.Add(overlap)
+
+		for _, key := range a.tokenCache.Keys() {
+			value, ok := a.tokenCache.Get(key)
+			if !ok {
+				continue
+			}
+
+			if entry, ok := value.(*tokenCacheEntry); !ok || entry.verified.Before(start) {
+				a.tokenCache.Remove(key)
+			}
+		}
+
+		return
+	}
+
+	if len(revocations.Events) == 0 {
+		return
+	}
+
+	subjects := map[string]bool{}
+	hashes := map[string]bool{}
+
+	for _, event := range revocations.Events {
+		if event.Subject != nil {
+			subjects[*event.Subject] = true
+		}
+
+		if event.TokenHash != nil {
+			hashes[*event.TokenHash] = true
+		}
+	}
+
+	for _, key := range a.tokenCache.Keys() {
+		rawToken, ok := key.(string)
+		if !ok {
+			continue
+		}
+
+		value, ok := a.tokenCache.Get(key)
+		if !ok {
+			continue
+		}
+
+		entry, ok := value.(*tokenCacheEntry)
+		if !ok {
+			continue
+		}
+
+		if subjects[entry.userinfo.Sub] || (len(hashes) > 0 && hashes[tokenHash(rawToken)]) {
+			a.tokenCache.Remove(key)
+		}
+	}
+}
+
+// revocationPoller tracks the state of the revocation feed.
+type revocationPoller struct {
+	issuer *identityclient.TokenIssuer
+	// token is the service's access token, reused until it's rejected.
+	token *identityclient.AccessTokenGetter
+	// since is the time to poll from.
+	since time.Time
+}
+
+// poll reads the revocation feed, and evicts any revoked tokens.
+func (a *Authorizer) poll(ctx context.Context, poller *revocationPoller, overlap time.Duration) error {
+	if poller.token == nil {
+		token, err := poller.issuer.Issue(ctx, "revocation feed")
+		if err != nil {
+			return err
+		}
+
+		poller.token = token
+	}
+
+	client, err := identityclient.New(a.client, a.options, a.clientOptions).Client(ctx, poller.token)
+	if err != nil {
+		return err
+	}
+
+	params := &identityapi.GetApiV1RevocationsParams{
+		Since: poller.since,
+	}
+
+	response, err := client.GetApiV1RevocationsWithResponse(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		// Most likely the token has expired, so get a new one next time.
+		poller.token = nil
+
+		return fmt.Errorf("%w: revocation feed status code %d", identityclient.ErrResponse, response.StatusCode())
+	}
+
+	a.revoke(response.JSON200, overlap)
+
+	// Replicas may be serving subsequent requests and their clocks may differ
+	// slightly, so overlap requests, revocations are idempotent.
+	poller.since = response.JSON200.Time.Add(-overlap)
+
+	return nil
+}
+
+// WatchRevocations polls the identity service's revocation feed and evicts revoked
+// tokens from the cache, so revocations take effect within the polling interval
+// rather than the cache lifetime.  This runs until the context is cancelled, and the
+// service's system account must be granted read access to identity:revocations.
+func (a *Authorizer) WatchRevocations(ctx context.Context, serviceName, serviceVersion string, interval time.Duration) {
+	poller := &revocationPoller{
+		issuer: identityclient.NewTokenIssuer(a.client, a.options, a.clientOptions, serviceName, serviceVersion),
+		since:  time.Now(),
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := a.poll(ctx, poller, interval); err != nil {
+			log.FromContext(ctx).Info("failed to poll revocation feed", "error", err)
+		}
+	}
+}
@@ -0,0 +1,432 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/google/uuid"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// backchannelLogoutMaxBackoff caps the delay between delivery attempts.
+	backchannelLogoutMaxBackoff = 5 * time.Minute
+)
+
+var (
+	// ErrBackchannelLogout is raised when a client doesn't acknowledge a
+	// logout token.
+	ErrBackchannelLogout = errors.New("back-channel logout failed")
+)
+
+// LogoutUser terminates all of a user's sessions, and notifies every client that
+// had a session, other than the one that initiated the logout, if any, via OIDC
+// back-channel logout.  Notifications are recorded as pending in the user's status,
+// and delivered asynchronously with retries by RunBackchannelLogout.
+func (a *Authenticator) LogoutUser(ctx context.Context, issuer string, user *unikornv1.User, initiatorClientID string) error {
+	return a.LogoutUserSessions(ctx, issuer, user, initiatorClientID, func(*unikornv1.UserSession) bool {
+		return true
+	})
+}
+
+// LogoutUserSessions is like LogoutUser, but only terminates the user's sessions that
+// match the predicate.
+func (a *Authenticator) LogoutUserSessions(ctx context.Context, issuer string, user *unikornv1.User, initiatorClientID string, predicate func(*unikornv1.UserSession) bool) error {
+	allSessions, err := a.sessions.List(ctx, user.Name)
+	if err != nil {
+		return err
+	}
+
+	userSessions := slices.DeleteFunc(allSessions, func(session unikornv1.UserSession) bool {
+		return !predicate(&session)
+	})
+
+	for i := range userSessions {
+		if err := a.deleteSession(ctx, &userSessions[i]); err != nil {
+			return err
+		}
+	}
+
+	now := metav1.Now()
+
+	var pending []unikornv1.UserBackchannelLogout
+
+	for i := range userSessions {
+		session := &userSessions[i].Spec
+
+		if session.ClientID == initiatorClientID {
+			continue
+		}
+
+		// Clients may have multiple sessions, each is notified separately so
+		// the client only terminates those that have been.
+		if slices.ContainsFunc(pending, func(record unikornv1.UserBackchannelLogout) bool {
+			return record.ClientID == session.ClientID && record.SessionID == session.ID
+		}) {
+			continue
+		}
+
+		oauth2client, err := a.lookupClient(ctx, session.ClientID)
+		if err != nil {
+			log.FromContext(ctx).Info("oauth2: failed to lookup client for back-channel logout", "client", session.ClientID, "error", err)
+			continue
+		}
+
+		if oauth2client.Spec.BackchannelLogoutURI == nil {
+			continue
+		}
+
+		pending = append(pending, unikornv1.UserBackchannelLogout{
+			ClientID:  session.ClientID,
+			SessionID: session.ID,
+			Issuer:    issuer,
+			Created:   now,
+		})
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// Only the latest outcome for a client is of interest, so completed records
+	// are replaced by the new ones.
+	superseded := func(record unikornv1.UserBackchannelLogout) bool {
+		return !a.backchannelLogoutPending(&record) && slices.ContainsFunc(pending, func(p unikornv1.UserBackchannelLogout) bool {
+			return p.ClientID == record.ClientID
+		})
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		current := &unikornv1.User{}
+
+		if err := a.client.Get(ctx, client.ObjectKeyFromObject(user), current); err != nil {
+			return err
+		}
+
+		updated := current.DeepCopy()
+		updated.Status.BackchannelLogouts = append(slices.DeleteFunc(updated.Status.BackchannelLogouts, superseded), pending...)
+
+		return a.client.Status().Update(ctx, updated)
+	})
+}
+
+// backchannelLogoutPending returns whether a notification is still to be delivered.
+func (a *Authenticator) backchannelLogoutPending(record *unikornv1.UserBackchannelLogout) bool {
+	return !record.Delivered && record.Attempts < max(a.options.BackchannelLogoutAttempts, 1)
+}
+
+// backchannelLogoutNextAttempt returns when a notification is next due for delivery,
+// failed attempts are retried with exponential back-off.
+func backchannelLogoutNextAttempt(record *unikornv1.UserBackchannelLogout) time.Time {
+	if record.Time == nil || record.Attempts == 0 {
+		return record.Created.Time
+	}
+
+	backoff := time.Second << min(record.Attempts-1, 16)
+
+	return record.Time.Add(min(backoff, backchannelLogoutMaxBackoff))
+}
+
+// sameBackchannelLogout returns whether two records are for the same notification.
+func sameBackchannelLogout(a, b *unikornv1.UserBackchannelLogout) bool {
+	return a.ClientID == b.ClientID && a.SessionID == b.SessionID && a.Created.Equal(&b.Created)
+}
+
+// RunBackchannelLogout delivers pending back-channel logout notifications recorded
+// against users.  As they are persisted, they survive restarts, and any replica can
+// deliver them, with optimistic locking ensuring only one does per attempt.  It runs
+// until the context is cancelled.
+func (a *Authenticator) RunBackchannelLogout(ctx context.Context, informers cache.Informers) error {
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	enqueue := func(obj any) {
+		user, ok := obj.(*unikornv1.User)
+		if !ok {
+			return
+		}
+
+		if slices.ContainsFunc(user.Status.BackchannelLogouts, func(record unikornv1.UserBackchannelLogout) bool {
+			return a.backchannelLogoutPending(&record)
+		}) {
+			queue.Add(user.Name)
+		}
+	}
+
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(_, newObj any) {
+			enqueue(newObj)
+		},
+	}
+
+	informer, err := informers.GetInformer(ctx, &unikornv1.User{})
+	if err != nil {
+		return err
+	}
+
+	if _, err := informer.AddEventHandler(handler); err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		queue.ShutDown()
+	}()
+
+	go func() {
+		for a.processBackchannelLogout(ctx, queue) {
+		}
+	}()
+
+	return nil
+}
+
+// processBackchannelLogout handles a single item from the work queue, returning false
+// when the queue has been shut down.
+func (a *Authenticator) processBackchannelLogout(ctx context.Context, queue workqueue.TypedRateLimitingInterface[string]) bool {
+	userID, shutdown := queue.Get()
+	if shutdown {
+		return false
+	}
+
+	defer queue.Done(userID)
+
+	requeue, err := a.reconcileBackchannelLogout(ctx, userID)
+	if err != nil {
+		log.FromContext(ctx).Info("oauth2: back-channel logout reconcile failed", "user", userID, "error", err)
+
+		queue.AddRateLimited(userID)
+
+		return true
+	}
+
+	queue.Forget(userID)
+
+	if requeue != 0 {
+		queue.AddAfter(userID, requeue)
+	}
+
+	return true
+}
+
+// nextBackchannelLogout returns the index of a notification that is due for delivery,
+// or if there are none, how long until the next one is due, if any.
+func (a *Authenticator) nextBackchannelLogout(user *unikornv1.User) (int, time.Duration) {
+	var requeue time.Duration
+
+	for i := range user.Status.BackchannelLogouts {
+		record := &user.Status.BackchannelLogouts[i]
+
+		if !a.backchannelLogoutPending(record) {
+			continue
+		}
+
+		wait := time.Until(backchannelLogoutNextAttempt(record))
+		if wait <= 0 {
+			return i, 0
+		}
+
+		if requeue == 0 || wait < requeue {
+			requeue = wait
+		}
+	}
+
+	return -1, requeue
+}
+
+// reconcileBackchannelLogout attempts delivery of any of the user's notifications that
+// are due, and returns how long until the next one is due, if any.
+func (a *Authenticator) reconcileBackchannelLogout(ctx context.Context, userID string) (time.Duration, error) {
+	user := &unikornv1.User{}
+
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: a.namespace, Name: userID}, user); err != nil {
+		if kerrors.IsNotFound(err) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	for {
+		index, requeue := a.nextBackchannelLogout(user)
+		if index < 0 {
+			return requeue, nil
+		}
+
+		updated, err := a.attemptBackchannelLogout(ctx, user, index)
+		if err != nil {
+			return 0, err
+		}
+
+		user = updated
+	}
+}
+
+// attemptBackchannelLogout makes a single delivery attempt and records the outcome,
+// returning the updated user.
+func (a *Authenticator) attemptBackchannelLogout(ctx context.Context, user *unikornv1.User, index int) (*unikornv1.User, error) {
+	user = user.DeepCopy()
+
+	record := user.Status.BackchannelLogouts[index]
+
+	// Claim the attempt, if another replica has got there first, then the
+	// update will conflict.
+	record.Attempts++
+	record.Time = ptr.To(metav1.Now())
+
+	user.Status.BackchannelLogouts[index] = record
+
+	if err := a.client.Status().Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if err := a.backchannelLogoutDeliver(ctx, user, &record); err != nil {
+		log.FromContext(ctx).Info("oauth2: back-channel logout failed", "user", user.Name, "client", record.ClientID, "attempts", record.Attempts, "error", err)
+
+		record.Error = ptr.To(err.Error())
+	} else {
+		record.Delivered = true
+		record.Error = nil
+	}
+
+	record.Time = ptr.To(metav1.Now())
+
+	return a.backchannelLogoutRecord(ctx, user, &record)
+}
+
+// backchannelLogoutDeliver generates and posts the logout token to the client.
+func (a *Authenticator) backchannelLogoutDeliver(ctx context.Context, user *unikornv1.User, record *unikornv1.UserBackchannelLogout) error {
+	oauth2client, err := a.lookupClient(ctx, record.ClientID)
+	if err != nil {
+		return err
+	}
+
+	if oauth2client.Spec.BackchannelLogoutURI == nil {
+		return fmt.Errorf("%w: client no longer has a back-channel logout URI", ErrBackchannelLogout)
+	}
+
+	now := time.Now()
+
+	claims := &oidc.LogoutToken{
+		Claims: jwt.Claims{
+			ID:      uuid.New().String(),
+			Issuer:  record.Issuer,
+			Subject: user.Spec.Subject,
+			Audience: jwt.Audience{
+				record.ClientID,
+			},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(2 * time.Minute)),
+		},
+		Events: map[string]struct{}{
+			oidc.BackchannelLogoutEvent: {},
+		},
+		SessionID: record.SessionID,
+	}
+
+	token, err := a.issuer.EncodeJWT(ctx, claims)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("logout_token", token)
+
+	httpClient := &http.Client{
+		Timeout: a.options.BackchannelLogoutTimeout,
+	}
+
+	return a.backchannelLogoutPost(ctx, httpClient, *oauth2client.Spec.BackchannelLogoutURI, form)
+}
+
+// backchannelLogoutPost does a single delivery attempt.
+func (a *Authenticator) backchannelLogoutPost(ctx context.Context, httpClient *http.Client, uri string, form url.Values) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("%w: unexpected status code %d", ErrBackchannelLogout, response.StatusCode)
+	}
+
+	return nil
+}
+
+// backchannelLogoutRecord updates the user's status with the delivery outcome, and
+// returns the updated user.  The user we have is most likely the latest version,
+// having just been updated, so try that first as the cache may lag behind.
+func (a *Authenticator) backchannelLogoutRecord(ctx context.Context, user *unikornv1.User, record *unikornv1.UserBackchannelLogout) (*unikornv1.User, error) {
+	updated := user.DeepCopy()
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		for i := range updated.Status.BackchannelLogouts {
+			if sameBackchannelLogout(&updated.Status.BackchannelLogouts[i], record) {
+				updated.Status.BackchannelLogouts[i] = *record
+			}
+		}
+
+		err := a.client.Status().Update(ctx, updated)
+		if err == nil || !kerrors.IsConflict(err) {
+			return err
+		}
+
+		updated = &unikornv1.User{}
+
+		if getErr := a.client.Get(ctx, client.ObjectKeyFromObject(user), updated); getErr != nil {
+			return getErr
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
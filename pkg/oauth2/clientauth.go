@@ -0,0 +1,409 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	gojose "github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// clientAssertionType is the only client assertion type we support, as
+	// defined by RFC 7523.
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	// clientAssertionMaxLifetime bounds how far in the future an assertion may
+	// expire, and therefore how long it needs to be recorded to detect reuse.
+	clientAssertionMaxLifetime = 5 * time.Minute
+)
+
+//nolint:gochecknoglobals
+var (
+	// clientSecretJWTSigningAlgorithms are the algorithms that can be used to
+	// sign client assertions with the client secret.
+	clientSecretJWTSigningAlgorithms = []gojose.SignatureAlgorithm{
+		gojose.HS256,
+		gojose.HS384,
+		gojose.HS512,
+	}
+)
+
+// ClientAssertionSigningAlgorithms returns the algorithms accepted for client
+// assertions at the token endpoint.
+func ClientAssertionSigningAlgorithms() []string {
+	algorithms := make([]string, 0, len(clientSecretJWTSigningAlgorithms)+len(requestObjectSigningAlgorithms))
+
+	for _, algorithm := range slices.Concat(clientSecretJWTSigningAlgorithms, requestObjectSigningAlgorithms) {
+		algorithms = append(algorithms, string(algorithm))
+	}
+
+	return algorithms
+}
+
+// ClientSecret reads the plain text client secret from the Kubernetes secret it
+// is written to, and checks it matches the current hash, so callers can wait for
+// the secret to be visible after creation or rotation.
+func ClientSecret(ctx context.Context, cli client.Client, oauth2client *unikornv1.OAuth2Client) (string, error) {
+	resource := &corev1.Secret{}
+
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: oauth2client.Namespace, Name: oauth2client.SecretName()}, resource); err != nil {
+		return "", err
+	}
+
+	secret, ok := resource.Data[unikornv1.OAuth2ClientSecretKey]
+	if !ok {
+		return "", fmt.Errorf("%w: client secret key missing", ErrReference)
+	}
+
+	ok, err := util.VerifySecret(ctx, oauth2client.Status.SecretHash, string(secret))
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return "", fmt.Errorf("%w: client secret does not match hash", ErrReference)
+	}
+
+	return string(secret), nil
+}
+
+// clientSecretValid checks the secret against all secrets currently valid for
+// the client, as previous secrets remain valid for a while after rotation.
+// Only hashes are stored, which are compared in constant time.
+func clientSecretValid(ctx context.Context, oauth2client *unikornv1.OAuth2Client, secret string) bool {
+	for _, hash := range oauth2client.SecretHashes() {
+		if ok, err := util.VerifySecret(ctx, hash, secret); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isConfidentialClient checks whether the client has credentials it can authenticate
+// with, and therefore must.
+func isConfidentialClient(oauth2client *unikornv1.OAuth2Client) bool {
+	return oauth2client.Status.SecretHash != "" || oauth2client.Spec.JWKS != nil || oauth2client.Spec.JWKSURI != nil
+}
+
+// clientAuthenticationMethodAllowed checks the method used to authenticate is
+// allowed by the client.
+func clientAuthenticationMethodAllowed(client *unikornv1.OAuth2Client, method unikornv1.OAuth2ClientAuthenticationMethod) error {
+	if client.Spec.TokenEndpointAuthMethod != nil && *client.Spec.TokenEndpointAuthMethod != method {
+		return errors.OAuth2InvalidClient("client authentication method not allowed").WithValues("method", method)
+	}
+
+	return nil
+}
+
+// clientSecretJWTVerify checks the JWT is signed by the client secret.  As this
+// requires the plain text secret, it's read back from the Kubernetes secret, and
+// therefore only the current secret is accepted.
+func (a *Authenticator) clientSecretJWTVerify(ctx context.Context, oauth2client *unikornv1.OAuth2Client, token *jwt.JSONWebToken, claims *jwt.Claims) error {
+	secret, err := ClientSecret(ctx, a.client, oauth2client)
+	if err != nil {
+		return err
+	}
+
+	return token.Claims([]byte(secret), claims)
+}
+
+// requestClientID returns the client ID a request claims to be from, as given by
+// HTTP basic authentication, the client_id parameter, or the subject of a client
+// assertion.  This is unverified, and must be checked by validateClient.
+func requestClientID(r *http.Request) (string, error) {
+	if clientID, _, ok := r.BasicAuth(); ok {
+		return clientID, nil
+	}
+
+	if r.Form.Has("client_id") {
+		return r.Form.Get("client_id"), nil
+	}
+
+	if r.Form.Has("client_assertion") {
+		token, err := jwt.ParseSigned(r.Form.Get("client_assertion"))
+		if err != nil {
+			return "", errors.OAuth2InvalidClient("client assertion malformed").WithError(err)
+		}
+
+		claims := &jwt.Claims{}
+
+		if err := token.UnsafeClaimsWithoutVerification(claims); err != nil {
+			return "", errors.OAuth2InvalidClient("client assertion malformed").WithError(err)
+		}
+
+		return claims.Subject, nil
+	}
+
+	return "", errors.OAuth2InvalidClient("client authentication required")
+}
+
+// authenticateClient authenticates a client at endpoints where the client ID isn't
+// otherwise known, e.g. revocation and introspection, using the same methods as
+// the token endpoint.
+func (a *Authenticator) authenticateClient(r *http.Request) (*unikornv1.OAuth2Client, error) {
+	clientID, err := requestClientID(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validateClient(r, clientID); err != nil {
+		return nil, err
+	}
+
+	client, err := a.lookupClient(r.Context(), clientID)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to lookup client").WithError(err)
+	}
+
+	return client, nil
+}
+
+// validateClient checks a token endpoint request is from the expected client,
+// this handles shared secrets, and signed JWT assertions.
+func (a *Authenticator) validateClient(r *http.Request, clientID string) error {
+	if r.Form.Has("client_assertion_type") || r.Form.Has("client_assertion") {
+		return a.validateClientAssertion(r, clientID)
+	}
+
+	return a.validateClientSharedSecret(r, clientID)
+}
+
+// validateClientSharedSecret checks a shared secret provided by either HTTP basic
+// authentication or in the request body.
+func (a *Authenticator) validateClientSharedSecret(r *http.Request, expectedClientID string) error {
+	method := unikornv1.ClientSecretBasic
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		if !r.Form.Has("client_id") || !r.Form.Has("client_secret") {
+			return errors.OAuth2InvalidClient("client ID secret not set in request body")
+		}
+
+		method = unikornv1.ClientSecretPost
+		clientID = r.Form.Get("client_id")
+		clientSecret = r.Form.Get("client_secret")
+	}
+
+	if expectedClientID != clientID {
+		return errors.OAuth2InvalidGrant("client_id mismatch")
+	}
+
+	client, err := a.lookupClient(r.Context(), clientID)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.OAuth2InvalidClient("client does not exist").WithError(err)
+		}
+
+		return errors.OAuth2ServerError("failed to lookup client").WithError(err)
+	}
+
+	if err := clientAuthenticationMethodAllowed(client, method); err != nil {
+		return err
+	}
+
+	if client.Status.SecretHash == "" {
+		return errors.OAuth2ServerError("client secret not set")
+	}
+
+	if !clientSecretValid(r.Context(), client, clientSecret) {
+		return errors.OAuth2InvalidClient("client secret invalid")
+	}
+
+	return nil
+}
+
+// validateClientAssertion checks a JWT client assertion as defined by RFC 7523
+// and OIDC core section 9.  The assertion is either signed by the client secret, or
+// by a key in the client's JWKS.
+//
+//nolint:cyclop
+func (a *Authenticator) validateClientAssertion(r *http.Request, clientID string) error {
+	if r.Form.Get("client_assertion_type") != clientAssertionType {
+		return errors.OAuth2InvalidClient("client assertion type not supported")
+	}
+
+	if r.Form.Has("client_id") && r.Form.Get("client_id") != clientID {
+		return errors.OAuth2InvalidGrant("client_id mismatch")
+	}
+
+	client, err := a.lookupClient(r.Context(), clientID)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.OAuth2InvalidClient("client does not exist").WithError(err)
+		}
+
+		return errors.OAuth2ServerError("failed to lookup client").WithError(err)
+	}
+
+	raw := r.Form.Get("client_assertion")
+
+	token, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return errors.OAuth2InvalidClient("client assertion malformed").WithError(err)
+	}
+
+	if len(token.Headers) != 1 {
+		return errors.OAuth2InvalidClient("client assertion malformed")
+	}
+
+	method := unikornv1.PrivateKeyJWT
+
+	if slices.Contains(clientSecretJWTSigningAlgorithms, gojose.SignatureAlgorithm(token.Headers[0].Algorithm)) {
+		method = unikornv1.ClientSecretJWT
+	}
+
+	if err := clientAuthenticationMethodAllowed(client, method); err != nil {
+		return err
+	}
+
+	claims := &jwt.Claims{}
+
+	switch method {
+	case unikornv1.ClientSecretJWT:
+		if client.Status.SecretHash == "" {
+			return errors.OAuth2ServerError("client secret not set")
+		}
+
+		if err := a.clientSecretJWTVerify(r.Context(), client, token, claims); err != nil {
+			return errors.OAuth2InvalidClient("client assertion signature invalid").WithError(err)
+		}
+	default:
+		c, err := a.verifyClientJWT(r.Context(), client, raw, nil)
+		if err != nil {
+			return errors.OAuth2InvalidClient("client assertion signature invalid").WithError(err)
+		}
+
+		claims = c
+	}
+
+	expected := jwt.Expected{
+		Issuer:  clientID,
+		Subject: clientID,
+		Time:    time.Now(),
+	}
+
+	if err := claims.Validate(expected); err != nil {
+		return errors.OAuth2InvalidClient("client assertion claims invalid").WithError(err)
+	}
+
+	// The audience must identify us, either as the issuer or the endpoint the
+	// assertion was presented to.
+	if !claims.Audience.Contains("https://"+r.Host) && !claims.Audience.Contains("https://"+r.Host+r.URL.Path) {
+		return errors.OAuth2InvalidClient("client assertion audience invalid")
+	}
+
+	if claims.Expiry == nil || claims.ID == "" {
+		return errors.OAuth2InvalidClient("client assertion must have exp and jti claims")
+	}
+
+	if time.Until(claims.Expiry.Time()) > clientAssertionMaxLifetime {
+		return errors.OAuth2InvalidClient("client assertion lifetime too long")
+	}
+
+	return a.consumeClientAssertion(r.Context(), client, claims)
+}
+
+// clientAssertionName returns the name of the resource that records use of an
+// assertion, this is directly derived so reuse is detected by creation failing.
+func clientAssertionName(clientID, id string) string {
+	sum := sha256.Sum256([]byte(clientID + "/" + id))
+
+	return "assertion-" + hex.EncodeToString(sum[:])
+}
+
+// pruneClientAssertions removes records of the client's assertions that have expired.
+func (a *Authenticator) pruneClientAssertions(ctx context.Context, clientID string) error {
+	assertions := &unikornv1.OAuth2ClientAssertionList{}
+
+	if err := a.client.List(ctx, assertions, &client.ListOptions{Namespace: a.namespace}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for i := range assertions.Items {
+		assertion := &assertions.Items[i]
+
+		if assertion.Spec.ClientID != clientID || assertion.Spec.Expiry.Time.After(now) {
+			continue
+		}
+
+		if err := a.client.Delete(ctx, assertion); err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// consumeClientAssertion records the assertion as used until it expires, so it can
+// only be used once.  This is visible to all replicas.
+func (a *Authenticator) consumeClientAssertion(ctx context.Context, oauth2client *unikornv1.OAuth2Client, claims *jwt.Claims) error {
+	if err := a.pruneClientAssertions(ctx, oauth2client.Name); err != nil {
+		return errors.OAuth2ServerError("failed to prune client assertions").WithError(err)
+	}
+
+	assertion := &unikornv1.OAuth2ClientAssertion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: a.namespace,
+			Name:      clientAssertionName(oauth2client.Name, claims.ID),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: unikornv1.SchemeGroupVersion.String(),
+					Kind:       "OAuth2Client",
+					Name:       oauth2client.Name,
+					UID:        oauth2client.UID,
+					Controller: ptr.To(true),
+				},
+			},
+		},
+		Spec: unikornv1.OAuth2ClientAssertionSpec{
+			ClientID: oauth2client.Name,
+			Expiry:   metav1.NewTime(claims.Expiry.Time().Add(jwt.DefaultLeeway)),
+		},
+	}
+
+	if err := a.client.Create(ctx, assertion); err != nil {
+		if kerrors.IsAlreadyExists(err) {
+			return errors.OAuth2InvalidClient("client assertion reuse")
+		}
+
+		return errors.OAuth2ServerError("failed to record client assertion").WithError(err)
+	}
+
+	return nil
+}
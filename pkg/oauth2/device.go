@@ -0,0 +1,619 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	"crypto/sha256"
+	goerrors "errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/unikorn-cloud/core/pkg/constants"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/html"
+	"github.com/unikorn-cloud/identity/pkg/jose"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
+	"github.com/unikorn-cloud/identity/pkg/openapi"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// userCodeAlphabet is the set of characters used in user codes, as recommended
+	// by RFC 8628 section 6.1, there are no vowels to avoid spelling words, and
+	// no ambiguous characters.
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+
+	// userCodeLength is the number of characters in a user code, excluding
+	// the separator.
+	userCodeLength = 8
+)
+
+var (
+	// ErrDeviceAuthorization is raised when a device authorization cannot be used.
+	ErrDeviceAuthorization = goerrors.New("device authorization invalid")
+)
+
+// DeviceCode is returned to a device by the device authorization endpoint, and
+// is exchanged for tokens once the user has approved it.  Like authorization codes
+// this is encrypted so no server side state is required while it's pending.
+type DeviceCode struct {
+	// ID is a unique identifier for the code, the user code is derived
+	// from this.
+	ID string `json:"id"`
+	// ClientQuery stores the client ID and requested scope.
+	ClientQuery string `json:"cq"`
+	// Expiry is when the device code expires in seconds since the epoch.
+	Expiry int64 `json:"exp"`
+}
+
+// deviceUserCode derives a user code from the device code ID.  As the ID is
+// encrypted in the device code, only the device and this service can know it.
+func deviceUserCode(id string) string {
+	sum := sha256.Sum256([]byte(id))
+
+	var builder strings.Builder
+
+	for i := range userCodeLength {
+		if i == userCodeLength/2 {
+			builder.WriteByte('-')
+		}
+
+		builder.WriteByte(userCodeAlphabet[int(sum[i])%len(userCodeAlphabet)])
+	}
+
+	return builder.String()
+}
+
+// normalizeUserCode takes the user code as entered by the user and translates it
+// into canonical form, ignoring case, whitespace and separators.
+func normalizeUserCode(in string) (string, bool) {
+	var builder strings.Builder
+
+	for _, c := range strings.ToUpper(in) {
+		if c == '-' || c == ' ' {
+			continue
+		}
+
+		if !strings.ContainsRune(userCodeAlphabet, c) {
+			return "", false
+		}
+
+		if builder.Len() == userCodeLength/2 {
+			builder.WriteByte('-')
+		}
+
+		builder.WriteRune(c)
+	}
+
+	if builder.Len() != userCodeLength+1 {
+		return "", false
+	}
+
+	return builder.String(), true
+}
+
+// isDeviceAuthorization checks whether the client query is from the device verification
+// flow rather than an authorization request, which always has a client ID.
+func isDeviceAuthorization(query url.Values) bool {
+	return !query.Has("client_id") && query.Has("user_code")
+}
+
+// DeviceAuthorization implements the device authorization endpoint as defined
+// by RFC 8628 section 3.1.
+func (a *Authenticator) DeviceAuthorization(w http.ResponseWriter, r *http.Request) (*openapi.DeviceAuthorization, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, errors.OAuth2InvalidRequest("failed to parse form data").WithError(err)
+	}
+
+	clientID := r.Form.Get("client_id")
+
+	oauth2client, err := a.lookupClient(r.Context(), clientID)
+	if err != nil {
+		return nil, errors.OAuth2InvalidClient("client_id does not exist").WithError(err)
+	}
+
+	// Confidential clients must authenticate as they would at the token endpoint.
+	if isConfidentialClient(oauth2client) {
+		if err := a.validateClient(r, clientID); err != nil {
+			return nil, err
+		}
+	}
+
+	clientQuery := url.Values{}
+	clientQuery.Set("client_id", clientID)
+
+	if r.Form.Has("scope") {
+		clientQuery.Set("scope", r.Form.Get("scope"))
+	}
+
+	deviceCode := &DeviceCode{
+		ID:          uuid.New().String(),
+		ClientQuery: clientQuery.Encode(),
+		Expiry:      time.Now().Add(a.options.DeviceCodeDuration).Unix(),
+	}
+
+	deviceCodeCipher, err := a.issuer.EncodeJWEToken(r.Context(), deviceCode, jose.TokenTypeDeviceCode)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to encode device code").WithError(err)
+	}
+
+	userCode := deviceUserCode(deviceCode.ID)
+
+	if err := a.createDeviceAuthorization(r.Context(), oauth2client, userCode, time.Unix(deviceCode.Expiry, 0)); err != nil {
+		return nil, errors.OAuth2ServerError("failed to record device authorization").WithError(err)
+	}
+
+	verificationURI := "https://" + r.Host + "/oauth2/v2/device"
+
+	query := url.Values{}
+	query.Set("user_code", userCode)
+
+	result := &openapi.DeviceAuthorization{
+		DeviceCode:              deviceCodeCipher,
+		UserCode:                userCode,
+		VerificationUri:         verificationURI,
+		VerificationUriComplete: verificationURI + "?" + query.Encode(),
+		ExpiresIn:               int(a.options.DeviceCodeDuration.Seconds()),
+		Interval:                int(a.options.DeviceCodePollInterval.Seconds()),
+	}
+
+	return result, nil
+}
+
+// Device renders the user code entry dialog.
+func (a *Authenticator) Device(w http.ResponseWriter, r *http.Request) {
+	// This is rendered back to the user, so only allow it through if it's
+	// well formed.
+	userCode, _ := normalizeUserCode(r.URL.Query().Get("user_code"))
+
+	body, err := html.Device(userCode)
+	if err != nil {
+		htmlError(w, r, http.StatusInternalServerError, "failed to render device template")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(body); err != nil {
+		log.FromContext(r.Context()).Info("oauth2: failed to write HTML response")
+	}
+}
+
+// DeviceLogin handles the response from the user code entry dialog and kicks off
+// the usual login flow.
+func (a *Authenticator) DeviceLogin(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		htmlError(w, r, http.StatusBadRequest, "form parse failure")
+		return
+	}
+
+	userCode, ok := normalizeUserCode(r.Form.Get("user_code"))
+	if !ok {
+		htmlError(w, r, http.StatusBadRequest, "user code is invalid")
+		return
+	}
+
+	// Fail fast rather than have the user login for nothing.
+	if _, err := a.pendingDeviceAuthorization(r.Context(), userCode); err != nil {
+		htmlError(w, r, http.StatusBadRequest, "user code is invalid or has expired")
+		return
+	}
+
+	query := url.Values{}
+	query.Set("user_code", userCode)
+
+	// There is no client to redirect errors to, so these will be rendered
+	// as HTML.
+	a.login(w, r, newRedirector(w, r, "", ""), query, nil)
+}
+
+// deviceAuthorizationName returns the name of the resource that records the approval
+// of a user code, this is directly derived so devices can find it without a search.
+func deviceAuthorizationName(userCode string) string {
+	return "device-" + strings.ToLower(userCode)
+}
+
+// deviceAuthorizationDecided checks whether the user has made a decision.
+func deviceAuthorizationDecided(authorization *unikornv1.DeviceAuthorization) bool {
+	_, ok := authorization.Labels[constants.UserLabel]
+
+	return ok
+}
+
+// pruneDeviceAuthorizations removes any of the client's device authorizations that
+// were never collected.
+func (a *Authenticator) pruneDeviceAuthorizations(ctx context.Context, clientID string) error {
+	authorizations := &unikornv1.DeviceAuthorizationList{}
+
+	if err := a.client.List(ctx, authorizations, &client.ListOptions{Namespace: a.namespace}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for i := range authorizations.Items {
+		authorization := &authorizations.Items[i]
+
+		if authorization.Spec.ClientID != clientID || authorization.Spec.Expiry.Time.After(now) {
+			continue
+		}
+
+		if err := a.client.Delete(ctx, authorization); err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createDeviceAuthorization records a pending device authorization, so the client can
+// be presented to the user for consent.
+func (a *Authenticator) createDeviceAuthorization(ctx context.Context, oauth2client *unikornv1.OAuth2Client, userCode string, expiry time.Time) error {
+	if err := a.pruneDeviceAuthorizations(ctx, oauth2client.Name); err != nil {
+		return err
+	}
+
+	authorization := &unikornv1.DeviceAuthorization{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: a.namespace,
+			Name:      deviceAuthorizationName(userCode),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: unikornv1.SchemeGroupVersion.String(),
+					Kind:       "OAuth2Client",
+					Name:       oauth2client.Name,
+					UID:        oauth2client.UID,
+					Controller: ptr.To(true),
+				},
+			},
+		},
+		Spec: unikornv1.DeviceAuthorizationSpec{
+			ClientID: oauth2client.Name,
+			Expiry:   metav1.NewTime(expiry),
+		},
+	}
+
+	return a.client.Create(ctx, authorization)
+}
+
+// pendingDeviceAuthorization returns the device authorization for a user code if it
+// is still awaiting the user's decision.
+func (a *Authenticator) pendingDeviceAuthorization(ctx context.Context, userCode string) (*unikornv1.DeviceAuthorization, error) {
+	authorization := &unikornv1.DeviceAuthorization{}
+
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: a.namespace, Name: deviceAuthorizationName(userCode)}, authorization); err != nil {
+		return nil, err
+	}
+
+	if deviceAuthorizationDecided(authorization) {
+		return nil, fmt.Errorf("%w: device authorization already decided", ErrDeviceAuthorization)
+	}
+
+	if authorization.Spec.Expiry.Time.Before(time.Now()) {
+		return nil, fmt.Errorf("%w: device authorization expired", ErrDeviceAuthorization)
+	}
+
+	return authorization, nil
+}
+
+// DeviceApproval is rendered into the consent form at the end of the login flow,
+// and when posted back identifies the user and the device authorization they are
+// deciding on.  This is encrypted so it cannot be forged.
+type DeviceApproval struct {
+	// UserCode identifies the device authorization.
+	UserCode string `json:"uc"`
+	// UserID is the user who logged in.
+	UserID string `json:"uid"`
+	// OAuth2Provider is the provider the user authenticated with.
+	OAuth2Provider string `json:"oap"`
+	// Expiry is when the approval expires in seconds since the epoch.
+	Expiry int64 `json:"exp"`
+}
+
+// deviceAuthorizationConsent is called at the end of the login flow for device
+// authorization and asks the user to approve the client that is requesting access.
+func (a *Authenticator) deviceAuthorizationConsent(w http.ResponseWriter, r *http.Request, redirector *redirector, user *unikornv1.User, provider, userCode string) {
+	authorization, err := a.pendingDeviceAuthorization(r.Context(), userCode)
+	if err != nil {
+		redirector.raise(ErrorAccessDenied, "device authorization is invalid or has expired")
+		return
+	}
+
+	oauth2client, err := a.lookupClient(r.Context(), authorization.Spec.ClientID)
+	if err != nil {
+		redirector.raise(ErrorServerError, "failed to lookup client")
+		return
+	}
+
+	clientName := oauth2client.Name
+
+	if name, ok := oauth2client.Labels[constants.NameLabel]; ok {
+		clientName = name
+	}
+
+	approval := &DeviceApproval{
+		UserCode:       userCode,
+		UserID:         user.Name,
+		OAuth2Provider: provider,
+		Expiry:         authorization.Spec.Expiry.Unix(),
+	}
+
+	approvalCipher, err := a.issuer.EncodeJWEToken(r.Context(), approval, jose.TokenTypeDeviceApproval)
+	if err != nil {
+		redirector.raise(ErrorServerError, "failed to encode device approval")
+		return
+	}
+
+	body, err := html.DeviceConsent(clientName, userCode, approvalCipher)
+	if err != nil {
+		redirector.raise(ErrorServerError, "failed to render device template")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(body); err != nil {
+		log.FromContext(r.Context()).Info("oauth2: failed to write HTML response")
+	}
+}
+
+// DeviceApprove handles the response from the consent dialog and records the user's
+// decision, which is then visible to all replicas when the device next polls.
+func (a *Authenticator) DeviceApprove(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		htmlError(w, r, http.StatusBadRequest, "form parse failure")
+		return
+	}
+
+	approval := &DeviceApproval{}
+
+	if err := a.issuer.DecodeJWEToken(r.Context(), r.Form.Get("approval"), approval, jose.TokenTypeDeviceApproval); err != nil {
+		htmlError(w, r, http.StatusBadRequest, "device approval is invalid")
+		return
+	}
+
+	if time.Now().After(time.Unix(approval.Expiry, 0)) {
+		htmlError(w, r, http.StatusBadRequest, "device approval has expired")
+		return
+	}
+
+	user := &unikornv1.User{}
+
+	if err := a.client.Get(r.Context(), client.ObjectKey{Namespace: a.namespace, Name: approval.UserID}, user); err != nil {
+		htmlError(w, r, http.StatusInternalServerError, "failed to lookup user")
+		return
+	}
+
+	authorization, err := a.pendingDeviceAuthorization(r.Context(), approval.UserCode)
+	if err != nil {
+		htmlError(w, r, http.StatusBadRequest, "device authorization is invalid or has expired")
+		return
+	}
+
+	denied := r.Form.Get("decision") != "approve"
+
+	if authorization.Labels == nil {
+		authorization.Labels = map[string]string{}
+	}
+
+	authorization.Labels[constants.UserLabel] = user.Name
+	authorization.OwnerReferences = append(authorization.OwnerReferences, metav1.OwnerReference{
+		APIVersion: unikornv1.SchemeGroupVersion.String(),
+		Kind:       "User",
+		Name:       user.Name,
+		UID:        user.UID,
+	})
+
+	authorization.Spec.OAuth2Provider = approval.OAuth2Provider
+	authorization.Spec.SourceIP = sourceIP(r)
+	authorization.Spec.Denied = denied
+
+	// The first decision wins, otherwise anyone could hijack the device, the
+	// update is conditional on the resource version read above.
+	if err := a.client.Update(r.Context(), authorization); err != nil {
+		if kerrors.IsConflict(err) {
+			htmlError(w, r, http.StatusBadRequest, "device has already been approved")
+			return
+		}
+
+		htmlError(w, r, http.StatusInternalServerError, "failed to record device authorization")
+
+		return
+	}
+
+	body, err := html.DeviceComplete(denied)
+	if err != nil {
+		htmlError(w, r, http.StatusInternalServerError, "failed to render device template")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(body); err != nil {
+		log.FromContext(r.Context()).Info("oauth2: failed to write HTML response")
+	}
+}
+
+// consumeDeviceAuthorization finds the user's decision for the user code, and deletes
+// it so it cannot be used again.  Deletion is atomic, so only one poll can collect the
+// decision regardless of replica.  If the user has not yet decided, then nothing is
+// returned.
+func (a *Authenticator) consumeDeviceAuthorization(ctx context.Context, userCode string) (*unikornv1.DeviceAuthorization, error) {
+	authorization := &unikornv1.DeviceAuthorization{}
+
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: a.namespace, Name: deviceAuthorizationName(userCode)}, authorization); err != nil {
+		return nil, err
+	}
+
+	if !deviceAuthorizationDecided(authorization) {
+		return nil, nil
+	}
+
+	if err := a.client.Delete(ctx, authorization, client.Preconditions{UID: &authorization.UID}); err != nil {
+		return nil, err
+	}
+
+	return authorization, nil
+}
+
+// TokenDeviceCode issues a token once the device code has been approved by the user.
+func (a *Authenticator) TokenDeviceCode(w http.ResponseWriter, r *http.Request) (*openapi.Token, error) {
+	deviceCode := &DeviceCode{}
+
+	if err := a.issuer.DecodeJWEToken(r.Context(), r.Form.Get("device_code"), deviceCode, jose.TokenTypeDeviceCode); err != nil {
+		return nil, errors.OAuth2InvalidGrant("failed to parse device code").WithError(err)
+	}
+
+	clientQuery, err := url.ParseQuery(deviceCode.ClientQuery)
+	if err != nil {
+		return nil, errors.OAuth2InvalidRequest("failed to parse client query").WithError(err)
+	}
+
+	clientID := clientQuery.Get("client_id")
+
+	confirmation, err := a.tokenConfirmation(r)
+	if err != nil {
+		return nil, err
+	}
+
+	oauth2client, err := a.lookupClient(r.Context(), clientID)
+	if err != nil {
+		return nil, errors.OAuth2InvalidClient("client_id does not exist").WithError(err)
+	}
+
+	// Confidential clients must authenticate as with any other grant.  Devices
+	// are typically public clients so may not, the device code is the secret,
+	// but the client must still match.
+	if isConfidentialClient(oauth2client) {
+		if err := a.validateClient(r, clientID); err != nil {
+			return nil, err
+		}
+	} else {
+		requestClientID, _, ok := r.BasicAuth()
+		if !ok {
+			requestClientID = r.Form.Get("client_id")
+		}
+
+		if requestClientID != clientID {
+			return nil, errors.OAuth2InvalidClient("client_id mismatch")
+		}
+	}
+
+	if time.Now().After(time.Unix(deviceCode.Expiry, 0)) {
+		return nil, newProtocolError(http.StatusBadRequest, ErrorExpiredToken, "device code has expired")
+	}
+
+	// Polling rate limiting is best effort and per-replica, so a device polling
+	// multiple replicas may exceed the interval without being told to slow down.
+	// This is tolerable as polling is a single cached lookup, and approvals are
+	// collected atomically regardless of replica.
+	if _, ok := a.deviceCodeCache.Get(deviceCode.ID); ok {
+		return nil, newProtocolError(http.StatusBadRequest, ErrorSlowDown, "device code polled too often")
+	}
+
+	a.deviceCodeCache.Add(deviceCode.ID, nil, a.options.DeviceCodePollInterval)
+
+	authorization, err := a.consumeDeviceAuthorization(r.Context(), deviceUserCode(deviceCode.ID))
+	if err != nil {
+		// Either the device authorization has already been collected, possibly by
+		// a concurrent poll, or it has been pruned.
+		if kerrors.IsNotFound(err) || kerrors.IsConflict(err) {
+			return nil, newProtocolError(http.StatusBadRequest, ErrorAccessDenied, "device authorization has been used")
+		}
+
+		return nil, errors.OAuth2ServerError("failed to lookup device authorization").WithError(err)
+	}
+
+	if authorization == nil {
+		return nil, newProtocolError(http.StatusBadRequest, ErrorAuthorizationPending, "device authorization is pending")
+	}
+
+	if authorization.Spec.Denied {
+		return nil, newProtocolError(http.StatusBadRequest, ErrorAccessDenied, "device authorization was denied")
+	}
+
+	user := &unikornv1.User{}
+
+	if err := a.client.Get(r.Context(), client.ObjectKey{Namespace: a.namespace, Name: authorization.Labels[constants.UserLabel]}, user); err != nil {
+		return nil, errors.OAuth2ServerError("failed to lookup user").WithError(err)
+	}
+
+	if user.Spec.State != unikornv1.UserStateActive {
+		return nil, errors.OAuth2AccessDenied("user is not active")
+	}
+
+	info := &IssueInfo{
+		Issuer:   "https://" + r.Host,
+		Audience: r.Host,
+		Subject:  user.Spec.Subject,
+		Type:     TokenTypeFederated,
+		Federated: &FederatedClaims{
+			ClientID: clientID,
+			UserID:   user.Name,
+			Provider: authorization.Spec.OAuth2Provider,
+			Scope:    NewScope(clientQuery.Get("scope")),
+		},
+		Interactive:  true,
+		Confirmation: confirmation,
+		SourceIP:     authorization.Spec.SourceIP,
+	}
+
+	tokens, err := a.Issue(r.Context(), info)
+	if err != nil {
+		return nil, err
+	}
+
+	// The provider's ID token isn't preserved across the device approval, so only
+	// what we know about the user is available.
+	oidcIDToken := &oidc.IDToken{
+		Email: oidc.Email{
+			Email:         user.Spec.Subject,
+			EmailVerified: true,
+		},
+	}
+
+	idToken, err := a.oidcIDToken(r, oidcIDToken, clientQuery, a.options.AccessTokenDuration, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &openapi.Token{
+		TokenType:    tokenType(confirmation),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		IdToken:      idToken,
+		ExpiresIn:    int(time.Until(tokens.Expiry).Seconds()),
+	}
+
+	return result, nil
+}
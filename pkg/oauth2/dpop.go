@@ -0,0 +1,73 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"net/http"
+
+	"github.com/unikorn-cloud/identity/pkg/oauth2/dpop"
+)
+
+// tokenConfirmation verifies the DPoP proof presented to the token endpoint, if
+// any, and returns the confirmation claims that issued tokens are bound to.
+//
+//nolint:nilnil
+func (a *Authenticator) tokenConfirmation(r *http.Request) (*ConfirmationClaims, error) {
+	if !dpop.Present(r) {
+		return nil, nil
+	}
+
+	thumbprint, err := a.dpopVerifier.Verify(r, "")
+	if err != nil {
+		return nil, newProtocolError(http.StatusBadRequest, ErrorInvalidDPoPProof, err.Error())
+	}
+
+	confirmation := &ConfirmationClaims{
+		JWKThumbprint: thumbprint,
+	}
+
+	return confirmation, nil
+}
+
+// tokenRefreshConfirmation checks a DPoP bound refresh token is presented with a
+// proof from the same key, as refresh tokens for public clients are otherwise
+// replayable.
+func (a *Authenticator) tokenRefreshConfirmation(r *http.Request, claims *RefreshTokenClaims) (*ConfirmationClaims, error) {
+	confirmation, err := a.tokenConfirmation(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Confirmation == nil {
+		return confirmation, nil
+	}
+
+	if confirmation == nil || confirmation.JWKThumbprint != claims.Confirmation.JWKThumbprint {
+		return nil, newProtocolError(http.StatusBadRequest, ErrorInvalidDPoPProof, "refresh token is bound to a different key")
+	}
+
+	return confirmation, nil
+}
+
+// tokenType returns the token type to report in the token response.
+func tokenType(confirmation *ConfirmationClaims) string {
+	if confirmation != nil {
+		return dpop.Scheme
+	}
+
+	return "Bearer"
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dpop implements proof of possession verification as defined by
+// RFC 9449, and is shared between the token endpoint and resource servers.
+package dpop
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+var (
+	// ErrProof is raised when a DPoP proof is missing or invalid.
+	ErrProof = errors.New("DPoP proof invalid")
+)
+
+const (
+	// Header is the HTTP header containing the proof.
+	Header = "DPoP"
+
+	// Scheme is the HTTP authorization scheme for DPoP bound access tokens.
+	Scheme = "DPoP"
+
+	// proofType is the required JWT type.
+	proofType = "dpop+jwt"
+
+	// proofLifetime is how far the proof's issue time may differ from now.
+	proofLifetime = time.Minute
+)
+
+//nolint:gochecknoglobals
+var (
+	// SigningAlgorithms are the asymmetric algorithms we accept for proofs.
+	SigningAlgorithms = []jose.SignatureAlgorithm{
+		jose.RS256,
+		jose.PS256,
+		jose.ES256,
+		jose.ES384,
+		jose.ES512,
+		jose.EdDSA,
+	}
+)
+
+// ProofClaims are the claims in a DPoP proof.
+type ProofClaims struct {
+	jwt.Claims `json:",inline"`
+	// Method is the HTTP method of the request.
+	Method string `json:"htm"`
+	// URI is the HTTP URI of the request without query or fragment.
+	URI string `json:"htu"`
+	// AccessTokenHash is the hash of the access token, when presented
+	// to a resource server.
+	AccessTokenHash string `json:"ath,omitempty"`
+}
+
+// Verifier validates DPoP proofs.
+type Verifier struct {
+	// replayCache records proof IDs to prevent reuse.
+	replayCache *cache.LRUExpireCache
+}
+
+// NewVerifier creates a new verifier, the cache size limits how many proofs
+// can be tracked for replay protection.
+func NewVerifier(cacheSize int) *Verifier {
+	return &Verifier{
+		replayCache: cache.NewLRUExpireCache(cacheSize),
+	}
+}
+
+// AccessTokenHash returns the access token hash used in the ath claim.
+func AccessTokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Present returns true if the request has a DPoP proof.
+func Present(r *http.Request) bool {
+	return len(r.Header.Values(Header)) != 0
+}
+
+// Verify checks the request's DPoP proof, and returns the JWK SHA-256 thumbprint
+// of the key it was signed with.  When presented to a resource server the access
+// token must be specified, and the proof bound to it.
+//
+//nolint:cyclop
+func (v *Verifier) Verify(r *http.Request, accessToken string) (string, error) {
+	proofs := r.Header.Values(Header)
+	if len(proofs) != 1 {
+		return "", fmt.Errorf("%w: expected exactly one proof", ErrProof)
+	}
+
+	token, err := jwt.ParseSigned(proofs[0])
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrProof, err)
+	}
+
+	if len(token.Headers) != 1 {
+		return "", fmt.Errorf("%w: expected exactly one header", ErrProof)
+	}
+
+	header := token.Headers[0]
+
+	if t, ok := header.ExtraHeaders[jose.HeaderType].(string); !ok || t != proofType {
+		return "", fmt.Errorf("%w: typ header incorrect", ErrProof)
+	}
+
+	if !slices.Contains(SigningAlgorithms, jose.SignatureAlgorithm(header.Algorithm)) {
+		return "", fmt.Errorf("%w: signing algorithm %s not allowed", ErrProof, header.Algorithm)
+	}
+
+	if header.JSONWebKey == nil || !header.JSONWebKey.IsPublic() {
+		return "", fmt.Errorf("%w: jwk header must be a public key", ErrProof)
+	}
+
+	claims := &ProofClaims{}
+
+	if err := token.Claims(header.JSONWebKey.Key, claims); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrProof, err)
+	}
+
+	if claims.Method != r.Method {
+		return "", fmt.Errorf("%w: htm mismatch", ErrProof)
+	}
+
+	// TLS is terminated before we see the request, so like the issuer, the
+	// scheme is implied.
+	if claims.URI != "https://"+r.Host+r.URL.Path {
+		return "", fmt.Errorf("%w: htu mismatch", ErrProof)
+	}
+
+	if claims.IssuedAt == nil {
+		return "", fmt.Errorf("%w: iat not set", ErrProof)
+	}
+
+	if issuedAt := claims.IssuedAt.Time(); time.Since(issuedAt).Abs() > proofLifetime {
+		return "", fmt.Errorf("%w: iat out of range", ErrProof)
+	}
+
+	if accessToken != "" && claims.AccessTokenHash != AccessTokenHash(accessToken) {
+		return "", fmt.Errorf("%w: ath mismatch", ErrProof)
+	}
+
+	if claims.ID == "" {
+		return "", fmt.Errorf("%w: jti not set", ErrProof)
+	}
+
+	// Proofs are only valid within the lifetime either side of now, so only
+	// need to be remembered for that long.
+	if _, ok := v.replayCache.Get(claims.ID); ok {
+		return "", fmt.Errorf("%w: proof replayed", ErrProof)
+	}
+
+	v.replayCache.Add(claims.ID, nil, 2*proofLifetime)
+
+	thumbprint, err := header.JSONWebKey.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrProof, err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ProtocolError is raised for conditions defined by oauth2 extensions, e.g. RFC 8628
+// section 3.5 and RFC 7591 section 3.2.2, that the generic error handling doesn't
+// cater for.
+type ProtocolError struct {
+	// status is the HTTP status code.
+	status int
+	// code is the terse error code.
+	code Error
+	// description is a verbose description of the error.
+	description string
+}
+
+func newProtocolError(status int, code Error, description string) *ProtocolError {
+	return &ProtocolError{
+		status:      status,
+		code:        code,
+		description: description,
+	}
+}
+
+// Error implements the error interface.
+func (e *ProtocolError) Error() string {
+	return e.description
+}
+
+// Write returns the error code and description to the client.
+func (e *ProtocolError) Write(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Cache-Control", "no-store")
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(e.status)
+
+	body := map[string]string{
+		"error":             string(e.code),
+		"error_description": e.description,
+	}
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.FromContext(r.Context()).Info("oauth2: failed to write protocol error", "error", err)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/util"
+
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// tokenTypeAccessToken is the only token type we accept and issue
+	// for token exchange as defined by RFC 8693 section 3.
+	tokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// TokenExchange allows a service to exchange a subject's access token for a short
+// lived one that it can use to act on behalf of the subject, as defined by RFC 8693.
+// The actor is authenticated with mTLS, as for client credentials, and the issued
+// token is bound to its certificate.
+//
+//nolint:cyclop
+func (a *Authenticator) TokenExchange(w http.ResponseWriter, r *http.Request) (*openapi.Token, error) {
+	certPEM, err := util.GetClientCertificateHeader(r.Header)
+	if err != nil {
+		return nil, errors.OAuth2InvalidRequest("mTLS client verification failed").WithError(err)
+	}
+
+	certificate, err := util.GetClientCertificate(certPEM)
+	if err != nil {
+		return nil, errors.OAuth2InvalidRequest("mTLS certificate validation failed").WithError(err)
+	}
+
+	if r.Form.Get("subject_token_type") != tokenTypeAccessToken {
+		return nil, errors.OAuth2InvalidRequest("subject_token_type must be an access token")
+	}
+
+	if r.Form.Has("requested_token_type") && r.Form.Get("requested_token_type") != tokenTypeAccessToken {
+		return nil, errors.OAuth2InvalidRequest("requested_token_type must be an access token")
+	}
+
+	if r.Form.Has("actor_token") {
+		return nil, errors.OAuth2InvalidRequest("actor_token is not supported, actors are authenticated with mTLS")
+	}
+
+	audience := r.Form.Get("audience")
+	if audience == "" {
+		return nil, errors.OAuth2InvalidRequest("audience must be specified")
+	}
+
+	verifyInfo := &VerifyInfo{
+		Issuer:   "https://" + r.Host,
+		Audience: r.Host,
+		Token:    r.Form.Get("subject_token"),
+	}
+
+	claims, err := a.Verify(r.Context(), verifyInfo)
+	if err != nil {
+		return nil, errors.OAuth2InvalidGrant("subject token validation failed").WithError(err)
+	}
+
+	// Services are already trusted to act on behalf of others, and we don't
+	// allow delegation chains.
+	if claims.Type == TokenTypeService {
+		return nil, errors.OAuth2InvalidGrant("service tokens cannot be exchanged")
+	}
+
+	if claims.Actor != nil {
+		return nil, errors.OAuth2InvalidGrant("delegated tokens cannot be exchanged")
+	}
+
+	// The delegated token must not outlive the subject's.
+	duration := a.options.TokenExchangeDuration
+
+	if expiresIn := time.Until(claims.Expiry.Time()); expiresIn < duration {
+		duration = expiresIn
+	}
+
+	info := &IssueInfo{
+		Issuer:         "https://" + r.Host,
+		Audience:       r.Host,
+		TargetAudience: audience,
+		Subject:        claims.Subject,
+		Type:           claims.Type,
+		Federated:      claims.Federated,
+		ServiceAccount: claims.ServiceAccount,
+		Actor: &ActorClaims{
+			Subject:          certificate.Subject.CommonName,
+			X509Thumbprint:   util.GetClientCertiifcateThumbprint(certificate),
+			SubjectTokenHash: tokenHash(verifyInfo.Token),
+		},
+		Duration: &duration,
+	}
+
+	tokens, err := a.Issue(r.Context(), info)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &openapi.Token{
+		TokenType:       "Bearer",
+		AccessToken:     tokens.AccessToken,
+		ExpiresIn:       int(time.Until(tokens.Expiry).Seconds()),
+		IssuedTokenType: ptr.To(tokenTypeAccessToken),
+	}
+
+	return result, nil
+}
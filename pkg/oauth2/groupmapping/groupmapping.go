@@ -0,0 +1,106 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupmapping
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+)
+
+// Result is the outcome of group mapping for a single group.
+type Result struct {
+	// GroupID is the group being mapped.
+	GroupID string
+	// Member is whether the user should be a member of the group.
+	Member bool
+	// Matched are the mappings that granted membership.
+	Matched []unikornv1.OAuth2ProviderGroupMapping
+}
+
+// lookup finds a claim by its period separated path.
+func lookup(claims map[string]any, path string) (any, bool) {
+	var value any = claims
+
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		if value, ok = object[segment]; !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// matches checks whether the claim equals the value, or contains it when the claim
+// is a list.  Non-string scalars e.g. booleans, are compared by their string form.
+func matches(claim any, value string) bool {
+	if list, ok := claim.([]any); ok {
+		return slices.ContainsFunc(list, func(item any) bool {
+			return matches(item, value)
+		})
+	}
+
+	switch t := claim.(type) {
+	case string:
+		return t == value
+	case map[string]any, nil:
+		return false
+	default:
+		return fmt.Sprint(t) == value
+	}
+}
+
+// Evaluate applies the mappings to a set of ID token claims, returning a result
+// for every group referenced by the mappings, ordered by group ID.
+func Evaluate(mappings []unikornv1.OAuth2ProviderGroupMapping, claims map[string]any) []Result {
+	results := map[string]*Result{}
+
+	for _, mapping := range mappings {
+		result, ok := results[mapping.GroupID]
+		if !ok {
+			result = &Result{
+				GroupID: mapping.GroupID,
+			}
+
+			results[mapping.GroupID] = result
+		}
+
+		if claim, ok := lookup(claims, mapping.Claim); ok && matches(claim, mapping.Value) {
+			result.Member = true
+			result.Matched = append(result.Matched, mapping)
+		}
+	}
+
+	out := make([]Result, 0, len(results))
+
+	for _, result := range results {
+		out = append(out, *result)
+	}
+
+	slices.SortFunc(out, func(a, b Result) int {
+		return strings.Compare(a.GroupID, b.GroupID)
+	})
+
+	return out
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupmapping_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/groupmapping"
+)
+
+// claims are typical of a Keycloak ID token.
+const claims = `{
+	"sub": "barry@foo.com",
+	"groups": ["developers", "operators"],
+	"email_verified": true,
+	"realm_access": {
+		"roles": ["admin"]
+	}
+}`
+
+// TestEvaluate checks list, nested and scalar claims, and that groups without any
+// matching mappings are reported so membership can be removed.
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	in := map[string]any{}
+
+	require.NoError(t, json.Unmarshal([]byte(claims), &in))
+
+	mappings := []unikornv1.OAuth2ProviderGroupMapping{
+		{Claim: "groups", Value: "developers", GroupID: "b"},
+		{Claim: "realm_access.roles", Value: "admin", GroupID: "a"},
+		{Claim: "email_verified", Value: "true", GroupID: "c"},
+		{Claim: "groups", Value: "auditors", GroupID: "d"},
+		{Claim: "realm_access.missing", Value: "admin", GroupID: "d"},
+		{Claim: "sub.nested", Value: "barry@foo.com", GroupID: "d"},
+	}
+
+	results := groupmapping.Evaluate(mappings, in)
+	require.Len(t, results, 4)
+
+	require.Equal(t, "a", results[0].GroupID)
+	require.True(t, results[0].Member)
+	require.Equal(t, mappings[1:2], results[0].Matched)
+
+	require.Equal(t, "b", results[1].GroupID)
+	require.True(t, results[1].Member)
+
+	require.Equal(t, "c", results[2].GroupID)
+	require.True(t, results[2].Member)
+
+	require.Equal(t, "d", results[3].GroupID)
+	require.False(t, results[3].Member)
+	require.Empty(t, results[3].Matched)
+}
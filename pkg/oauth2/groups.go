@@ -0,0 +1,386 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	"github.com/unikorn-cloud/core/pkg/constants"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/groupmapping"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/providers"
+	providererrors "github.com/unikorn-cloud/identity/pkg/oauth2/providers/errors"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/types"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	// ErrGitHubOrganizationRoute is raised when a user who is a member of an organization
+	// restricted to a GitHub organization logs in some way that cannot check it.
+	ErrGitHubOrganizationRoute = fmt.Errorf("%w: organization membership requires login via the organization's email domain", providererrors.ErrAccessDenied)
+)
+
+// matchProviderGroups returns the user's provider groups that are mapped to a group.
+// Group identifiers, e.g. email addresses, are case insensitive.
+func matchProviderGroups(mapped, providerGroups []string) []string {
+	var result []string
+
+	for _, name := range mapped {
+		if slices.ContainsFunc(providerGroups, func(providerGroup string) bool {
+			return strings.EqualFold(name, providerGroup)
+		}) {
+			result = append(result, name)
+		}
+	}
+
+	return result
+}
+
+// organizationUserID returns the user's organization membership, through which they
+// are added to groups, or an empty string if they are not a member.
+func (a *Authenticator) organizationUserID(ctx context.Context, namespace string, user *unikornv1.User) (string, error) {
+	selector := labels.SelectorFromSet(map[string]string{
+		constants.UserLabel: user.Name,
+	})
+
+	organizationUsers := &unikornv1.OrganizationUserList{}
+
+	if err := a.client.List(ctx, organizationUsers, &client.ListOptions{Namespace: namespace, LabelSelector: selector}); err != nil {
+		return "", err
+	}
+
+	if len(organizationUsers.Items) == 0 {
+		return "", nil
+	}
+
+	return organizationUsers.Items[0].Name, nil
+}
+
+// setGroupMembership adds or removes the organization user from the group, and
+// returns whether anything changed.  Concurrent logins may update the same group,
+// so the patch is guarded by the resource version, and retried with a fresh copy
+// of the group on conflict.
+func (a *Authenticator) setGroupMembership(ctx context.Context, group *unikornv1.Group, organizationUserID string, member bool) (bool, error) {
+	var changed bool
+
+	update := func() error {
+		current := &unikornv1.Group{}
+
+		if err := a.client.Get(ctx, client.ObjectKeyFromObject(group), current); err != nil {
+			return err
+		}
+
+		if member == slices.Contains(current.Spec.UserIDs, organizationUserID) {
+			changed = false
+
+			return nil
+		}
+
+		updated := current.DeepCopy()
+
+		if member {
+			updated.Spec.UserIDs = append(updated.Spec.UserIDs, organizationUserID)
+		} else {
+			updated.Spec.UserIDs = slices.DeleteFunc(updated.Spec.UserIDs, func(id string) bool {
+				return id == organizationUserID
+			})
+		}
+
+		if err := a.client.Patch(ctx, updated, client.MergeFromWithOptions(current, client.MergeFromWithOptimisticLock{})); err != nil {
+			return err
+		}
+
+		changed = true
+
+		return nil
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, update); err != nil {
+		return false, err
+	}
+
+	return changed, nil
+}
+
+// githubOrganization returns the GitHub organization the organization is restricted to,
+// if any.
+func githubOrganization(organization *unikornv1.Organization) string {
+	if organization == nil || organization.Spec.ProviderOptions == nil || organization.Spec.ProviderOptions.GitHub == nil || organization.Spec.ProviderOptions.GitHub.Organization == nil {
+		return ""
+	}
+
+	return *organization.Spec.ProviderOptions.GitHub.Organization
+}
+
+// groupLookupConfigured returns whether the organization is configured to synchronize
+// groups from its identity provider.
+func groupLookupConfigured(organization *unikornv1.Organization) bool {
+	options := organization.Spec.ProviderOptions
+
+	if options == nil {
+		return false
+	}
+
+	return (options.Google != nil && options.Google.CustomerID != nil) ||
+		(options.Microsoft != nil && options.Microsoft.TenantID != nil) ||
+		(options.GitHub != nil && options.GitHub.Organization != nil)
+}
+
+// memberOrganizations returns the organizations the user is a member of.
+func (a *Authenticator) memberOrganizations(ctx context.Context, user *unikornv1.User) ([]*unikornv1.Organization, error) {
+	selector := labels.SelectorFromSet(map[string]string{
+		constants.UserLabel: user.Name,
+	})
+
+	organizationUsers := &unikornv1.OrganizationUserList{}
+
+	if err := a.client.List(ctx, organizationUsers, &client.ListOptions{LabelSelector: selector}); err != nil {
+		return nil, err
+	}
+
+	var result []*unikornv1.Organization
+
+	for i := range organizationUsers.Items {
+		organization := &unikornv1.Organization{}
+
+		if err := a.client.Get(ctx, client.ObjectKey{Namespace: a.namespace, Name: organizationUsers.Items[i].Labels[constants.OrganizationLabel]}, organization); err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		result = append(result, organization)
+	}
+
+	return result, nil
+}
+
+// providerGroups looks up the user's groups with the provider for an organization.
+func providerGroups(ctx context.Context, driver providers.Provider, parameters *types.ConfigParameters, organization *unikornv1.Organization, token *oauth2.Token, user *unikornv1.User) ([]string, error) {
+	groupsParameters := &types.GroupsParameters{
+		ConfigParameters: types.ConfigParameters{
+			Host:         parameters.Host,
+			Provider:     parameters.Provider,
+			Organization: organization,
+		},
+		Token: token,
+		Email: user.Spec.Subject,
+	}
+
+	return driver.Groups(ctx, groupsParameters)
+}
+
+// syncGroups updates the user's membership of groups that are mapped to identity
+// provider groups, just in time at login, errors should deny the login.  A lookup
+// is only possible for the organization the login was routed through, as the provider
+// may need additional scopes to perform it.  Restrictions and memberships in any other
+// organization the user is a member of must still be honoured, otherwise logging in
+// some other way would bypass them.
+func (a *Authenticator) syncGroups(ctx context.Context, driver providers.Provider, parameters *types.ConfigParameters, token *oauth2.Token, user *unikornv1.User) error {
+	if parameters.Organization != nil {
+		if err := a.syncRoutedOrganizationGroups(ctx, driver, parameters, token, user); err != nil {
+			return err
+		}
+	}
+
+	organizations, err := a.memberOrganizations(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	for _, organization := range organizations {
+		if parameters.Organization != nil && parameters.Organization.Name == organization.Name {
+			continue
+		}
+
+		if err := a.syncMemberOrganizationGroups(ctx, driver, parameters, organization, token, user); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncRoutedOrganizationGroups synchronizes groups for the organization the login was
+// routed through.
+func (a *Authenticator) syncRoutedOrganizationGroups(ctx context.Context, driver providers.Provider, parameters *types.ConfigParameters, token *oauth2.Token, user *unikornv1.User) error {
+	// Always perform the lookup, as the provider may also use this to enforce
+	// organization specific login restrictions.
+	groups, err := providerGroups(ctx, driver, parameters, parameters.Organization, token, user)
+	if err != nil {
+		if goerrors.Is(err, providererrors.ErrGroupsUnsupported) {
+			log.FromContext(ctx).Info("oauth2: provider group lookup not supported, skipping group synchronization", "user", user.Name, "organization", parameters.Organization.Name)
+
+			return nil
+		}
+
+		return err
+	}
+
+	return a.setProviderGroups(ctx, parameters.Organization, user, groups)
+}
+
+// syncMemberOrganizationGroups synchronizes groups for an organization the user is a
+// member of, but the login wasn't routed through.  GitHub organization restrictions can
+// only be checked when the login is routed through an organization that is also
+// restricted, as that requests the required scopes, so any other route is denied.
+// Otherwise the user's groups cannot be looked up, so any previously synchronized
+// memberships cannot be trusted, and are removed until they login via the organization.
+func (a *Authenticator) syncMemberOrganizationGroups(ctx context.Context, driver providers.Provider, parameters *types.ConfigParameters, organization *unikornv1.Organization, token *oauth2.Token, user *unikornv1.User) error {
+	if githubOrganization(organization) != "" {
+		if ptr.Deref(parameters.Provider.Spec.Type, "") != unikornv1.GitHub || githubOrganization(parameters.Organization) == "" {
+			return fmt.Errorf("%w: organization %s", ErrGitHubOrganizationRoute, organization.Name)
+		}
+
+		groups, err := providerGroups(ctx, driver, parameters, organization, token, user)
+		if err != nil {
+			return err
+		}
+
+		return a.setProviderGroups(ctx, organization, user, groups)
+	}
+
+	if !groupLookupConfigured(organization) {
+		return nil
+	}
+
+	return a.setProviderGroups(ctx, organization, user, nil)
+}
+
+// setProviderGroups updates the user's membership of the organization's groups that
+// are mapped to provider groups.
+func (a *Authenticator) setProviderGroups(ctx context.Context, organization *unikornv1.Organization, user *unikornv1.User, providerGroups []string) error {
+	log := log.FromContext(ctx)
+
+	namespace := organization.Status.Namespace
+
+	groups := &unikornv1.GroupList{}
+
+	if err := a.client.List(ctx, groups, &client.ListOptions{Namespace: namespace}); err != nil {
+		return err
+	}
+
+	groups.Items = slices.DeleteFunc(groups.Items, func(group unikornv1.Group) bool {
+		return len(group.Spec.ProviderGroups) == 0
+	})
+
+	if len(groups.Items) == 0 {
+		return nil
+	}
+
+	organizationUserID, err := a.organizationUserID(ctx, namespace, user)
+	if err != nil {
+		return err
+	}
+
+	if organizationUserID == "" {
+		log.Info("oauth2: user is not an organization member, skipping group synchronization", "user", user.Name, "organization", organization.Name)
+
+		return nil
+	}
+
+	for i := range groups.Items {
+		group := &groups.Items[i]
+
+		matched := matchProviderGroups(group.Spec.ProviderGroups, providerGroups)
+		member := len(matched) != 0
+
+		changed, err := a.setGroupMembership(ctx, group, organizationUserID, member)
+		if err != nil {
+			return err
+		}
+
+		// Every decision is logged for audit, not just changes.
+		log.Info("oauth2: group mapping decision", "organization", organization.Name, "group", group.Name, "user", organizationUserID, "matched", matched, "member", member, "changed", changed)
+	}
+
+	return nil
+}
+
+// mapGroupClaims updates the user's membership of groups referenced by the provider's
+// claim mappings, just in time at login.  These are only applied for organization
+// scoped providers, as group IDs are only meaningful within that organization.
+func (a *Authenticator) mapGroupClaims(ctx context.Context, provider *unikornv1.OAuth2Provider, token *oauth2.Token, user *unikornv1.User) error {
+	log := log.FromContext(ctx)
+
+	if len(provider.Spec.GroupMappings) == 0 {
+		return nil
+	}
+
+	if provider.Namespace == a.namespace {
+		log.Info("oauth2: ignoring group mappings for global provider", "provider", provider.Name)
+
+		return nil
+	}
+
+	claims := map[string]any{}
+
+	if err := oidc.TokenClaims(token, &claims); err != nil {
+		return err
+	}
+
+	organizationUserID, err := a.organizationUserID(ctx, provider.Namespace, user)
+	if err != nil {
+		return err
+	}
+
+	if organizationUserID == "" {
+		log.Info("oauth2: user is not an organization member, skipping group mapping", "user", user.Name, "provider", provider.Name)
+
+		return nil
+	}
+
+	for _, result := range groupmapping.Evaluate(provider.Spec.GroupMappings, claims) {
+		group := &unikornv1.Group{}
+
+		if err := a.client.Get(ctx, client.ObjectKey{Namespace: provider.Namespace, Name: result.GroupID}, group); err != nil {
+			if kerrors.IsNotFound(err) {
+				log.Info("oauth2: group mapping references missing group", "provider", provider.Name, "group", result.GroupID)
+
+				continue
+			}
+
+			return err
+		}
+
+		changed, err := a.setGroupMembership(ctx, group, organizationUserID, result.Member)
+		if err != nil {
+			return err
+		}
+
+		// Every decision is logged for audit, not just changes.
+		log.Info("oauth2: group mapping decision", "provider", provider.Name, "group", group.Name, "user", organizationUserID, "matched", result.Matched, "member", result.Member, "changed", changed)
+	}
+
+	return nil
+}
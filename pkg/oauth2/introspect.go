@@ -0,0 +1,137 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	goerrors "errors"
+	"net/http"
+	"strings"
+
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/util"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// authenticateResourceServer checks the caller is allowed to introspect tokens.
+// Services present an mTLS client certificate, as they do for the client credentials
+// grant, otherwise we fall back to oauth2 client authentication.
+func (a *Authenticator) authenticateResourceServer(r *http.Request) error {
+	certPEM, err := util.GetClientCertificateHeader(r.Header)
+	if err != nil {
+		if !goerrors.Is(err, util.ErrClientCertificateNotPresent) {
+			return errors.OAuth2InvalidClient("mTLS client verification failed").WithError(err)
+		}
+
+		if _, err := a.authenticateClient(r); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if _, err := util.GetClientCertificate(certPEM); err != nil {
+		return errors.OAuth2InvalidClient("mTLS certificate validation failed").WithError(err)
+	}
+
+	return nil
+}
+
+// Introspect implements RFC 7662 token introspection.
+func (a *Authenticator) Introspect(w http.ResponseWriter, r *http.Request) (*openapi.TokenIntrospection, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, errors.OAuth2InvalidRequest("failed to parse form data").WithError(err)
+	}
+
+	if err := a.authenticateResourceServer(r); err != nil {
+		return nil, err
+	}
+
+	token := r.Form.Get("token")
+	if token == "" {
+		return nil, errors.OAuth2InvalidRequest("token not specified")
+	}
+
+	verifyInfo := &VerifyInfo{
+		Issuer:   "https://" + r.Host,
+		Audience: r.Host,
+		Token:    token,
+	}
+
+	claims, err := a.Verify(r.Context(), verifyInfo)
+	if err != nil {
+		// Tokens that fail validation aren't an error as per RFC 7662 section 2.2,
+		// and the reason is not disclosed to the caller.
+		log.FromContext(r.Context()).V(1).Info("introspected token inactive", "error", err)
+
+		return &openapi.TokenIntrospection{}, nil
+	}
+
+	result := &openapi.TokenIntrospection{
+		Active:    true,
+		Sub:       ptr.To(claims.Subject),
+		Iss:       ptr.To(claims.Issuer),
+		TokenType: ptr.To(openapi.IntrospectionTokenType(claims.Type)),
+	}
+
+	if claims.Expiry != nil {
+		result.Exp = ptr.To(int(claims.Expiry.Time().Unix()))
+	}
+
+	if claims.IssuedAt != nil {
+		result.Iat = ptr.To(int(claims.IssuedAt.Time().Unix()))
+	}
+
+	if len(claims.Audience) != 0 {
+		result.Aud = ptr.To([]string(claims.Audience))
+	}
+
+	if claims.Actor != nil {
+		result.Act = &openapi.TokenActor{
+			Sub: claims.Actor.Subject,
+		}
+	}
+
+	if claims.Confirmation != nil {
+		result.Cnf = &openapi.TokenConfirmation{
+			Jkt: ptr.To(claims.Confirmation.JWKThumbprint),
+		}
+	}
+
+	switch claims.Type {
+	case TokenTypeFederated:
+		result.ClientId = ptr.To(claims.Federated.ClientID)
+
+		if len(claims.Federated.Scope) != 0 {
+			result.Scope = ptr.To(strings.Join(claims.Federated.Scope, " "))
+		}
+	case TokenTypeServiceAccount:
+		result.OrganizationId = ptr.To(claims.ServiceAccount.OrganizationID)
+	case TokenTypeService:
+		// Service tokens may be bound to both a certificate and a DPoP key.
+		if result.Cnf == nil {
+			result.Cnf = &openapi.TokenConfirmation{}
+		}
+
+		result.Cnf.X5tS256 = ptr.To(claims.Service.X509Thumbprint)
+	}
+
+	return result, nil
+}
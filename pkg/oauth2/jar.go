@@ -0,0 +1,296 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	gojose "github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// jwksCacheDuration is how long to cache a client's JWKS fetched by URI.
+	jwksCacheDuration = 5 * time.Minute
+
+	// jwksMaxSize limits how much we will read from a client's JWKS URI.
+	jwksMaxSize = 1 << 20
+)
+
+//nolint:gochecknoglobals
+var (
+	// requestObjectSigningAlgorithms are the algorithms we accept for client signed
+	// JWTs, symmetric algorithms are not supported as the key would be the client
+	// secret, and "none" provides no integrity.
+	requestObjectSigningAlgorithms = []gojose.SignatureAlgorithm{
+		gojose.RS256,
+		gojose.RS384,
+		gojose.RS512,
+		gojose.PS256,
+		gojose.PS384,
+		gojose.PS512,
+		gojose.ES256,
+		gojose.ES384,
+		gojose.ES512,
+		gojose.EdDSA,
+	}
+
+	// requestObjectIgnoredClaims are JWT claims that aren't authorization request
+	// parameters, or that cannot be nested.
+	requestObjectIgnoredClaims = []string{
+		"iss",
+		"aud",
+		"exp",
+		"iat",
+		"nbf",
+		"jti",
+		"request",
+		"request_uri",
+	}
+)
+
+// clientJWKS returns the client's JSON web key set, either inline from the client
+// specification, or fetched from its JWKS URI.
+func (a *Authenticator) clientJWKS(ctx context.Context, client *unikornv1.OAuth2Client) (*gojose.JSONWebKeySet, error) {
+	if client.Spec.JWKS != nil {
+		jwks := &gojose.JSONWebKeySet{}
+
+		if err := json.Unmarshal([]byte(*client.Spec.JWKS), jwks); err != nil {
+			return nil, err
+		}
+
+		return jwks, nil
+	}
+
+	if client.Spec.JWKSURI == nil {
+		return nil, fmt.Errorf("%w: client has no JWKS", ErrKeyFormat)
+	}
+
+	uri := *client.Spec.JWKSURI
+
+	if value, ok := a.jwksCache.Get(uri); ok {
+		if jwks, ok := value.(*gojose.JSONWebKeySet); ok {
+			return jwks, nil
+		}
+	}
+
+	jwks, err := fetchJWKS(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	a.jwksCache.Add(uri, jwks, jwksCacheDuration)
+
+	return jwks, nil
+}
+
+// fetchJWKS reads a JSON web key set from a URI.
+func fetchJWKS(ctx context.Context, uri string) (*gojose.JSONWebKeySet, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: JWKS fetch returned status code %d", ErrKeyFormat, response.StatusCode)
+	}
+
+	jwks := &gojose.JSONWebKeySet{}
+
+	if err := json.NewDecoder(io.LimitReader(response.Body, jwksMaxSize)).Decode(jwks); err != nil {
+		return nil, err
+	}
+
+	return jwks, nil
+}
+
+// verifyClientJWT checks a JWT is signed by one of the client's keys, returning
+// the standard claims, and unmarshalling all claims into the optional output.
+func (a *Authenticator) verifyClientJWT(ctx context.Context, client *unikornv1.OAuth2Client, raw string, out any) (*jwt.Claims, error) {
+	token, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(token.Headers) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one header", ErrTokenVerification)
+	}
+
+	header := token.Headers[0]
+
+	if !slices.Contains(requestObjectSigningAlgorithms, gojose.SignatureAlgorithm(header.Algorithm)) {
+		return nil, fmt.Errorf("%w: signing algorithm %s not allowed", ErrTokenVerification, header.Algorithm)
+	}
+
+	jwks, err := a.clientJWKS(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := jwks.Keys
+
+	if header.KeyID != "" {
+		keys = jwks.Key(header.KeyID)
+	}
+
+	outputs := []any{
+		&jwt.Claims{},
+	}
+
+	if out != nil {
+		outputs = append(outputs, out)
+	}
+
+	for i := range keys {
+		if err := token.Claims(keys[i].Key, outputs...); err == nil {
+			//nolint:forcetypeassert
+			return outputs[0].(*jwt.Claims), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no matching key found", ErrTokenVerification)
+}
+
+// requestObjectQuery verifies a signed request object as defined by RFC 9101 and
+// returns its parameters, these replace the query entirely.
+func (a *Authenticator) requestObjectQuery(r *http.Request, client *unikornv1.OAuth2Client, query url.Values) (url.Values, error) {
+	parameters := map[string]any{}
+
+	claims, err := a.verifyClientJWT(r.Context(), client, query.Get("request"), &parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	expected := jwt.Expected{
+		Issuer: client.Name,
+		Audience: jwt.Audience{
+			"https://" + r.Host,
+		},
+		Time: time.Now(),
+	}
+
+	// Request objects must be short lived to limit replay.
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w: exp claim is required", ErrTokenVerification)
+	}
+
+	if err := claims.ValidateWithLeeway(expected, a.options.TokenVerificationLeeway); err != nil {
+		return nil, err
+	}
+
+	// The client was looked up from the query, so the request object must agree.
+	if clientID, ok := parameters["client_id"]; ok && clientID != query.Get("client_id") {
+		return nil, fmt.Errorf("%w: client_id mismatch", ErrTokenVerification)
+	}
+
+	// RFC 9101 section 6.3 mandates that only the parameters in the request object
+	// are used, otherwise unsigned parameters could be injected.
+	merged := url.Values{}
+	merged.Set("client_id", client.Name)
+
+	for key, value := range parameters {
+		if slices.Contains(requestObjectIgnoredClaims, key) {
+			continue
+		}
+
+		switch t := value.(type) {
+		case string:
+			merged.Set(key, t)
+		case float64:
+			merged.Set(key, strconv.FormatFloat(t, 'f', -1, 64))
+		case bool:
+			merged.Set(key, strconv.FormatBool(t))
+		default:
+			// Structured parameters e.g. "claims" are JSON encoded.
+			data, err := json.Marshal(t)
+			if err != nil {
+				return nil, err
+			}
+
+			merged.Set(key, string(data))
+		}
+	}
+
+	return merged, nil
+}
+
+// authorizationRequestObject handles an authorization request containing a signed
+// request object.  As the request object may contain the redirect URI, errors are
+// returned to the client's registered redirect URI, or the one in the query if the
+// client has many.
+func (a *Authenticator) authorizationRequestObject(w http.ResponseWriter, r *http.Request, query url.Values) (url.Values, bool) {
+	if !query.Has("client_id") {
+		htmlError(w, r, http.StatusBadRequest, "client_id is not specified")
+
+		return nil, false
+	}
+
+	client, err := a.lookupClient(r.Context(), query.Get("client_id"))
+	if err != nil {
+		htmlError(w, r, http.StatusBadRequest, "client_id does not exist")
+
+		return nil, false
+	}
+
+	redirectURI, ok := defaultRedirectURI(client, query.Get("redirect_uri"))
+	if !ok {
+		htmlError(w, r, http.StatusBadRequest, "redirect_uri is invalid")
+
+		return nil, false
+	}
+
+	redirector := newRedirector(w, r, redirectURI, query.Get("state"))
+
+	if client.Spec.JWKS == nil && client.Spec.JWKSURI == nil {
+		redirector.raise(ErrorRequestNotSupported, "client has no keys to verify request objects")
+
+		return nil, false
+	}
+
+	merged, err := a.requestObjectQuery(r, client, query)
+	if err != nil {
+		log.FromContext(r.Context()).Info("oauth2: request object verification failed", "error", err)
+
+		redirector.raise(ErrorInvalidRequestObject, "request object is invalid")
+
+		return nil, false
+	}
+
+	return merged, true
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/html"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// clearSessionCookie removes the single sign-on cookie so silent authentication
+// will no longer work.
+func clearSessionCookie(w http.ResponseWriter, r *http.Request) {
+	cookie := &http.Cookie{
+		Name:     SessionCookie,
+		Path:     "/",
+		Domain:   r.Host,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   -1,
+	}
+
+	w.Header().Add("Set-Cookie", cookie.String())
+}
+
+// logoutIDTokenHint validates the ID token hint, returning the token's claims.
+// The ID token may have expired, as is common for logout, so only the signature,
+// the issuer and the client are checked.
+func (a *Authenticator) logoutIDTokenHint(r *http.Request, clientID string) (*oidc.IDToken, error) {
+	idToken := &oidc.IDToken{}
+
+	if err := a.issuer.DecodeJWT(r.Context(), r.Form.Get("id_token_hint"), idToken); err != nil {
+		return nil, err
+	}
+
+	if idToken.Issuer != "https://"+r.Host {
+		return nil, ErrTokenVerification
+	}
+
+	if clientID != "" && !idToken.Audience.Contains(clientID) {
+		return nil, ErrTokenVerification
+	}
+
+	return idToken, nil
+}
+
+// Logout implements OpenID Connect RP-Initiated Logout 1.0.  Like the authorization
+// endpoint this either redirects or renders HTML.
+//
+//nolint:cyclop
+func (a *Authenticator) Logout(w http.ResponseWriter, r *http.Request) {
+	log := log.FromContext(r.Context())
+
+	if err := r.ParseForm(); err != nil {
+		htmlError(w, r, http.StatusBadRequest, "form parse failure")
+		return
+	}
+
+	clientID := r.Form.Get("client_id")
+
+	var idToken *oidc.IDToken
+
+	if r.Form.Has("id_token_hint") {
+		t, err := a.logoutIDTokenHint(r, clientID)
+		if err != nil {
+			htmlError(w, r, http.StatusBadRequest, "id_token_hint is invalid")
+			return
+		}
+
+		idToken = t
+
+		if clientID == "" {
+			clientID = idToken.AuthorizedParty
+		}
+	}
+
+	// The redirect URI must be registered with the client, or we become an
+	// open redirector.
+	redirectURI := r.Form.Get("post_logout_redirect_uri")
+
+	var client *unikornv1.OAuth2Client
+
+	if clientID != "" {
+		c, err := a.lookupClient(r.Context(), clientID)
+		if err != nil {
+			htmlError(w, r, http.StatusBadRequest, "client_id does not exist")
+			return
+		}
+
+		client = c
+	}
+
+	if redirectURI != "" && (client == nil || !slices.Contains(client.Spec.PostLogoutRedirectURIs, redirectURI)) {
+		htmlError(w, r, http.StatusBadRequest, "post_logout_redirect_uri is invalid")
+		return
+	}
+
+	// Only the holder of an ID token can terminate the user's sessions, otherwise
+	// a malicious site could log users out of arbitrary clients.  Logout ends single
+	// sign-on, but only the session the ID token was issued for is terminated, other
+	// clients, and other sessions with the same client e.g. on another device, are
+	// unaffected.  ID tokens issued before sessions were identified terminate all of
+	// the user's sessions with the client.
+	if idToken != nil && client != nil {
+		predicate := func(session *unikornv1.UserSession) bool {
+			return session.Spec.ClientID == client.Name && (idToken.SessionID == "" || session.Spec.ID == idToken.SessionID)
+		}
+
+		user, err := a.rbac.GetUser(r.Context(), idToken.Subject)
+		if err == nil {
+			if err := a.LogoutUserSessions(r.Context(), "https://"+r.Host, user, client.Name, predicate); err != nil {
+				htmlError(w, r, http.StatusInternalServerError, "failed to remove user sessions")
+				return
+			}
+		}
+	}
+
+	clearSessionCookie(w, r)
+
+	if redirectURI != "" {
+		u, err := url.Parse(redirectURI)
+		if err != nil {
+			htmlError(w, r, http.StatusBadRequest, "post_logout_redirect_uri failed to parse")
+			return
+		}
+
+		if r.Form.Has("state") {
+			query := u.Query()
+			query.Set("state", r.Form.Get("state"))
+
+			u.RawQuery = query.Encode()
+		}
+
+		http.Redirect(w, r, u.String(), http.StatusFound)
+
+		return
+	}
+
+	body, err := html.Logout()
+	if err != nil {
+		htmlError(w, r, http.StatusInternalServerError, "failed to render logout template")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(body); err != nil {
+		log.Info("oauth2: failed to write HTML response")
+	}
+}
@@ -49,8 +49,11 @@ import (
 	"github.com/unikorn-cloud/identity/pkg/html"
 	"github.com/unikorn-cloud/identity/pkg/jose"
 	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/dpop"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/providers"
+	providererrors "github.com/unikorn-cloud/identity/pkg/oauth2/providers/errors"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/sessions"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/types"
 	"github.com/unikorn-cloud/identity/pkg/openapi"
 	"github.com/unikorn-cloud/identity/pkg/rbac"
@@ -81,6 +84,15 @@ type Options struct {
 	// period.
 	RefreshTokenDuration time.Duration
 
+	// RefreshTokenReuseGracePeriod allows a superseded refresh token to be
+	// presented shortly after rotation without being treated as a replay,
+	// e.g. when multiple browser tabs refresh at once.
+	RefreshTokenReuseGracePeriod time.Duration
+
+	// MaxSessionsPerClient limits how many concurrent sessions a user may have
+	// with a single client, the oldest are evicted first.
+	MaxSessionsPerClient int
+
 	// TokenVerificationLeeway tells us how permissive we should or shouldn't
 	// be of timing.
 	TokenVerificationLeeway time.Duration
@@ -101,6 +113,34 @@ type Options struct {
 	// in flight.
 	AccountCreationCacheSize int
 
+	// DeviceCodeDuration is how long a device has to be approved by the user
+	// before the device code expires.
+	DeviceCodeDuration time.Duration
+
+	// DeviceCodePollInterval is how often a device may poll for a token.
+	DeviceCodePollInterval time.Duration
+
+	// PushedAuthorizationRequestDuration is how long a pushed authorization
+	// request can be used for.
+	PushedAuthorizationRequestDuration time.Duration
+
+	// TokenExchangeDuration is the maximum lifetime of a delegated token.
+	TokenExchangeDuration time.Duration
+
+	// BackchannelLogoutTimeout is how long to wait for a client to respond
+	// to a back-channel logout notification.
+	BackchannelLogoutTimeout time.Duration
+
+	// BackchannelLogoutAttempts is how many times to try delivering a
+	// back-channel logout notification before giving up.
+	BackchannelLogoutAttempts int
+
+	// RegistrationInitialAccessTokenFile, when set, contains a bearer token that
+	// allows dynamic client registration without an access token that grants
+	// permission to create clients.  This is read from a file so it's not exposed
+	// on the command line, and so it can be rotated without a restart.
+	RegistrationInitialAccessTokenFile string
+
 	// AccountCreationEnabled is used to permit new account creation.
 	AccountCreationEnabled bool
 
@@ -119,11 +159,20 @@ type Options struct {
 func (o *Options) AddFlags(f *pflag.FlagSet) {
 	f.DurationVar(&o.AccessTokenDuration, "access-token-duration", time.Hour, "Maximum time an access token can be active for.")
 	f.DurationVar(&o.RefreshTokenDuration, "refresh-token-duration", 0, "Maximum time a refresh token can be active for.")
+	f.DurationVar(&o.RefreshTokenReuseGracePeriod, "refresh-token-reuse-grace-period", 10*time.Second, "How long a superseded refresh token can be presented without revoking the session.")
+	f.IntVar(&o.MaxSessionsPerClient, "max-sessions-per-client", 10, "Maximum number of concurrent sessions a user may have per client.")
 	f.DurationVar(&o.TokenVerificationLeeway, "token-verification-leeway", 0, "How mush leeway to permit for verification of token validity.")
 	f.DurationVar(&o.TokenLeewayDuration, "token-leeway", time.Minute, "How long to remove from the provider token expiry to account for network and processing latency.")
 	f.IntVar(&o.TokenCacheSize, "token-cache-size", 8192, "How many token cache entries to allow.")
 	f.IntVar(&o.CodeCacheSize, "code-cache-size", 8192, "How many code cache entries to allow.")
 	f.IntVar(&o.AccountCreationCacheSize, "account-creation-cache-size", 8192, "How many account creation cache entries to allow.")
+	f.DurationVar(&o.DeviceCodeDuration, "device-code-duration", 10*time.Minute, "How long a device code is valid for.")
+	f.DurationVar(&o.DeviceCodePollInterval, "device-code-poll-interval", 5*time.Second, "Minimum interval between device token requests.")
+	f.DurationVar(&o.PushedAuthorizationRequestDuration, "pushed-authorization-request-duration", time.Minute, "How long a pushed authorization request is valid for.")
+	f.DurationVar(&o.TokenExchangeDuration, "token-exchange-duration", 5*time.Minute, "Maximum time a delegated token can be active for.")
+	f.DurationVar(&o.BackchannelLogoutTimeout, "backchannel-logout-timeout", 10*time.Second, "How long to wait for a client to acknowledge a back-channel logout.")
+	f.IntVar(&o.BackchannelLogoutAttempts, "backchannel-logout-attempts", 5, "How many times to attempt back-channel logout delivery.")
+	f.StringVar(&o.RegistrationInitialAccessTokenFile, "registration-initial-access-token-file", "", "File containing a bearer token that permits dynamic client registration.")
 	f.BoolVar(&o.AccountCreationEnabled, "account-creation-enabled", false, "Whether to allow accounts to be created.")
 	f.StringSliceVar(&o.AccountCreationDefaultRoles, "account-creation-default-roles", []string{"administrator"}, "Default role names to grant a account creators user.")
 	f.StringVar(&o.AccountCreationWebhookURI, "account-creation-webhook-uri", "", "URI to post user signup data.")
@@ -143,30 +192,67 @@ type Authenticator struct {
 
 	rbac *rbac.RBAC
 
+	// sessions stores user sessions.
+	sessions sessions.Store
+
 	// tokenCache is used to enhance interaction as the validation is a
 	// very expensive operation.
 	tokenCache *cache.LRUExpireCache
 
+	// revocations records recent token revocations for remote token caches.
+	revocations *revocationFeed
+
 	// codeCache is used to protect against authorization code reuse.
 	codeCache *cache.LRUExpireCache
 
 	// accountCreationCache is used to ensure an account creation token
 	// can only be used once.
 	accountCreationCache *cache.LRUExpireCache
+
+	// deviceCodeCache is used to rate limit device token polling.
+	deviceCodeCache *cache.LRUExpireCache
+
+	// pushedAuthorizationRequestCache records pushed authorization requests
+	// that have been used.
+	pushedAuthorizationRequestCache *cache.LRUExpireCache
+
+	// jwksCache stores client key sets fetched by URI.
+	jwksCache *cache.LRUExpireCache
+
+	// dpopVerifier checks DPoP proofs presented to the token endpoint.
+	dpopVerifier *dpop.Verifier
+
+	// samlMetadataCache stores SAML identity provider metadata fetched by URL.
+	samlMetadataCache *cache.LRUExpireCache
+
+	// samlAssertionCache is used to protect against SAML assertion reuse.
+	// This is per-replica, so a replay to another replica isn't detected,
+	// however that also requires the browser's state cookie, and must happen
+	// within the assertion's short validity period.
+	samlAssertionCache *cache.LRUExpireCache
 }
 
 // New returns a new authenticator with required fields populated.
 // You must call AddFlags after this.
-func New(options *Options, namespace string, client client.Client, issuer *jose.JWTIssuer, rbac *rbac.RBAC) *Authenticator {
+func New(options *Options, namespace string, client client.Client, issuer *jose.JWTIssuer, rbac *rbac.RBAC, sessions sessions.Store) *Authenticator {
 	return &Authenticator{
 		options:              options,
 		namespace:            namespace,
 		client:               client,
 		issuer:               issuer,
 		rbac:                 rbac,
+		sessions:             sessions,
 		tokenCache:           cache.NewLRUExpireCache(options.TokenCacheSize),
+		revocations:          newRevocationFeed(),
 		codeCache:            cache.NewLRUExpireCache(options.CodeCacheSize),
 		accountCreationCache: cache.NewLRUExpireCache(options.AccountCreationCacheSize),
+		deviceCodeCache:      cache.NewLRUExpireCache(options.CodeCacheSize),
+
+		pushedAuthorizationRequestCache: cache.NewLRUExpireCache(options.CodeCacheSize),
+		jwksCache:                       cache.NewLRUExpireCache(options.CodeCacheSize),
+		dpopVerifier:                    dpop.NewVerifier(options.CodeCacheSize),
+		samlMetadataCache:               cache.NewLRUExpireCache(options.CodeCacheSize),
+		samlAssertionCache:              cache.NewLRUExpireCache(options.CodeCacheSize),
 	}
 }
 
@@ -184,6 +270,14 @@ const (
 	ErrorRequestURINotSupported   Error = "request_uri_not_supported"
 	ErrorInteractionRequired      Error = "interaction_required"
 	ErrorRegistrationNotSupported Error = "registration_not_supported"
+	ErrorAuthorizationPending     Error = "authorization_pending"
+	ErrorSlowDown                 Error = "slow_down"
+	ErrorExpiredToken             Error = "expired_token"
+	ErrorInvalidRedirectURI       Error = "invalid_redirect_uri"
+	ErrorInvalidClientMetadata    Error = "invalid_client_metadata"
+	ErrorInvalidRequestObject     Error = "invalid_request_object"
+	ErrorInvalidDPoPProof         Error = "invalid_dpop_proof"
+	ErrorUnsupportedTokenType     Error = "unsupported_token_type"
 )
 
 // State records state across the call to the authorization server.
@@ -199,6 +293,13 @@ type State struct {
 	OAuth2Provider string `json:"oap"`
 	// ClientQuery stores the full client query string.
 	ClientQuery string `json:"cq"`
+	// OrganizationID is set when the provider was inferred from the user's
+	// organization, and allows organization specific provider options, e.g.
+	// group synchronization, to be used.
+	OrganizationID string `json:"oid,omitempty"`
+	// SAMLRequestID is the ID of the SAML authentication request, the
+	// response must be in response to this.
+	SAMLRequestID string `json:"srid,omitempty"`
 }
 
 // OnboardingState propagates information across an onboarding dialog.
@@ -231,6 +332,11 @@ type Code struct {
 	// Interactive declares whether this is an interactive login
 	// or not (e.g. cookie based).
 	Interactive bool `json:"int"`
+	// SessionID is set for silent re-authentication, and continues the
+	// existing session rather than starting a new one.
+	SessionID string `json:"sid,omitempty"`
+	// SourceIP is the address the user authenticated from.
+	SourceIP string `json:"ip,omitempty"`
 }
 
 // htmlError is used in dire situations when we cannot return an error via
@@ -276,8 +382,14 @@ func (e *redirector) redirect(values url.Values) {
 }
 
 // raise redirects to the client's callback URI with an error
-// code in the query.
+// code in the query.  Flows without a client callback, e.g. device
+// authorization, render the error instead.
 func (e *redirector) raise(kind Error, description string) {
+	if e.redirectURI == "" {
+		htmlError(e.w, e.r, http.StatusBadRequest, description)
+		return
+	}
+
 	values := url.Values{}
 	values.Set("error", string(kind))
 	values.Set("error_description", description)
@@ -428,7 +540,7 @@ func (a *Authenticator) authorizationValidateNonRedirecting(w http.ResponseWrite
 		return nil, false
 	}
 
-	if client.Spec.RedirectURI != query.Get("redirect_uri") {
+	if !redirectURIAllowed(client, query.Get("redirect_uri")) {
 		htmlError(w, r, http.StatusBadRequest, "redirect_uri is invalid")
 
 		return nil, false
@@ -468,11 +580,6 @@ var (
 // the redirect URI has been validated.  If any of these fail, we redirect but with an
 // error query rather than a code for the client to pick up and run with.
 func authorizationValidateRedirecting(redirector *redirector, query url.Values) bool {
-	if query.Has("request") {
-		redirector.raise(ErrorRequestNotSupported, "request object by value not supported")
-		return false
-	}
-
 	if query.Has("request_uri") {
 		redirector.raise(ErrorRequestURINotSupported, "request object by URI not supported")
 		return false
@@ -573,7 +680,12 @@ func (a *Authenticator) authorizationSilent(r *http.Request, redirector *redirec
 		return false
 	}
 
-	session, err := user.Session(query.Get("client_id"))
+	// The cookie is bound to the session created when it was exchanged.
+	lookupSession := func(session *unikornv1.UserSession) bool {
+		return session.Spec.ClientID == query.Get("client_id") && session.Spec.AuthorizationCodeID == code.ID
+	}
+
+	session, err := a.findSession(r.Context(), user.Name, lookupSession)
 	if err != nil {
 		return false
 	}
@@ -588,11 +700,11 @@ func (a *Authenticator) authorizationSilent(r *http.Request, redirector *redirec
 			return false
 		}
 
-		if session.LastAuthentication == nil {
+		if session.Spec.LastAuthentication == nil {
 			return false
 		}
 
-		if session.LastAuthentication.Add(time.Duration(maxAge) * time.Second).Before(time.Now()) {
+		if session.Spec.LastAuthentication.Add(time.Duration(maxAge) * time.Second).Before(time.Now()) {
 			return false
 		}
 	}
@@ -604,6 +716,8 @@ func (a *Authenticator) authorizationSilent(r *http.Request, redirector *redirec
 		ClientQuery:    query.Encode(),
 		OAuth2Provider: code.OAuth2Provider,
 		IDToken:        code.IDToken,
+		SessionID:      session.Spec.ID,
+		SourceIP:       sourceIP(r),
 	}
 
 	newCode, err := a.issuer.EncodeJWEToken(r.Context(), oauth2Code, jose.TokenTypeAuthorizationCode)
@@ -645,12 +759,34 @@ func getAuthorizationQuery(r *http.Request) (url.Values, error) {
 //
 //nolint:cyclop
 func (a *Authenticator) Authorization(w http.ResponseWriter, r *http.Request) {
-	log := log.FromContext(r.Context())
-
 	// Extract the client supplied parameters.
 	query, err := getAuthorizationQuery(r)
 	if err != nil {
 		htmlError(w, r, http.StatusBadRequest, "failed to get authorization query")
+		return
+	}
+
+	// Pushed authorization requests replace the query entirely.
+	pushed := isPushedAuthorizationRequest(query)
+
+	if pushed {
+		q, err := a.pushedAuthorizationRequest(r, query)
+		if err != nil {
+			htmlError(w, r, http.StatusBadRequest, "request_uri is invalid")
+			return
+		}
+
+		query = q
+	}
+
+	// Signed request objects are verified and replace the query.
+	if query.Has("request") {
+		q, ok := a.authorizationRequestObject(w, r, query)
+		if !ok {
+			return
+		}
+
+		query = q
 	}
 
 	// Get the client corresponding to the request, if this errors then we cannot
@@ -660,7 +796,12 @@ func (a *Authenticator) Authorization(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	redirector := newRedirector(w, r, client.Spec.RedirectURI, query.Get("state"))
+	redirector := newRedirector(w, r, query.Get("redirect_uri"), query.Get("state"))
+
+	if client.Spec.RequirePushedAuthorizationRequests && !pushed {
+		redirector.raise(ErrorInvalidRequest, "pushed authorization request required")
+		return
+	}
 
 	// Validate the other request parameters based on what we support, on error this
 	// returns control back to the client via the redirect.
@@ -682,6 +823,14 @@ func (a *Authenticator) Authorization(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	a.login(w, r, redirector, query, client.Spec.LoginURI)
+}
+
+// login renders the login dialog, or redirects to an external one if the client
+// has one defined.
+func (a *Authenticator) login(w http.ResponseWriter, r *http.Request, redirector *redirector, query url.Values, loginURI *string) {
+	log := log.FromContext(r.Context())
+
 	// Encrypt the query across the login dialog to prevent tampering.
 	stateClaims := &LoginStateClaims{
 		Query: query.Encode(),
@@ -706,8 +855,8 @@ func (a *Authenticator) Authorization(w http.ResponseWriter, r *http.Request) {
 	loginQuery.Set("providers", strings.Join(supportedTypes, " "))
 
 	// Redirect to an external login handler, if you have chosen to.
-	if client.Spec.LoginURI != nil {
-		http.Redirect(w, r, fmt.Sprintf("%s?%s", *client.Spec.LoginURI, loginQuery.Encode()), http.StatusFound)
+	if loginURI != nil {
+		http.Redirect(w, r, fmt.Sprintf("%s?%s", *loginURI, loginQuery.Encode()), http.StatusFound)
 		return
 	}
 
@@ -762,7 +911,7 @@ func (a *Authenticator) Login(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		a.providerAuthenticationRequest(w, r, redirector, provider, query, "")
+		a.providerAuthenticationRequest(w, r, redirector, provider, nil, query, "")
 
 		return
 	}
@@ -786,12 +935,18 @@ func (a *Authenticator) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	a.providerAuthenticationRequest(w, r, redirector, provider, query, email)
+	a.providerAuthenticationRequest(w, r, redirector, provider, organization, query, email)
 }
 
 // providerAuthenticationRequest kicks off the authorization flow with the backend
-// provider.
-func (a *Authenticator) providerAuthenticationRequest(w http.ResponseWriter, r *http.Request, redirector *redirector, provider *unikornv1.OAuth2Provider, query url.Values, email string) {
+// provider.  The organization is optional, and is only known when inferred from
+// the user's email address.
+func (a *Authenticator) providerAuthenticationRequest(w http.ResponseWriter, r *http.Request, redirector *redirector, provider *unikornv1.OAuth2Provider, organization *unikornv1.Organization, query url.Values, email string) {
+	if isSAML(provider) {
+		a.samlAuthenticationRequest(w, r, redirector, provider, organization, query)
+		return
+	}
+
 	// Try infer the email address if one was not specified.
 	if email == "" && query.Has("login_hint") {
 		email = query.Get("login_hint")
@@ -824,6 +979,10 @@ func (a *Authenticator) providerAuthenticationRequest(w http.ResponseWriter, r *
 		ClientQuery:    query.Encode(),
 	}
 
+	if organization != nil {
+		oidcState.OrganizationID = organization.Name
+	}
+
 	state, err := a.issuer.EncodeJWEToken(r.Context(), oidcState, jose.TokenTypeLoginState)
 	if err != nil {
 		redirector.raise(ErrorServerError, "failed to encode oidc state: "+err.Error())
@@ -833,8 +992,9 @@ func (a *Authenticator) providerAuthenticationRequest(w http.ResponseWriter, r *
 	driver := providers.New(provider.Spec.Type)
 
 	configParameters := &types.ConfigParameters{
-		Host:     r.Host,
-		Provider: provider,
+		Host:         r.Host,
+		Provider:     provider,
+		Organization: organization,
 	}
 
 	config, err := driver.Config(r.Context(), configParameters)
@@ -865,7 +1025,7 @@ func (a *Authenticator) providerAuthenticationRequest(w http.ResponseWriter, r *
 // refresh token.  Remember, as far as the client is concerned we're still doing
 // the code grant, so return errors in the redirect query.
 //
-//nolint:cyclop,nestif
+//nolint:cyclop
 func (a *Authenticator) Callback(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
 
@@ -902,28 +1062,74 @@ func (a *Authenticator) Callback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	provider, err := a.lookupProviderByID(r.Context(), state.OAuth2Provider, nil)
+	var organization *unikornv1.Organization
+
+	if state.OrganizationID != "" {
+		organization = &unikornv1.Organization{}
+
+		if err := a.client.Get(r.Context(), client.ObjectKey{Namespace: a.namespace, Name: state.OrganizationID}, organization); err != nil {
+			redirector.raise(ErrorServerError, "failed to get organization")
+			return
+		}
+	}
+
+	// Organization scoped providers are only allowed for the organization
+	// the provider was selected for.
+	provider, err := a.lookupProviderByID(r.Context(), state.OAuth2Provider, organization)
 	if err != nil {
 		redirector.raise(ErrorServerError, "failed to get oauth2 provider")
 		return
 	}
 
+	// SAML responses are only accepted by the assertion consumer service.
+	if isSAML(provider) {
+		redirector.raise(ErrorServerError, "provider is not an oauth2 provider")
+		return
+	}
+
 	parameters := &types.CodeExchangeParameters{
 		ConfigParameters: types.ConfigParameters{
-			Host:     r.Host,
-			Provider: provider,
+			Host:         r.Host,
+			Provider:     provider,
+			Organization: organization,
 		},
 		Code:         query.Get("code"),
 		CodeVerifier: state.CodeVerifier,
 	}
 
-	_, idToken, err := providers.New(provider.Spec.Type).CodeExchange(r.Context(), parameters)
+	driver := providers.New(provider.Spec.Type)
+
+	token, idToken, err := driver.CodeExchange(r.Context(), parameters)
 	if err != nil {
 		redirector.raise(ErrorServerError, "code exchange failed: "+err.Error())
 		return
 	}
 
-	// Now we have done code exchange, we have access to the id_token and that
+	// Update group membership before any tokens are issued so they reflect the
+	// identity provider's view of the world.
+	sync := func(ctx context.Context, user *unikornv1.User) error {
+		if err := a.syncGroups(ctx, driver, &parameters.ConfigParameters, token, user); err != nil {
+			return fmt.Errorf("group synchronization failed: %w", err)
+		}
+
+		if err := a.mapGroupClaims(ctx, provider, token, user); err != nil {
+			return fmt.Errorf("group mapping failed: %w", err)
+		}
+
+		return nil
+	}
+
+	a.federatedLogin(w, r, redirector, state, clientQuery, idToken, sync)
+}
+
+// federatedLogin completes a login once the identity provider has authenticated the
+// user, regardless of protocol.  Existing users are optionally synchronized with the
+// provider, then either issued with an authorization code or have their device
+// approved, and new users are handed over to onboarding.
+//
+//nolint:cyclop,nestif
+func (a *Authenticator) federatedLogin(w http.ResponseWriter, r *http.Request, redirector *redirector, state *State, clientQuery url.Values, idToken *oidc.IDToken, sync func(context.Context, *unikornv1.User) error) {
+	// Now the user is authenticated, we have access to the ID token and that
 	// allows us to see if the user actually exists.  If it doesn't then we
 	// either deny entry or let them signup.
 	user, err := a.rbac.GetUser(r.Context(), idToken.Email.Email)
@@ -989,6 +1195,31 @@ func (a *Authenticator) Callback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if sync != nil {
+		// The error may contain internal details, so is only logged, and the client
+		// receives a generic message.
+		if err := sync(r.Context(), user); err != nil {
+			log.FromContext(r.Context()).Info("oauth2: group synchronization failed", "user", user.Name, "error", err)
+
+			if goerrors.Is(err, providererrors.ErrAccessDenied) {
+				redirector.raise(ErrorAccessDenied, "access denied by identity provider")
+				return
+			}
+
+			redirector.raise(ErrorServerError, "group synchronization failed")
+
+			return
+		}
+	}
+
+	// Device authorization has no client to return a code to, instead the
+	// user is asked to approve the device, and that is recorded for the device
+	// to collect.
+	if isDeviceAuthorization(clientQuery) {
+		a.deviceAuthorizationConsent(w, r, redirector, user, state.OAuth2Provider, clientQuery.Get("user_code"))
+		return
+	}
+
 	code := &Code{
 		ID:             uuid.New().String(),
 		UserID:         user.Name,
@@ -996,6 +1227,7 @@ func (a *Authenticator) Callback(w http.ResponseWriter, r *http.Request) {
 		OAuth2Provider: state.OAuth2Provider,
 		Interactive:    true,
 		IDToken:        idToken,
+		SourceIP:       sourceIP(r),
 	}
 
 	a.authorizationCodeRedirect(w, r, redirector, clientQuery, code)
@@ -1257,7 +1489,7 @@ func (a *Authenticator) Onboard(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	user, err := users.New(r.Host, a.client, a.namespace, a.issuer, &users.Options{}).Create(ctx, organization.Metadata.Id, userRequest)
+	user, err := users.New(r.Host, a.client, a.namespace, a.issuer, a, &users.Options{}).Create(ctx, organization.Metadata.Id, userRequest)
 	if err != nil {
 		redirector.raise(ErrorServerError, "failed to create user")
 		return
@@ -1270,7 +1502,7 @@ func (a *Authenticator) Onboard(w http.ResponseWriter, r *http.Request) {
 	// Finally when the optional webhook is
 	userRequest.Spec.State = openapi.Active
 
-	if _, err := users.New(r.Host, a.client, a.namespace, a.issuer, &users.Options{}).Update(ctx, organization.Metadata.Id, user.Metadata.Id, userRequest); err != nil {
+	if _, err := users.New(r.Host, a.client, a.namespace, a.issuer, a, &users.Options{}).Update(ctx, organization.Metadata.Id, user.Metadata.Id, userRequest); err != nil {
 		redirector.raise(ErrorServerError, "failed to create user")
 		return
 	}
@@ -1288,6 +1520,7 @@ func (a *Authenticator) Onboard(w http.ResponseWriter, r *http.Request) {
 		OAuth2Provider: state.OAuth2Provider,
 		Interactive:    true,
 		IDToken:        state.IDToken,
+		SourceIP:       sourceIP(r),
 	}
 
 	a.authorizationCodeRedirect(w, r, redirector, clientQuery, code)
@@ -1366,12 +1599,18 @@ func tokenValidate(r *http.Request) error {
 	return nil
 }
 
-// tokenValidateCode validates the request against the parsed code.
-func tokenValidateCode(r *http.Request, query url.Values) error {
+// tokenValidateCode validates the request against the parsed code.  The redirect
+// URI must be identical to the one used in the authorization request, and still be
+// allowed by the client, using the same matching rules.
+func tokenValidateCode(r *http.Request, client *unikornv1.OAuth2Client, query url.Values) error {
 	if query.Get("redirect_uri") != r.Form.Get("redirect_uri") {
 		return errors.OAuth2InvalidGrant("redirect_uri mismatch")
 	}
 
+	if !redirectURIAllowed(client, r.Form.Get("redirect_uri")) {
+		return errors.OAuth2InvalidGrant("redirect_uri is invalid")
+	}
+
 	// PKCE is optional, but highly recommended!
 	if query.Has("code_challenge") {
 		switch getCodeChallengeMethod(query) {
@@ -1399,7 +1638,7 @@ func oidcHash(value string) string {
 }
 
 // oidcIDToken builds an OIDC ID token.
-func (a *Authenticator) oidcIDToken(r *http.Request, idToken *oidc.IDToken, query url.Values, expiry time.Duration, atHash string, lastAuthenticationTime time.Time) (*string, error) {
+func (a *Authenticator) oidcIDToken(r *http.Request, idToken *oidc.IDToken, query url.Values, expiry time.Duration, tokens *Tokens) (*string, error) {
 	scope := strings.Split(query.Get("scope"), " ")
 
 	//nolint:nilnil
@@ -1420,15 +1659,13 @@ func (a *Authenticator) oidcIDToken(r *http.Request, idToken *oidc.IDToken, quer
 		},
 		Default: oidc.Default{
 			Nonce:           query.Get("nonce"),
-			AuthTime:        ptr.To(lastAuthenticationTime.Unix()),
+			ATHash:          oidcHash(tokens.AccessToken),
+			AuthTime:        ptr.To(tokens.LastAuthenticationTime.Unix()),
 			AuthorizedParty: query.Get("client_id"),
+			SessionID:       tokens.SessionID,
 		},
 	}
 
-	if atHash != "" {
-		claims.Default.ATHash = atHash
-	}
-
 	// NOTE: the scope here is intended to defined what happens when you call the
 	// userinfo endpoint (and probably the "code id_token" grant type), but Google
 	// etc. all do this, so why not...
@@ -1449,34 +1686,7 @@ func (a *Authenticator) oidcIDToken(r *http.Request, idToken *oidc.IDToken, quer
 }
 
 func (a *Authenticator) validateClientSecret(r *http.Request, query url.Values) error {
-	clientID, clientSecret, ok := r.BasicAuth()
-	if !ok {
-		if !r.Form.Has("client_id") || !r.Form.Has("client_secret") {
-			return errors.OAuth2ServerError("client ID secret not set in request body")
-		}
-
-		clientID = r.Form.Get("client_id")
-		clientSecret = r.Form.Get("client_secret")
-	}
-
-	if query.Get("client_id") != clientID {
-		return errors.OAuth2InvalidGrant("client_id mismatch")
-	}
-
-	client, err := a.lookupClient(r.Context(), query.Get("client_id"))
-	if err != nil {
-		return errors.OAuth2ServerError("failed to lookup client").WithError(err)
-	}
-
-	if client.Status.Secret == "" {
-		return errors.OAuth2ServerError("client secret not set")
-	}
-
-	if client.Status.Secret != clientSecret {
-		return errors.OAuth2InvalidRequest("client secret invalid")
-	}
-
-	return nil
+	return a.validateClient(r, query.Get("client_id"))
 }
 
 // revokeSession revokes all tokens for a clientID.
@@ -1486,22 +1696,20 @@ func (a *Authenticator) revokeSession(ctx context.Context, clientID, codeID, sub
 		return errors.OAuth2ServerError("failed to lookup user").WithError(err)
 	}
 
-	lookupSession := func(session unikornv1.UserSession) bool {
-		return session.ClientID == clientID && session.AuthorizationCodeID == codeID
+	lookupSession := func(session *unikornv1.UserSession) bool {
+		return session.Spec.ClientID == clientID && session.Spec.AuthorizationCodeID == codeID
 	}
 
-	index := slices.IndexFunc(user.Spec.Sessions, lookupSession)
-	if index < 0 {
-		return nil
-	}
-
-	// Things can still go wrong between here and issuing the new token, so invalidate
-	// the session now rather than relying on the reissue doing it for us.
-	a.InvalidateToken(ctx, user.Spec.Sessions[index].AccessToken)
+	session, err := a.findSession(ctx, user.Name, lookupSession)
+	if err != nil {
+		if goerrors.Is(err, sessions.ErrNotFound) {
+			return nil
+		}
 
-	user.Spec.Sessions = append(user.Spec.Sessions[:index], user.Spec.Sessions[index+1:]...)
+		return errors.OAuth2ServerError("failed to lookup user session").WithError(err)
+	}
 
-	if err := a.client.Update(ctx, user); err != nil {
+	if err := a.deleteSession(ctx, session); err != nil {
 		return errors.OAuth2ServerError("failed to revoke user session").WithError(err)
 	}
 
@@ -1515,6 +1723,11 @@ func (a *Authenticator) TokenAuthorizationCode(w http.ResponseWriter, r *http.Re
 		return nil, err
 	}
 
+	confirmation, err := a.tokenConfirmation(r)
+	if err != nil {
+		return nil, err
+	}
+
 	codeRaw := r.Form.Get("code")
 
 	code := &Code{}
@@ -1530,7 +1743,12 @@ func (a *Authenticator) TokenAuthorizationCode(w http.ResponseWriter, r *http.Re
 
 	clientID := clientQuery.Get("client_id")
 
-	if err := tokenValidateCode(r, clientQuery); err != nil {
+	client, err := a.lookupClient(r.Context(), clientID)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to lookup client").WithError(err)
+	}
+
+	if err := tokenValidateCode(r, client, clientQuery); err != nil {
 		return nil, err
 	}
 
@@ -1556,13 +1774,16 @@ func (a *Authenticator) TokenAuthorizationCode(w http.ResponseWriter, r *http.Re
 		Subject: code.IDToken.Email.Email,
 		Type:    TokenTypeFederated,
 		Federated: &FederatedClaims{
-			ClientID: clientID,
-			UserID:   code.UserID,
-			Provider: code.OAuth2Provider,
-			Scope:    NewScope(clientQuery.Get("scope")),
+			ClientID:  clientID,
+			SessionID: code.SessionID,
+			UserID:    code.UserID,
+			Provider:  code.OAuth2Provider,
+			Scope:     NewScope(clientQuery.Get("scope")),
 		},
 		AuthorizationCodeID: &code.ID,
 		Interactive:         code.Interactive,
+		Confirmation:        confirmation,
+		SourceIP:            code.SourceIP,
 	}
 
 	tokens, err := a.Issue(r.Context(), info)
@@ -1571,13 +1792,13 @@ func (a *Authenticator) TokenAuthorizationCode(w http.ResponseWriter, r *http.Re
 	}
 
 	// Handle OIDC.
-	idToken, err := a.oidcIDToken(r, code.IDToken, clientQuery, a.options.AccessTokenDuration, oidcHash(tokens.AccessToken), tokens.LastAuthenticationTime)
+	idToken, err := a.oidcIDToken(r, code.IDToken, clientQuery, a.options.AccessTokenDuration, tokens)
 	if err != nil {
 		return nil, err
 	}
 
 	result := &openapi.Token{
-		TokenType:    "Bearer",
+		TokenType:    tokenType(confirmation),
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		IdToken:      idToken,
@@ -1588,72 +1809,59 @@ func (a *Authenticator) TokenAuthorizationCode(w http.ResponseWriter, r *http.Re
 }
 
 func (a *Authenticator) validateClientSecretRefresh(r *http.Request, claims *RefreshTokenClaims) error {
-	clientID, clientSecret, ok := r.BasicAuth()
-	if !ok {
-		if !r.Form.Has("client_id") || !r.Form.Has("client_secret") {
-			return errors.OAuth2ServerError("client ID secret not set in request body")
-		}
-
-		clientID = r.Form.Get("client_id")
-		clientSecret = r.Form.Get("client_secret")
-	}
-
-	if claims.Federated.ClientID != clientID {
-		return errors.OAuth2InvalidGrant("client_id mismatch")
-	}
-
-	client, err := a.lookupClient(r.Context(), claims.Federated.ClientID)
-	if err != nil {
-		return errors.OAuth2ServerError("failed to lookup client").WithError(err)
-	}
-
-	if client.Status.Secret == "" {
-		return errors.OAuth2ServerError("client secret not set")
-	}
-
-	if client.Status.Secret != clientSecret {
-		return errors.OAuth2InvalidRequest("client secret invalid")
-	}
-
-	return nil
+	return a.validateClient(r, claims.Federated.ClientID)
 }
 
 // validateRefreshToken checks the refresh token ID is still valid (unused) and clears it
-// from the user record.
-func (a *Authenticator) validateRefreshToken(ctx context.Context, r *http.Request, refreshToken string, claims *RefreshTokenClaims) error {
+// from the user session.  If the token was superseded within the grace period, then
+// the session's current tokens are returned, and should be handed back to the client.
+func (a *Authenticator) validateRefreshToken(ctx context.Context, r *http.Request, refreshToken string, claims *RefreshTokenClaims) (*Tokens, error) {
 	if err := a.validateClientSecretRefresh(r, claims); err != nil {
-		return err
+		return nil, err
 	}
 
 	user, err := a.rbac.GetActiveUser(ctx, claims.Claims.Subject)
 	if err != nil {
-		return errors.OAuth2ServerError("failed to lookup user").WithError(err)
+		return nil, errors.OAuth2ServerError("failed to lookup user").WithError(err)
 	}
 
-	lookupSession := func(session unikornv1.UserSession) bool {
-		return session.ClientID == claims.Federated.ClientID
-	}
+	session, err := a.sessions.Get(ctx, user.Name, claims.Federated.ClientID, claims.Federated.SessionID)
+	if err != nil {
+		if goerrors.Is(err, sessions.ErrNotFound) {
+			return nil, errors.OAuth2InvalidGrant("no active session for user found")
+		}
 
-	index := slices.IndexFunc(user.Spec.Sessions, lookupSession)
-	if index < 0 {
-		return errors.OAuth2InvalidGrant("no active session for user found")
+		return nil, errors.OAuth2ServerError("failed to lookup user session").WithError(err)
 	}
 
-	if user.Spec.Sessions[index].RefreshToken != refreshToken {
-		return errors.OAuth2InvalidGrant("refresh token reuse")
+	if session.Spec.RefreshToken != refreshToken {
+		// Our view may just be stale, and revoking a session on the back of
+		// that would be bad, so make sure before going any further.
+		session, err = a.sessions.Reload(ctx, session)
+		if err != nil {
+			if goerrors.Is(err, sessions.ErrNotFound) {
+				return nil, errors.OAuth2InvalidGrant("no active session for user found")
+			}
+
+			return nil, errors.OAuth2ServerError("failed to lookup user session").WithError(err)
+		}
+
+		if session.Spec.RefreshToken != refreshToken {
+			return a.refreshTokenReuse(ctx, user, session, refreshToken, claims)
+		}
 	}
 
 	// Things can still go wrong between here and issuing the new token, so invalidate
 	// the session now rather than relying on the reissue doing it for us.
-	a.InvalidateToken(ctx, user.Spec.Sessions[index].AccessToken)
+	a.InvalidateToken(ctx, session.Spec.AccessToken)
 
-	user.Spec.Sessions[index].RefreshToken = ""
+	rotateRefreshToken(&session.Spec)
 
-	if err := a.client.Update(ctx, user); err != nil {
-		return errors.OAuth2ServerError("failed to revoke user session").WithError(err)
+	if err := a.sessions.Update(ctx, session); err != nil {
+		return nil, errors.OAuth2ServerError("failed to revoke user session").WithError(err)
 	}
 
-	return nil
+	return nil, nil
 }
 
 // TokenRefreshToken issues a token if the provided refresh token is valid.
@@ -1667,25 +1875,50 @@ func (a *Authenticator) TokenRefreshToken(w http.ResponseWriter, r *http.Request
 		return nil, errors.OAuth2InvalidGrant("refresh token is invalid or has expired").WithError(err)
 	}
 
-	if err := a.validateRefreshToken(r.Context(), r, refreshTokenRaw, claims); err != nil {
+	confirmation, err := a.tokenRefreshConfirmation(r, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := a.validateRefreshToken(r.Context(), r, refreshTokenRaw, claims)
+	if err != nil {
 		return nil, err
 	}
 
+	// Concurrent refreshes get the same tokens as the one that got there first.
+	if current != nil {
+		result := &openapi.Token{
+			TokenType:    tokenType(confirmation),
+			AccessToken:  current.AccessToken,
+			RefreshToken: current.RefreshToken,
+			ExpiresIn:    max(int(time.Until(current.Expiry).Seconds()), 0),
+		}
+
+		return result, nil
+	}
+
 	info := &IssueInfo{
-		Issuer:    "https://" + r.Host,
-		Audience:  r.Host,
-		Subject:   claims.Claims.Subject,
-		Type:      TokenTypeFederated,
-		Federated: claims.Federated,
+		Issuer:             "https://" + r.Host,
+		Audience:           r.Host,
+		Subject:            claims.Claims.Subject,
+		Type:               TokenTypeFederated,
+		Federated:          claims.Federated,
+		Confirmation:       confirmation,
+		SessionStart:       claims.sessionStart(),
+		RefreshTokenFamily: claims.FamilyID,
 	}
 
 	tokens, err := a.Issue(r.Context(), info)
 	if err != nil {
+		if goerrors.Is(err, ErrSessionExpired) {
+			return nil, errors.OAuth2InvalidGrant("session has expired, login required").WithError(err)
+		}
+
 		return nil, err
 	}
 
 	result := &openapi.Token{
-		TokenType:    "Bearer",
+		TokenType:    tokenType(confirmation),
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		ExpiresIn:    int(time.Until(tokens.Expiry).Seconds()),
@@ -1739,10 +1972,12 @@ func (a *Authenticator) Token(w http.ResponseWriter, r *http.Request) (*openapi.
 		return nil, errors.OAuth2InvalidRequest("failed to parse form data: " + err.Error())
 	}
 
-	// We support 3 garnt types:
+	// We support 5 garnt types:
 	// * "authorization_code" is used by all humans in the system
 	// * "refresh_token" is used by anyone to get a new access token
 	// * "client_credentials" is used by other services for IPC
+	// * "urn:ietf:params:oauth:grant-type:device_code" is used by input constrained devices
+	// * "urn:ietf:params:oauth:grant-type:token-exchange" is used by services to act on behalf of others
 	switch openapi.GrantType(r.Form.Get("grant_type")) {
 	case openapi.AuthorizationCode:
 		return a.TokenAuthorizationCode(w, r)
@@ -1750,6 +1985,10 @@ func (a *Authenticator) Token(w http.ResponseWriter, r *http.Request) (*openapi.
 		return a.TokenRefreshToken(w, r)
 	case openapi.ClientCredentials:
 		return a.TokenClientCredentials(w, r)
+	case openapi.UrnIetfParamsOauthGrantTypeDeviceCode:
+		return a.TokenDeviceCode(w, r)
+	case openapi.UrnIetfParamsOauthGrantTypeTokenExchange:
+		return a.TokenExchange(w, r)
 	}
 
 	return nil, errors.OAuth2InvalidRequest("token grant type is not supported")
@@ -18,21 +18,56 @@ package oauth2_test
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	gojose "github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/identity/pkg/jose"
 	josetesting "github.com/unikorn-cloud/identity/pkg/jose/testing"
+	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
+	"github.com/unikorn-cloud/identity/pkg/middleware/openapi/local"
 	"github.com/unikorn-cloud/identity/pkg/oauth2"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/dpop"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/sessions"
+	"github.com/unikorn-cloud/identity/pkg/openapi"
 	"github.com/unikorn-cloud/identity/pkg/rbac"
+	"github.com/unikorn-cloud/identity/pkg/util"
 
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -41,6 +76,15 @@ const (
 	// basis.
 	accessTokenDuration  = time.Second
 	refreshTokenDuration = 30 * time.Second
+
+	refreshTokenReuseGracePeriod = time.Second
+
+	tokenExchangeDuration = 10 * time.Second
+
+	maxSessionsPerClient = 2
+
+	//nolint:gosec
+	initialAccessToken = "initial-access-token"
 )
 
 func getScheme(t *testing.T) *runtime.Scheme {
@@ -53,24 +97,35 @@ func getScheme(t *testing.T) *runtime.Scheme {
 	return s
 }
 
-func TestTokens(t *testing.T) {
-	t.Parallel()
+// newAuthenticator creates an authenticator backed by a fake client containing
+// the provided objects.
+func newAuthenticator(ctx context.Context, t *testing.T, objects ...client.Object) (*oauth2.Authenticator, client.Client) {
+	t.Helper()
 
-	user := &unikornv1.User{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: josetesting.Namespace,
-			Name:      "fake",
-		},
-		Spec: unikornv1.UserSpec{
-			Subject: "barry@foo.com",
-			State:   unikornv1.UserStateActive,
-		},
-	}
+	authenticator, _, client := newAuthenticatorWithIssuer(ctx, t, objects...)
 
-	client := fake.NewClientBuilder().WithScheme(getScheme(t)).WithObjects(user).Build()
+	return authenticator, client
+}
+
+// newAuthenticatorWithIssuer is like newAuthenticator, but also returns the issuer
+// so tests can forge encrypted state that would otherwise be set by earlier steps.
+func newAuthenticatorWithIssuer(ctx context.Context, t *testing.T, objects ...client.Object) (*oauth2.Authenticator, *jose.JWTIssuer, client.Client) {
+	t.Helper()
+
+	client := fake.NewClientBuilder().WithScheme(getScheme(t)).WithObjects(objects...).WithStatusSubresource(&unikornv1.User{}).Build()
 
 	josetesting.RotateCertificate(t, client)
 
+	authenticator, issuer := newReplica(ctx, t, client)
+
+	return authenticator, issuer, client
+}
+
+// newReplica creates an authenticator backed by an existing client, as another
+// replica of the service would be.
+func newReplica(ctx context.Context, t *testing.T, client client.Client) (*oauth2.Authenticator, *jose.JWTIssuer) {
+	t.Helper()
+
 	joseOptions := &jose.Options{
 		IssuerSecretName: josetesting.KeySecretName,
 		RotationPeriod:   josetesting.RefreshPeriod,
@@ -78,33 +133,155 @@ func TestTokens(t *testing.T) {
 
 	issuer := jose.NewJWTIssuer(client, josetesting.Namespace, joseOptions)
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	require.NoError(t, issuer.Run(ctx, &josetesting.FakeCoordinationClientGetter{}))
 
 	rbac := rbac.New(client, josetesting.Namespace, &rbac.Options{})
 
 	options := &oauth2.Options{
-		AccessTokenDuration:      accessTokenDuration,
-		RefreshTokenDuration:     refreshTokenDuration,
-		TokenLeewayDuration:      accessTokenDuration,
-		TokenCacheSize:           1024,
-		CodeCacheSize:            1024,
-		AccountCreationCacheSize: 1024,
+		AccessTokenDuration:                accessTokenDuration,
+		RefreshTokenDuration:               refreshTokenDuration,
+		RefreshTokenReuseGracePeriod:       refreshTokenReuseGracePeriod,
+		MaxSessionsPerClient:               maxSessionsPerClient,
+		TokenLeewayDuration:                accessTokenDuration,
+		TokenCacheSize:                     1024,
+		CodeCacheSize:                      1024,
+		AccountCreationCacheSize:           1024,
+		DeviceCodeDuration:                 refreshTokenDuration,
+		PushedAuthorizationRequestDuration: refreshTokenDuration,
+		BackchannelLogoutTimeout:           time.Second,
+		BackchannelLogoutAttempts:          2,
+		RegistrationInitialAccessTokenFile: initialAccessTokenFile(t),
+		TokenExchangeDuration:              tokenExchangeDuration,
 	}
 
-	authenticator := oauth2.New(options, josetesting.Namespace, client, issuer, rbac)
+	authenticator := oauth2.New(options, josetesting.Namespace, client, issuer, rbac, sessions.NewMemory())
 
 	time.Sleep(2 * josetesting.RefreshPeriod)
 
+	return authenticator, issuer
+}
+
+// initialAccessTokenFile writes the registration initial access token as it
+// would be mounted from a secret.
+func initialAccessTokenFile(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "token")
+
+	require.NoError(t, os.WriteFile(path, []byte(initialAccessToken+"\n"), 0o600))
+
+	return path
+}
+
+// hashSecret hashes a client secret as the provisioner would.
+func hashSecret(t *testing.T, secret string) string {
+	t.Helper()
+
+	hash, err := util.HashSecret(secret)
+	require.NoError(t, err)
+
+	return hash
+}
+
+// newClientSecret creates the Kubernetes secret the provisioner writes the
+// plain text client secret to.
+func newClientSecret(t *testing.T, oauth2client *unikornv1.OAuth2Client, secret string) *corev1.Secret {
+	t.Helper()
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: oauth2client.Namespace,
+			Name:      oauth2client.SecretName(),
+		},
+		Data: map[string][]byte{
+			unikornv1.OAuth2ClientSecretKey: []byte(secret),
+		},
+	}
+}
+
+// newClientCertificate returns a client certificate for the common name, encoded
+// as the ingress controller would after mTLS verification.
+func newClientCertificate(t *testing.T, commonName string) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: commonName,
+		},
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+
+	return url.QueryEscape(string(certPEM))
+}
+
+// setClientCertificate adds mTLS verification headers as the ingress controller would.
+func setClientCertificate(r *http.Request, certificate string) {
+	r.Header.Set("Ssl-Client-Cert", certificate)
+	r.Header.Set("Ssl-Client-Verify", "SUCCESS")
+}
+
+// authorize runs the request through API authorization.
+func authorize(authorizer *local.Authorizer, r *http.Request) (*authorization.Info, error) {
+	input := &openapi3filter.AuthenticationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request: r,
+		},
+		SecurityScheme: &openapi3.SecurityScheme{
+			Type: "oauth2",
+		},
+	}
+
+	return authorizer.Authorize(input)
+}
+
+func newUser() *unikornv1.User {
+	return &unikornv1.User{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "fake",
+		},
+		Spec: unikornv1.UserSpec{
+			Subject: "barry@foo.com",
+			State:   unikornv1.UserStateActive,
+		},
+	}
+}
+
+func TestTokens(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, newUser(), oauth2client)
+
 	issueInfo := &oauth2.IssueInfo{
 		Issuer:   "https://foo.com",
 		Audience: "foo.com",
 		Subject:  "barry@foo.com",
 		Type:     oauth2.TokenTypeFederated,
 		Federated: &oauth2.FederatedClaims{
-			UserID: "fake",
+			UserID:   "fake",
+			ClientID: "client",
 		},
 	}
 
@@ -126,3 +303,1975 @@ func TestTokens(t *testing.T) {
 	_, err = authenticator.Verify(ctx, verifyInfo)
 	require.Error(t, err)
 }
+
+func TestRevoke(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, "secret"),
+		},
+	}
+
+	otherClient := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "other",
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, "secret"),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, newUser(), oauth2client, otherClient)
+
+	// Use a longer lived token so it cannot expire during the test.
+	duration := refreshTokenDuration
+
+	issueInfo := &oauth2.IssueInfo{
+		Issuer:   "https://foo.com",
+		Audience: "foo.com",
+		Subject:  "barry@foo.com",
+		Type:     oauth2.TokenTypeFederated,
+		Federated: &oauth2.FederatedClaims{
+			UserID:   "fake",
+			ClientID: "client",
+		},
+		Duration: &duration,
+	}
+
+	tokens, err := authenticator.Issue(ctx, issueInfo)
+	require.NoError(t, err)
+
+	revoke := func(clientID, token string) error {
+		form := url.Values{
+			"token":           []string{token},
+			"token_type_hint": []string{"refresh_token"},
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/oauth2/v2/revoke", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.SetBasicAuth(clientID, "secret")
+
+		return authenticator.Revoke(httptest.NewRecorder(), r)
+	}
+
+	verifyInfo := &oauth2.VerifyInfo{
+		Issuer:   "https://foo.com",
+		Audience: "foo.com",
+		Token:    tokens.AccessToken,
+	}
+
+	_, err = authenticator.Verify(ctx, verifyInfo)
+	require.NoError(t, err)
+
+	// Only the client the token was issued to can revoke it.
+	require.Error(t, revoke("other", *tokens.RefreshToken))
+	require.Error(t, revoke("other", tokens.AccessToken))
+
+	_, err = authenticator.Verify(ctx, verifyInfo)
+	require.NoError(t, err)
+
+	// Revoking the refresh token kills the session, and the access token with it.
+	require.NoError(t, revoke("client", *tokens.RefreshToken))
+
+	_, err = authenticator.Verify(ctx, verifyInfo)
+	require.Error(t, err)
+
+	// Revoking an already revoked, or invalid, token isn't an error.
+	require.NoError(t, revoke("client", tokens.AccessToken))
+	require.NoError(t, revoke("client", "garbage"))
+
+	// Service tokens cannot be revoked.
+	serviceIssueInfo := &oauth2.IssueInfo{
+		Issuer:   "https://foo.com",
+		Audience: "foo.com",
+		Subject:  "compute",
+		Type:     oauth2.TokenTypeService,
+		Service:  &oauth2.ServiceClaims{},
+	}
+
+	serviceTokens, err := authenticator.Issue(ctx, serviceIssueInfo)
+	require.NoError(t, err)
+
+	var protocolErr *oauth2.ProtocolError
+
+	require.ErrorAs(t, revoke("client", serviceTokens.AccessToken), &protocolErr)
+
+	w := httptest.NewRecorder()
+	protocolErr.Write(w, httptest.NewRequest(http.MethodPost, "/oauth2/v2/revoke", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "unsupported_token_type")
+}
+
+// TestIntrospect checks resource servers must authenticate, and that introspection
+// reflects each token type, and tokens that are no longer valid.
+func TestIntrospect(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, "secret"),
+		},
+	}
+
+	organization := &unikornv1.Organization{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "organization",
+		},
+		Status: unikornv1.OrganizationStatus{
+			Namespace: "organization",
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, cli := newAuthenticator(ctx, t, newUser(), oauth2client, organization)
+
+	introspect := func(token string, authenticate func(*http.Request)) (*openapi.TokenIntrospection, error) {
+		form := url.Values{
+			"token": []string{token},
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/oauth2/v2/introspect", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		if authenticate != nil {
+			authenticate(r)
+		}
+
+		return authenticator.Introspect(httptest.NewRecorder(), r)
+	}
+
+	basicAuth := func(r *http.Request) {
+		r.SetBasicAuth("client", "secret")
+	}
+
+	mTLS := func(r *http.Request) {
+		setClientCertificate(r, newClientCertificate(t, "compute"))
+	}
+
+	issue := func(info *oauth2.IssueInfo) *oauth2.Tokens {
+		info.Issuer = "https://example.com"
+		info.Audience = "example.com"
+
+		tokens, err := authenticator.Issue(ctx, info)
+		require.NoError(t, err)
+
+		return tokens
+	}
+
+	// Use a longer lived token so it cannot expire during the test.
+	duration := refreshTokenDuration
+
+	federated := issue(&oauth2.IssueInfo{
+		Subject: "barry@foo.com",
+		Type:    oauth2.TokenTypeFederated,
+		Federated: &oauth2.FederatedClaims{
+			UserID:   "fake",
+			ClientID: "client",
+			Scope:    oauth2.NewScope("openid email"),
+		},
+		Duration: &duration,
+	})
+
+	// Resource servers must authenticate.
+	_, err := introspect(federated.AccessToken, nil)
+	require.Error(t, err)
+
+	_, err = introspect(federated.AccessToken, func(r *http.Request) {
+		r.SetBasicAuth("client", "imposter")
+	})
+	require.Error(t, err)
+
+	result, err := introspect(federated.AccessToken, basicAuth)
+	require.NoError(t, err)
+	require.True(t, result.Active)
+	require.Equal(t, ptr.To(openapi.Fed), result.TokenType)
+	require.Equal(t, ptr.To("client"), result.ClientId)
+	require.Equal(t, ptr.To("openid email"), result.Scope)
+	require.Equal(t, ptr.To("barry@foo.com"), result.Sub)
+
+	// Service account tokens carry their organization.
+	serviceAccount := issue(&oauth2.IssueInfo{
+		Subject: "service-account",
+		Type:    oauth2.TokenTypeServiceAccount,
+		ServiceAccount: &oauth2.ServiceAccountClaims{
+			OrganizationID: "organization",
+		},
+		Duration: &duration,
+	})
+
+	require.NoError(t, cli.Create(ctx, &unikornv1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "organization",
+			Name:      "service-account",
+		},
+		Spec: unikornv1.ServiceAccountSpec{
+			AccessToken: serviceAccount.AccessToken,
+		},
+	}))
+
+	result, err = introspect(serviceAccount.AccessToken, mTLS)
+	require.NoError(t, err)
+	require.True(t, result.Active)
+	require.Equal(t, ptr.To(openapi.Sa), result.TokenType)
+	require.Equal(t, ptr.To("organization"), result.OrganizationId)
+
+	// Service tokens are bound to a certificate, and may also be bound to a DPoP key.
+	service := issue(&oauth2.IssueInfo{
+		Subject: "compute",
+		Type:    oauth2.TokenTypeService,
+		Service: &oauth2.ServiceClaims{
+			X509Thumbprint: "x5t",
+		},
+		Confirmation: &oauth2.ConfirmationClaims{
+			JWKThumbprint: "jkt",
+		},
+		Duration: &duration,
+	})
+
+	result, err = introspect(service.AccessToken, mTLS)
+	require.NoError(t, err)
+	require.True(t, result.Active)
+	require.Equal(t, ptr.To(openapi.Svc), result.TokenType)
+	require.NotNil(t, result.Cnf)
+	require.Equal(t, ptr.To("x5t"), result.Cnf.X5tS256)
+	require.Equal(t, ptr.To("jkt"), result.Cnf.Jkt)
+
+	// Revoked tokens are inactive.
+	form := url.Values{
+		"token": []string{*federated.RefreshToken},
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/v2/revoke", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	basicAuth(r)
+
+	require.NoError(t, authenticator.Revoke(httptest.NewRecorder(), r))
+
+	result, err = introspect(federated.AccessToken, basicAuth)
+	require.NoError(t, err)
+	require.False(t, result.Active)
+	require.Nil(t, result.Sub)
+
+	// Expired tokens are inactive.
+	expired := issue(&oauth2.IssueInfo{
+		Subject: "compute",
+		Type:    oauth2.TokenTypeService,
+		Service: &oauth2.ServiceClaims{},
+	})
+
+	time.Sleep(2 * accessTokenDuration)
+
+	result, err = introspect(expired.AccessToken, mTLS)
+	require.NoError(t, err)
+	require.False(t, result.Active)
+
+	// Garbage is inactive.
+	result, err = introspect("garbage", mTLS)
+	require.NoError(t, err)
+	require.False(t, result.Active)
+}
+
+func TestDeviceCode(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, "secret"),
+		},
+	}
+
+	user := newUser()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, issuer, cli := newAuthenticatorWithIssuer(ctx, t, user, oauth2client, newClientSecret(t, oauth2client, "secret"))
+
+	authorizeDevice := func(secret string) (*openapi.DeviceAuthorization, error) {
+		form := url.Values{
+			"client_id": []string{"client"},
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/oauth2/v2/device_authorization", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		if secret != "" {
+			r.SetBasicAuth("client", secret)
+		}
+
+		return authenticator.DeviceAuthorization(httptest.NewRecorder(), r)
+	}
+
+	// Confidential clients must authenticate to start the flow.
+	_, err := authorizeDevice("")
+	require.Error(t, err)
+
+	authorization, err := authorizeDevice("secret")
+	require.NoError(t, err)
+
+	token := func(clientID, secret string) error {
+		form := url.Values{
+			"grant_type":  []string{"urn:ietf:params:oauth:grant-type:device_code"},
+			"client_id":   []string{clientID},
+			"device_code": []string{authorization.DeviceCode},
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/oauth2/v2/token", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		if secret != "" {
+			r.SetBasicAuth(clientID, secret)
+		}
+
+		_, err := authenticator.Token(httptest.NewRecorder(), r)
+
+		return err
+	}
+
+	// Device codes are bound to the client.
+	require.Error(t, token("imposter", ""))
+
+	// Confidential clients must authenticate.
+	require.Error(t, token("client", ""))
+	require.Error(t, token("client", "wrong"))
+
+	// Until the user approves the device, it must keep polling.
+	var protocolErr *oauth2.ProtocolError
+
+	require.ErrorAs(t, token("client", "secret"), &protocolErr)
+
+	// The pending authorization records the client for the consent dialog.
+	deviceAuthorization := &unikornv1.DeviceAuthorization{}
+
+	require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: josetesting.Namespace, Name: "device-" + strings.ToLower(authorization.UserCode)}, deviceAuthorization))
+	require.Equal(t, "client", deviceAuthorization.Spec.ClientID)
+
+	// Simulate the user logging in and deciding on the device.
+	decide := func(decision string) int {
+		approval := &oauth2.DeviceApproval{
+			UserCode:       authorization.UserCode,
+			UserID:         user.Name,
+			OAuth2Provider: "provider",
+			Expiry:         time.Now().Add(time.Minute).Unix(),
+		}
+
+		approvalCipher, err := issuer.EncodeJWEToken(ctx, approval, jose.TokenTypeDeviceApproval)
+		require.NoError(t, err)
+
+		form := url.Values{
+			"approval": []string{approvalCipher},
+			"decision": []string{decision},
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/oauth2/v2/device/approve", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		w := httptest.NewRecorder()
+
+		authenticator.DeviceApprove(w, r)
+
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusOK, decide("approve"))
+
+	// The first decision wins.
+	require.Equal(t, http.StatusBadRequest, decide("deny"))
+
+	require.NoError(t, cli.Get(ctx, client.ObjectKeyFromObject(deviceAuthorization), deviceAuthorization))
+	require.NotEmpty(t, deviceAuthorization.Spec.SourceIP)
+
+	require.NoError(t, token("client", "secret"))
+
+	// The approval can only be used once.
+	require.ErrorAs(t, token("client", "secret"), &protocolErr)
+	require.True(t, kerrors.IsNotFound(cli.Get(ctx, client.ObjectKeyFromObject(deviceAuthorization), deviceAuthorization)))
+
+	// A denied device is told so.
+	authorization, err = authorizeDevice("secret")
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, decide("deny"))
+	require.ErrorAs(t, token("client", "secret"), &protocolErr)
+
+	w := httptest.NewRecorder()
+	protocolErr.Write(w, httptest.NewRequest(http.MethodPost, "/oauth2/v2/token", nil))
+	require.Contains(t, w.Body.String(), string(oauth2.ErrorAccessDenied))
+}
+
+func TestBackchannelLogout(t *testing.T) {
+	t.Parallel()
+
+	tokens := make(chan string, 2)
+
+	// The first delivery fails, and is retried.
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens <- r.FormValue("logout_token")
+
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Spec: unikornv1.OAuth2ClientSpec{
+			BackchannelLogoutURI: &server.URL,
+		},
+	}
+
+	user := newUser()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, cli := newAuthenticator(ctx, t, user, oauth2client)
+
+	informers := &informertest.FakeInformers{
+		Scheme: getScheme(t),
+	}
+
+	require.NoError(t, authenticator.RunBackchannelLogout(ctx, informers))
+
+	issueInfo := &oauth2.IssueInfo{
+		Issuer:   "https://foo.com",
+		Audience: "foo.com",
+		Subject:  "barry@foo.com",
+		Type:     oauth2.TokenTypeFederated,
+		Federated: &oauth2.FederatedClaims{
+			UserID:    "fake",
+			ClientID:  "client",
+			SessionID: "session",
+		},
+	}
+
+	issued, err := authenticator.Issue(ctx, issueInfo)
+	require.NoError(t, err)
+
+	require.NoError(t, authenticator.LogoutUser(ctx, "https://foo.com", user, ""))
+
+	// Notifications are persisted, and delivered when the watch sees them.
+	current := &unikornv1.User{}
+	require.NoError(t, cli.Get(ctx, client.ObjectKeyFromObject(user), current))
+	require.Len(t, current.Status.BackchannelLogouts, 1)
+	require.False(t, current.Status.BackchannelLogouts[0].Delivered)
+
+	informer, err := informers.FakeInformerFor(ctx, &unikornv1.User{})
+	require.NoError(t, err)
+
+	informer.Update(user, current)
+
+	for range 2 {
+		token, err := jwt.ParseSigned(<-tokens)
+		require.NoError(t, err)
+
+		claims := &oidc.LogoutToken{}
+		require.NoError(t, token.UnsafeClaimsWithoutVerification(claims))
+		require.Equal(t, "barry@foo.com", claims.Subject)
+		require.Equal(t, "session", claims.SessionID)
+	}
+
+	delivered := func() bool {
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(user), current); err != nil {
+			return false
+		}
+
+		return len(current.Status.BackchannelLogouts) == 1 && current.Status.BackchannelLogouts[0].Delivered
+	}
+
+	require.Eventually(t, delivered, 10*time.Second, 100*time.Millisecond)
+	require.Equal(t, 2, current.Status.BackchannelLogouts[0].Attempts)
+
+	verifyInfo := &oauth2.VerifyInfo{
+		Issuer:   "https://foo.com",
+		Audience: "foo.com",
+		Token:    issued.AccessToken,
+	}
+
+	_, err = authenticator.Verify(ctx, verifyInfo)
+	require.Error(t, err)
+}
+
+func TestLogout(t *testing.T) {
+	t.Parallel()
+
+	newClient := func(name string) *unikornv1.OAuth2Client {
+		return &unikornv1.OAuth2Client{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: josetesting.Namespace,
+				Name:      name,
+			},
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, issuer, _ := newAuthenticatorWithIssuer(ctx, t, newUser(), newClient("client"), newClient("other"))
+
+	// Use a longer lived token so it cannot expire during the test.
+	duration := refreshTokenDuration
+
+	issue := func(clientID string) *oauth2.Tokens {
+		issueInfo := &oauth2.IssueInfo{
+			Issuer:   "https://example.com",
+			Audience: "example.com",
+			Subject:  "barry@foo.com",
+			Type:     oauth2.TokenTypeFederated,
+			Federated: &oauth2.FederatedClaims{
+				UserID:   "fake",
+				ClientID: clientID,
+			},
+			Duration: &duration,
+		}
+
+		tokens, err := authenticator.Issue(ctx, issueInfo)
+		require.NoError(t, err)
+		require.NotEmpty(t, tokens.SessionID)
+
+		return tokens
+	}
+
+	verify := func(tokens *oauth2.Tokens) error {
+		verifyInfo := &oauth2.VerifyInfo{
+			Issuer:   "https://example.com",
+			Audience: "example.com",
+			Token:    tokens.AccessToken,
+		}
+
+		_, err := authenticator.Verify(ctx, verifyInfo)
+
+		return err
+	}
+
+	logout := func(tokens *oauth2.Tokens) {
+		idToken := &oidc.IDToken{
+			Claims: jwt.Claims{
+				Issuer:   "https://example.com",
+				Subject:  "barry@foo.com",
+				Audience: jwt.Audience{"client"},
+			},
+			Default: oidc.Default{
+				AuthorizedParty: "client",
+				SessionID:       tokens.SessionID,
+			},
+		}
+
+		idTokenHint, err := issuer.EncodeJWT(ctx, idToken)
+		require.NoError(t, err)
+
+		query := url.Values{
+			"id_token_hint": []string{idTokenHint},
+		}
+
+		w := httptest.NewRecorder()
+
+		authenticator.Logout(w, httptest.NewRequest(http.MethodGet, "https://example.com/oauth2/v2/logout?"+query.Encode(), nil))
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+
+	first := issue("client")
+	second := issue("client")
+	other := issue("other")
+
+	// Only the session the ID token was issued for is terminated.
+	logout(first)
+
+	require.Error(t, verify(first))
+	require.NoError(t, verify(second))
+	require.NoError(t, verify(other))
+}
+
+func TestRegistration(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, cli := newAuthenticator(ctx, t)
+
+	// Simulate the provisioner generating the client secret.
+	go func() {
+		for ctx.Err() == nil {
+			clients := &unikornv1.OAuth2ClientList{}
+
+			if err := cli.List(ctx, clients); err == nil {
+				for i := range clients.Items {
+					if clients.Items[i].Status.SecretHash == "" {
+						_ = cli.Create(ctx, newClientSecret(t, &clients.Items[i], "secret"))
+
+						clients.Items[i].Status.SecretHash = hashSecret(t, "secret")
+
+						_ = cli.Update(ctx, &clients.Items[i])
+					}
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+
+	request := func(method, token string) *http.Request {
+		r := httptest.NewRequestWithContext(ctx, method, "https://foo.com/oauth2/v2/register", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+
+		return r
+	}
+
+	metadata := &openapi.ClientMetadata{
+		RedirectUris: []string{
+			"https://console.foo.com/oauth2/callback",
+		},
+	}
+
+	// Registration requires authorization.
+	_, err := authenticator.Register(request(http.MethodPost, "imposter"), metadata)
+	require.Error(t, err)
+
+	information, err := authenticator.Register(request(http.MethodPost, initialAccessToken), metadata)
+	require.NoError(t, err)
+	require.NotNil(t, information.ClientSecret)
+	require.Equal(t, "secret", *information.ClientSecret)
+
+	// Management is restricted to the registration access token.
+	_, err = authenticator.RegistrationRead(request(http.MethodGet, initialAccessToken), information.ClientId)
+	require.Error(t, err)
+
+	read, err := authenticator.RegistrationRead(request(http.MethodGet, information.RegistrationAccessToken), information.ClientId)
+	require.NoError(t, err)
+	require.Equal(t, metadata.RedirectUris, read.RedirectUris)
+
+	require.NoError(t, authenticator.RegistrationDelete(request(http.MethodDelete, information.RegistrationAccessToken), information.ClientId))
+
+	_, err = authenticator.RegistrationRead(request(http.MethodGet, information.RegistrationAccessToken), information.ClientId)
+	require.Error(t, err)
+}
+
+// TestRegistrationURIs checks registered URIs cannot be used for script injection
+// or to make us issue requests to internal addresses.
+func TestRegistrationURIs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t)
+
+	register := func(metadata *openapi.ClientMetadata) error {
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "https://foo.com/oauth2/v2/register", nil)
+		r.Header.Set("Authorization", "Bearer "+initialAccessToken)
+
+		_, err := authenticator.Register(r, metadata)
+
+		return err
+	}
+
+	redirectURIs := []string{
+		"javascript:alert(1)",
+		"data:text/html,foo",
+		"http://foo.com/callback",
+		"http://localhost/callback",
+		"https://user@foo.com/callback",
+	}
+
+	for _, uri := range redirectURIs {
+		require.Error(t, register(&openapi.ClientMetadata{RedirectUris: []string{uri}}), uri)
+	}
+
+	serverURIs := []string{
+		"http://foo.com/jwks",
+		"https://127.0.0.1/jwks",
+		"https://localhost/jwks",
+		"https://10.0.0.1/jwks",
+		"https://169.254.169.254/jwks",
+		"https://[::1]/jwks",
+	}
+
+	for _, uri := range serverURIs {
+		require.Error(t, register(&openapi.ClientMetadata{RedirectUris: []string{"http://127.0.0.1/callback"}, JwksUri: ptr.To(uri)}), uri)
+		require.Error(t, register(&openapi.ClientMetadata{RedirectUris: []string{"http://127.0.0.1/callback"}, BackchannelLogoutUri: ptr.To(uri)}), uri)
+	}
+}
+
+func TestPushedAuthorizationRequest(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Spec: unikornv1.OAuth2ClientSpec{
+			RedirectURI:                        "https://foo.com/callback",
+			RequirePushedAuthorizationRequests: true,
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, "secret"),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, oauth2client)
+
+	query := url.Values{
+		"response_type":         []string{"code"},
+		"client_id":             []string{"client"},
+		"redirect_uri":          []string{"https://foo.com/callback"},
+		"code_challenge":        []string{"challenge"},
+		"code_challenge_method": []string{"S256"},
+	}
+
+	push := func(secret string) (*openapi.PushedAuthorization, error) {
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/oauth2/v2/par", strings.NewReader(query.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.SetBasicAuth("client", secret)
+
+		return authenticator.PushedAuthorization(httptest.NewRecorder(), r)
+	}
+
+	authorize := func(query url.Values) *httptest.ResponseRecorder {
+		r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/oauth2/v2/authorization?"+query.Encode(), nil)
+		w := httptest.NewRecorder()
+
+		authenticator.Authorization(w, r)
+
+		return w
+	}
+
+	// The client requires pushed authorization requests.
+	w := authorize(query)
+	require.Equal(t, http.StatusFound, w.Code)
+	require.Contains(t, w.Header().Get("Location"), "error=invalid_request")
+
+	// Pushing requires client authentication.
+	_, err := push("imposter")
+	require.Error(t, err)
+
+	result, err := push("secret")
+	require.NoError(t, err)
+
+	pushedQuery := url.Values{
+		"client_id":   []string{"client"},
+		"request_uri": []string{result.RequestUri},
+	}
+
+	require.Equal(t, http.StatusOK, authorize(pushedQuery).Code)
+
+	// Request URIs are single use.
+	require.Equal(t, http.StatusBadRequest, authorize(pushedQuery).Code)
+
+	// Request URIs are bound to the client.
+	result, err = push("secret")
+	require.NoError(t, err)
+
+	pushedQuery.Set("client_id", "imposter")
+	pushedQuery.Set("request_uri", result.RequestUri)
+
+	require.Equal(t, http.StatusBadRequest, authorize(pushedQuery).Code)
+
+	// And presenting them with the wrong client doesn't consume them.
+	pushedQuery.Set("client_id", "client")
+
+	require.Equal(t, http.StatusOK, authorize(pushedQuery).Code)
+
+	// Request URIs must have been issued by us.
+	pushedQuery.Set("client_id", "client")
+	pushedQuery.Set("request_uri", "urn:ietf:params:oauth:request_uri:garbage")
+
+	require.Equal(t, http.StatusBadRequest, authorize(pushedQuery).Code)
+}
+
+func TestRequestObject(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	jwks := gojose.JSONWebKeySet{
+		Keys: []gojose.JSONWebKey{
+			{
+				Key:       key.Public(),
+				KeyID:     "key",
+				Algorithm: string(gojose.ES256),
+				Use:       "sig",
+			},
+		},
+	}
+
+	jwksData, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	jwksString := string(jwksData)
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Spec: unikornv1.OAuth2ClientSpec{
+			RedirectURI: "https://foo.com/callback",
+			JWKS:        &jwksString,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, oauth2client)
+
+	requestObject := func(signingKey any, mutate func(map[string]any)) string {
+		options := (&gojose.SignerOptions{}).WithHeader("kid", "key")
+
+		signer, err := gojose.NewSigner(gojose.SigningKey{Algorithm: gojose.ES256, Key: signingKey}, options)
+		require.NoError(t, err)
+
+		claims := map[string]any{
+			"iss":                   "client",
+			"aud":                   "https://example.com",
+			"exp":                   time.Now().Add(time.Minute).Unix(),
+			"response_type":         "code",
+			"client_id":             "client",
+			"redirect_uri":          "https://foo.com/callback",
+			"code_challenge":        "challenge",
+			"code_challenge_method": "S256",
+		}
+
+		if mutate != nil {
+			mutate(claims)
+		}
+
+		token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+
+		return token
+	}
+
+	authorize := func(request string, extra ...string) *httptest.ResponseRecorder {
+		query := url.Values{
+			"client_id": []string{"client"},
+			"request":   []string{request},
+		}
+
+		for i := 0; i < len(extra); i += 2 {
+			query.Set(extra[i], extra[i+1])
+		}
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/oauth2/v2/authorization?"+query.Encode(), nil)
+		w := httptest.NewRecorder()
+
+		authenticator.Authorization(w, r)
+
+		return w
+	}
+
+	// Parameters are taken from the request object.
+	require.Equal(t, http.StatusOK, authorize(requestObject(key, nil)).Code)
+
+	// Request objects must be signed by the client.
+	imposter, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	w := authorize(requestObject(imposter, nil))
+	require.Equal(t, http.StatusFound, w.Code)
+	require.Contains(t, w.Header().Get("Location"), "error=invalid_request_object")
+
+	// Request objects must expire.
+	w = authorize(requestObject(key, func(claims map[string]any) {
+		delete(claims, "exp")
+	}))
+	require.Equal(t, http.StatusFound, w.Code)
+	require.Contains(t, w.Header().Get("Location"), "error=invalid_request_object")
+
+	// Request objects must be for the client in the query.
+	w = authorize(requestObject(key, func(claims map[string]any) {
+		claims["client_id"] = "imposter"
+	}))
+	require.Equal(t, http.StatusFound, w.Code)
+	require.Contains(t, w.Header().Get("Location"), "error=invalid_request_object")
+
+	// Parameters outside of the request object are ignored.
+	w = authorize(requestObject(key, func(claims map[string]any) {
+		delete(claims, "response_type")
+	}), "response_type", "code")
+	require.NotEqual(t, http.StatusOK, w.Code)
+}
+
+func TestDPoP(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, "secret"),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, newUser(), oauth2client)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	thumbprint, err := (&gojose.JSONWebKey{Key: key.Public()}).Thumbprint(crypto.SHA256)
+	require.NoError(t, err)
+
+	duration := refreshTokenDuration
+
+	issueInfo := &oauth2.IssueInfo{
+		Issuer:   "https://example.com",
+		Audience: "example.com",
+		Subject:  "barry@foo.com",
+		Type:     oauth2.TokenTypeFederated,
+		Federated: &oauth2.FederatedClaims{
+			UserID:   "fake",
+			ClientID: "client",
+		},
+		Duration: &duration,
+		Confirmation: &oauth2.ConfirmationClaims{
+			JWKThumbprint: base64.RawURLEncoding.EncodeToString(thumbprint),
+		},
+	}
+
+	tokens, err := authenticator.Issue(ctx, issueInfo)
+	require.NoError(t, err)
+
+	proof := func(signingKey *ecdsa.PrivateKey) string {
+		options := (&gojose.SignerOptions{EmbedJWK: true}).WithType("dpop+jwt")
+
+		signer, err := gojose.NewSigner(gojose.SigningKey{Algorithm: gojose.ES256, Key: signingKey}, options)
+		require.NoError(t, err)
+
+		claims := map[string]any{
+			"jti": uuid.New().String(),
+			"htm": http.MethodPost,
+			"htu": "https://example.com/oauth2/v2/token",
+			"iat": time.Now().Unix(),
+		}
+
+		token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+
+		return token
+	}
+
+	refresh := func(proof string) (*openapi.Token, error) {
+		form := url.Values{
+			"grant_type":    []string{"refresh_token"},
+			"refresh_token": []string{*tokens.RefreshToken},
+		}
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/oauth2/v2/token", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.SetBasicAuth("client", "secret")
+
+		if proof != "" {
+			r.Header.Set(dpop.Header, proof)
+		}
+
+		return authenticator.Token(httptest.NewRecorder(), r)
+	}
+
+	// Bound refresh tokens cannot be used without a proof.
+	_, err = refresh("")
+	require.Error(t, err)
+
+	// Or with a proof from a different key.
+	imposter, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, err = refresh(proof(imposter))
+	require.Error(t, err)
+
+	// Proofs cannot be replayed.
+	replayed := proof(key)
+
+	result, err := refresh(replayed)
+	require.NoError(t, err)
+	require.Equal(t, "DPoP", result.TokenType)
+
+	_, err = refresh(replayed)
+	require.Error(t, err)
+}
+
+// TestDPoPPropagation checks a propagated DPoP key thumbprint is only trusted when
+// sent by a system account, so a stolen token cannot be used without a proof by
+// forging the header.
+func TestDPoPPropagation(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, client := newAuthenticator(ctx, t, newUser(), oauth2client)
+
+	rbacOptions := &rbac.Options{
+		SystemAccountRoleIDs: map[string]string{
+			"compute": "role",
+		},
+	}
+
+	authorizer := local.NewAuthorizer(authenticator, rbac.New(client, josetesting.Namespace, rbacOptions))
+
+	duration := refreshTokenDuration
+
+	issueInfo := &oauth2.IssueInfo{
+		Issuer:   "https://example.com",
+		Audience: "example.com",
+		Subject:  "barry@foo.com",
+		Type:     oauth2.TokenTypeFederated,
+		Federated: &oauth2.FederatedClaims{
+			UserID:   "fake",
+			ClientID: "client",
+		},
+		Duration: &duration,
+		Confirmation: &oauth2.ConfirmationClaims{
+			JWKThumbprint: "thumbprint",
+		},
+	}
+
+	tokens, err := authenticator.Issue(ctx, issueInfo)
+	require.NoError(t, err)
+
+	authorize := func(certificate string) error {
+		r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/oauth2/v2/userinfo", nil)
+		r.Header.Set("Authorization", "DPoP "+tokens.AccessToken)
+		r.Header.Set("Unikorn-DPoP-Thumbprint", "thumbprint")
+
+		if certificate != "" {
+			setClientCertificate(r, certificate)
+		}
+
+		_, err := authorize(authorizer, r)
+
+		return err
+	}
+
+	// A forged header without a proof is rejected.
+	require.Error(t, authorize(""))
+
+	// As is one from an mTLS client that isn't a system account.
+	require.Error(t, authorize(newClientCertificate(t, "barry")))
+
+	// System accounts that have verified the proof are trusted.
+	require.NoError(t, authorize(newClientCertificate(t, "compute")))
+}
+
+// TestTokenExchange checks services can act on behalf of a user with a short lived
+// token bound to the service's certificate, and restricted to the requested audience.
+//
+//nolint:maintidx
+func TestTokenExchange(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, client := newAuthenticator(ctx, t, newUser(), oauth2client)
+
+	rbacOptions := &rbac.Options{
+		SystemAccountRoleIDs: map[string]string{
+			"compute": "role",
+		},
+	}
+
+	authorizer := local.NewAuthorizer(authenticator, rbac.New(client, josetesting.Namespace, rbacOptions))
+
+	issue := func(duration time.Duration) string {
+		issueInfo := &oauth2.IssueInfo{
+			Issuer:   "https://example.com",
+			Audience: "example.com",
+			Subject:  "barry@foo.com",
+			Type:     oauth2.TokenTypeFederated,
+			Federated: &oauth2.FederatedClaims{
+				UserID:   "fake",
+				ClientID: "client",
+			},
+			Duration: &duration,
+		}
+
+		tokens, err := authenticator.Issue(ctx, issueInfo)
+		require.NoError(t, err)
+
+		return tokens.AccessToken
+	}
+
+	exchange := func(certificate, subjectToken string) (*openapi.Token, error) {
+		form := url.Values{
+			"grant_type":         []string{string(openapi.UrnIetfParamsOauthGrantTypeTokenExchange)},
+			"subject_token":      []string{subjectToken},
+			"subject_token_type": []string{"urn:ietf:params:oauth:token-type:access_token"},
+			"audience":           []string{"compute.example.com"},
+		}
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/oauth2/v2/token", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		if certificate != "" {
+			setClientCertificate(r, certificate)
+		}
+
+		return authenticator.Token(httptest.NewRecorder(), r)
+	}
+
+	compute := newClientCertificate(t, "compute")
+	subjectToken := issue(refreshTokenDuration)
+
+	// Actors must authenticate with mTLS.
+	_, err := exchange("", subjectToken)
+	require.Error(t, err)
+
+	// Delegated tokens are short lived.
+	delegated, err := exchange(compute, subjectToken)
+	require.NoError(t, err)
+	require.LessOrEqual(t, delegated.ExpiresIn, int(tokenExchangeDuration.Seconds()))
+
+	// And cannot outlive the subject's token.
+	shortLived, err := exchange(compute, issue(2*time.Second))
+	require.NoError(t, err)
+	require.LessOrEqual(t, shortLived.ExpiresIn, 2)
+
+	// Delegated tokens cannot be exchanged again.
+	_, err = exchange(compute, delegated.AccessToken)
+	require.Error(t, err)
+
+	// use presents the delegated token as the target service would when validating
+	// it with the identity service, propagating the actor's certificate and its
+	// expected audience.
+	use := func(actor, caller, audience string) error {
+		r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/oauth2/v2/userinfo", nil)
+		r.Header.Set("Authorization", "Bearer "+delegated.AccessToken)
+
+		if caller != "" {
+			setClientCertificate(r, caller)
+		}
+
+		if audience != "" {
+			authorization.InjectAudience(r.Header, audience)
+		}
+
+		r = r.WithContext(authorization.NewContextWithClientCert(r.Context(), actor))
+
+		_, err := authorize(authorizer, r)
+
+		return err
+	}
+
+	require.NoError(t, use(compute, compute, "compute.example.com"))
+
+	// The token is bound to the actor's certificate.
+	require.Error(t, use(newClientCertificate(t, "compute"), compute, "compute.example.com"))
+
+	// And only valid for the target audience.
+	require.Error(t, use(compute, compute, "region.example.com"))
+	require.Error(t, use(compute, "", ""))
+
+	// Which cannot be forged by anyone other than a system account.
+	require.Error(t, use(compute, newClientCertificate(t, "barry"), "compute.example.com"))
+	require.Error(t, use(compute, "", "compute.example.com"))
+
+	// Delegated service account tokens are revoked along with the token they
+	// were exchanged for.
+	serviceAccountToken := func() string {
+		duration := refreshTokenDuration
+
+		issueInfo := &oauth2.IssueInfo{
+			Issuer:   "https://example.com",
+			Audience: "example.com",
+			Subject:  "service-account",
+			Type:     oauth2.TokenTypeServiceAccount,
+			ServiceAccount: &oauth2.ServiceAccountClaims{
+				OrganizationID: "organization",
+			},
+			Duration: &duration,
+		}
+
+		tokens, err := authenticator.Issue(ctx, issueInfo)
+		require.NoError(t, err)
+
+		return tokens.AccessToken
+	}
+
+	require.NoError(t, client.Create(ctx, &unikornv1.Organization{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "organization",
+		},
+		Status: unikornv1.OrganizationStatus{
+			Namespace: "organization",
+		},
+	}))
+
+	serviceAccount := &unikornv1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "organization",
+			Name:      "service-account",
+		},
+		Spec: unikornv1.ServiceAccountSpec{
+			AccessToken: serviceAccountToken(),
+		},
+	}
+
+	require.NoError(t, client.Create(ctx, serviceAccount))
+
+	delegated, err = exchange(compute, serviceAccount.Spec.AccessToken)
+	require.NoError(t, err)
+
+	serviceAccount.Spec.AccessToken = serviceAccountToken()
+
+	require.NoError(t, client.Update(ctx, serviceAccount))
+
+	verifyInfo := &oauth2.VerifyInfo{
+		Issuer:   "https://example.com",
+		Audience: "example.com",
+		Token:    delegated.AccessToken,
+	}
+
+	_, err = authenticator.Verify(ctx, verifyInfo)
+	require.Error(t, err)
+}
+
+func TestClientAssertion(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	jwks := gojose.JSONWebKeySet{
+		Keys: []gojose.JSONWebKey{
+			{
+				Key:       key.Public(),
+				Algorithm: string(gojose.ES256),
+				Use:       "sig",
+			},
+		},
+	}
+
+	jwksData, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	jwksString := string(jwksData)
+
+	secret := "0123456789abcdef0123456789abcdef"
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Spec: unikornv1.OAuth2ClientSpec{
+			JWKS: &jwksString,
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, secret),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, newUser(), oauth2client, newClientSecret(t, oauth2client, secret))
+
+	duration := refreshTokenDuration
+
+	issueInfo := &oauth2.IssueInfo{
+		Issuer:   "https://example.com",
+		Audience: "example.com",
+		Subject:  "barry@foo.com",
+		Type:     oauth2.TokenTypeFederated,
+		Federated: &oauth2.FederatedClaims{
+			UserID:   "fake",
+			ClientID: "client",
+		},
+		Duration: &duration,
+	}
+
+	tokens, err := authenticator.Issue(ctx, issueInfo)
+	require.NoError(t, err)
+
+	refreshToken := *tokens.RefreshToken
+
+	assertion := func(algorithm gojose.SignatureAlgorithm, signingKey any, audience string, lifetime time.Duration) string {
+		signer, err := gojose.NewSigner(gojose.SigningKey{Algorithm: algorithm, Key: signingKey}, nil)
+		require.NoError(t, err)
+
+		claims := jwt.Claims{
+			Issuer:   "client",
+			Subject:  "client",
+			Audience: jwt.Audience{audience},
+			ID:       uuid.New().String(),
+			Expiry:   jwt.NewNumericDate(time.Now().Add(lifetime)),
+		}
+
+		token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+
+		return token
+	}
+
+	refresh := func(assertion string) error {
+		form := url.Values{
+			"grant_type":            []string{"refresh_token"},
+			"refresh_token":         []string{refreshToken},
+			"client_assertion_type": []string{"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+			"client_assertion":      []string{assertion},
+		}
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/oauth2/v2/token", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		result, err := authenticator.Token(httptest.NewRecorder(), r)
+		if err != nil {
+			return err
+		}
+
+		refreshToken = *result.RefreshToken
+
+		return nil
+	}
+
+	// Assertions signed by a client key are accepted, but only once.
+	privateKeyJWT := assertion(gojose.ES256, key, "https://example.com/oauth2/v2/token", time.Minute)
+
+	require.NoError(t, refresh(privateKeyJWT))
+	require.Error(t, refresh(privateKeyJWT))
+
+	// Assertions signed by the client secret are accepted.
+	require.NoError(t, refresh(assertion(gojose.HS256, []byte(secret), "https://example.com", time.Minute)))
+
+	// Assertions must be signed by the client.
+	imposter, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	require.Error(t, refresh(assertion(gojose.ES256, imposter, "https://example.com/oauth2/v2/token", time.Minute)))
+
+	// And intended for us.
+	require.Error(t, refresh(assertion(gojose.ES256, key, "https://imposter.com/oauth2/v2/token", time.Minute)))
+
+	// And short lived.
+	require.Error(t, refresh(assertion(gojose.ES256, key, "https://example.com/oauth2/v2/token", time.Hour)))
+}
+
+// TestClientAuthenticationMethod checks endpoints other than the token endpoint
+// authenticate clients using their registered method.
+func TestClientAuthenticationMethod(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	jwks := gojose.JSONWebKeySet{
+		Keys: []gojose.JSONWebKey{
+			{
+				Key:       key.Public(),
+				Algorithm: string(gojose.ES256),
+				Use:       "sig",
+			},
+		},
+	}
+
+	jwksData, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	jwksString := string(jwksData)
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Spec: unikornv1.OAuth2ClientSpec{
+			JWKS:                    &jwksString,
+			TokenEndpointAuthMethod: ptr.To(unikornv1.PrivateKeyJWT),
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, "secret"),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, cli := newAuthenticator(ctx, t, oauth2client)
+
+	signer, err := gojose.NewSigner(gojose.SigningKey{Algorithm: gojose.ES256, Key: key}, nil)
+	require.NoError(t, err)
+
+	claims := jwt.Claims{
+		Issuer:   "client",
+		Subject:  "client",
+		Audience: jwt.Audience{"https://example.com/oauth2/v2/revoke"},
+		ID:       uuid.New().String(),
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	}
+
+	assertion, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	require.NoError(t, err)
+
+	// Revocation of an invalid token isn't an error, so this just checks
+	// client authentication.
+	revoke := func(authenticator *oauth2.Authenticator, form url.Values, secret string) error {
+		form.Set("token", "garbage")
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/oauth2/v2/revoke", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		if secret != "" {
+			r.SetBasicAuth("client", secret)
+		}
+
+		return authenticator.Revoke(httptest.NewRecorder(), r)
+	}
+
+	// The client secret is valid, but not the registered method.
+	require.Error(t, revoke(authenticator, url.Values{}, "secret"))
+
+	withAssertion := url.Values{
+		"client_assertion_type": []string{"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"client_assertion":      []string{assertion},
+	}
+
+	require.NoError(t, revoke(authenticator, withAssertion, ""))
+
+	// Assertions are single use, across all replicas.
+	require.Error(t, revoke(authenticator, withAssertion, ""))
+
+	replica, _ := newReplica(ctx, t, cli)
+
+	require.Error(t, revoke(replica, withAssertion, ""))
+}
+
+func TestClientSecretRotation(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, "current"),
+			PreviousSecrets: []unikornv1.OAuth2ClientSecret{
+				{
+					Hash:   hashSecret(t, "previous"),
+					Expiry: metav1.NewTime(time.Now().Add(time.Hour)),
+				},
+				{
+					Hash:   hashSecret(t, "expired"),
+					Expiry: metav1.NewTime(time.Now().Add(-time.Hour)),
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, oauth2client)
+
+	// Revocation of an invalid token isn't an error, so this just checks
+	// client authentication.
+	authenticate := func(secret string) error {
+		form := url.Values{
+			"token": []string{"garbage"},
+		}
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/oauth2/v2/revoke", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.SetBasicAuth("client", secret)
+
+		return authenticator.Revoke(httptest.NewRecorder(), r)
+	}
+
+	require.NoError(t, authenticate("current"))
+	require.NoError(t, authenticate("previous"))
+	require.Error(t, authenticate("expired"))
+	require.Error(t, authenticate("garbage"))
+}
+
+func TestRedirectURIs(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Spec: unikornv1.OAuth2ClientSpec{
+			RedirectURIs: []string{
+				"https://foo.com/callback",
+				"https://bar.com/callback",
+			},
+			RedirectURIPatterns: []string{
+				"http://127.0.0.1/callback",
+				"https://*.preview.foo.com/callback",
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, oauth2client)
+
+	authorize := func(redirectURI string) int {
+		query := url.Values{
+			"response_type":         []string{"code"},
+			"client_id":             []string{"client"},
+			"redirect_uri":          []string{redirectURI},
+			"code_challenge":        []string{"challenge"},
+			"code_challenge_method": []string{"S256"},
+		}
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/oauth2/v2/authorization?"+query.Encode(), nil)
+		w := httptest.NewRecorder()
+
+		authenticator.Authorization(w, r)
+
+		return w.Code
+	}
+
+	allowed := []string{
+		"https://foo.com/callback",
+		"https://bar.com/callback",
+		"http://127.0.0.1:49152/callback",
+		"http://127.0.0.1/callback",
+		"https://pr-123.preview.foo.com/callback",
+	}
+
+	for _, uri := range allowed {
+		require.Equal(t, http.StatusOK, authorize(uri), uri)
+	}
+
+	rejected := []string{
+		"https://baz.com/callback",
+		"https://foo.com/callback?foo=bar",
+		"http://localhost:49152/callback",
+		"https://127.0.0.1:49152/callback",
+		"http://127.0.0.1:49152/other",
+		"https://a.b.preview.foo.com/callback",
+		"https://preview.foo.com/callback",
+		"https://evil.com/.preview.foo.com/callback",
+		"https://pr-123.preview.foo.com:8443/callback",
+		"http://pr-123.preview.foo.com/callback",
+		"https://user@pr-123.preview.foo.com/callback",
+	}
+
+	for _, uri := range rejected {
+		require.Equal(t, http.StatusBadRequest, authorize(uri), uri)
+	}
+}
+
+func TestTokenLifetimes(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Spec: unikornv1.OAuth2ClientSpec{
+			AccessTokenLifetime:     &metav1.Duration{Duration: 10 * time.Second},
+			RefreshTokenIdleTimeout: &metav1.Duration{Duration: 10 * time.Second},
+			AbsoluteSessionLifetime: &metav1.Duration{Duration: 2 * time.Second},
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, "secret"),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, newUser(), oauth2client)
+
+	issueInfo := &oauth2.IssueInfo{
+		Issuer:   "https://example.com",
+		Audience: "example.com",
+		Subject:  "barry@foo.com",
+		Type:     oauth2.TokenTypeFederated,
+		Federated: &oauth2.FederatedClaims{
+			UserID:   "fake",
+			ClientID: "client",
+		},
+	}
+
+	start := time.Now()
+
+	tokens, err := authenticator.Issue(ctx, issueInfo)
+	require.NoError(t, err)
+
+	// The client's access token lifetime overrides the default, but tokens cannot
+	// outlive the session.
+	require.WithinDuration(t, start.Add(2*time.Second), tokens.Expiry, time.Second)
+
+	refreshToken := *tokens.RefreshToken
+
+	refresh := func() (*openapi.Token, error) {
+		form := url.Values{
+			"grant_type":    []string{"refresh_token"},
+			"refresh_token": []string{refreshToken},
+		}
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/oauth2/v2/token", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.SetBasicAuth("client", "secret")
+
+		result, err := authenticator.Token(httptest.NewRecorder(), r)
+		if err != nil {
+			return nil, err
+		}
+
+		refreshToken = *result.RefreshToken
+
+		return result, nil
+	}
+
+	// Refreshing doesn't extend the session.
+	result, err := refresh()
+	require.NoError(t, err)
+	require.LessOrEqual(t, result.ExpiresIn, 2)
+
+	// Once the session has expired the user must log in again.
+	time.Sleep(2 * time.Second)
+
+	_, err = refresh()
+	require.Error(t, err)
+}
+
+func TestRefreshTokenReuse(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Status: unikornv1.OAuth2ClientStatus{
+			SecretHash: hashSecret(t, "secret"),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, newUser(), oauth2client)
+
+	issueInfo := &oauth2.IssueInfo{
+		Issuer:   "https://example.com",
+		Audience: "example.com",
+		Subject:  "barry@foo.com",
+		Type:     oauth2.TokenTypeFederated,
+		Federated: &oauth2.FederatedClaims{
+			UserID:   "fake",
+			ClientID: "client",
+		},
+	}
+
+	tokens, err := authenticator.Issue(ctx, issueInfo)
+	require.NoError(t, err)
+
+	refresh := func(refreshToken string) (string, error) {
+		form := url.Values{
+			"grant_type":    []string{"refresh_token"},
+			"refresh_token": []string{refreshToken},
+		}
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/oauth2/v2/token", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.SetBasicAuth("client", "secret")
+
+		result, err := authenticator.Token(httptest.NewRecorder(), r)
+		if err != nil {
+			return "", err
+		}
+
+		return *result.RefreshToken, nil
+	}
+
+	superseded := *tokens.RefreshToken
+
+	current, err := refresh(superseded)
+	require.NoError(t, err)
+
+	// Concurrent refreshes get the same tokens, and don't revoke the session.
+	replayed, err := refresh(superseded)
+	require.NoError(t, err)
+	require.Equal(t, current, replayed)
+
+	current, err = refresh(current)
+	require.NoError(t, err)
+
+	// Replays outside of the grace period revoke the whole token family.
+	time.Sleep(2 * refreshTokenReuseGracePeriod)
+
+	_, err = refresh(superseded)
+	require.Error(t, err)
+
+	_, err = refresh(current)
+	require.Error(t, err)
+}
+
+func TestMultipleSessions(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, newUser(), oauth2client)
+
+	// Use a longer lived token so it cannot expire during the test.
+	duration := refreshTokenDuration
+
+	issue := func() string {
+		issueInfo := &oauth2.IssueInfo{
+			Issuer:   "https://foo.com",
+			Audience: "foo.com",
+			Subject:  "barry@foo.com",
+			Type:     oauth2.TokenTypeFederated,
+			Federated: &oauth2.FederatedClaims{
+				UserID:   "fake",
+				ClientID: "client",
+			},
+			Duration: &duration,
+		}
+
+		tokens, err := authenticator.Issue(ctx, issueInfo)
+		require.NoError(t, err)
+
+		return tokens.AccessToken
+	}
+
+	verify := func(token string) error {
+		verifyInfo := &oauth2.VerifyInfo{
+			Issuer:   "https://foo.com",
+			Audience: "foo.com",
+			Token:    token,
+		}
+
+		_, err := authenticator.Verify(ctx, verifyInfo)
+
+		return err
+	}
+
+	// Logging in again doesn't affect existing sessions.
+	first := issue()
+	second := issue()
+
+	require.NoError(t, verify(first))
+	require.NoError(t, verify(second))
+
+	// Until the maximum number of sessions is reached, and the oldest is evicted.
+	third := issue()
+
+	require.Error(t, verify(first))
+	require.NoError(t, verify(second))
+	require.NoError(t, verify(third))
+}
+
+func TestRevokeSession(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, _ := newAuthenticator(ctx, t, newUser(), oauth2client)
+
+	// Use a longer lived token so it cannot expire during the test.
+	duration := refreshTokenDuration
+
+	issue := func() string {
+		issueInfo := &oauth2.IssueInfo{
+			Issuer:   "https://foo.com",
+			Audience: "foo.com",
+			Subject:  "barry@foo.com",
+			Type:     oauth2.TokenTypeFederated,
+			Federated: &oauth2.FederatedClaims{
+				UserID:   "fake",
+				ClientID: "client",
+			},
+			Duration: &duration,
+		}
+
+		tokens, err := authenticator.Issue(ctx, issueInfo)
+		require.NoError(t, err)
+
+		return tokens.AccessToken
+	}
+
+	verify := func(token string) error {
+		verifyInfo := &oauth2.VerifyInfo{
+			Issuer:   "https://foo.com",
+			Audience: "foo.com",
+			Token:    token,
+		}
+
+		_, err := authenticator.Verify(ctx, verifyInfo)
+
+		return err
+	}
+
+	first := issue()
+	second := issue()
+
+	userSessions, err := authenticator.ListSessions(ctx, "fake")
+	require.NoError(t, err)
+	require.Len(t, userSessions, 2)
+
+	index := slices.IndexFunc(userSessions, func(session unikornv1.UserSession) bool {
+		return session.Spec.AccessToken == first
+	})
+	require.GreaterOrEqual(t, index, 0)
+
+	// Revoking a session only affects that session.
+	require.NoError(t, authenticator.RevokeSession(ctx, "fake", userSessions[index].Name))
+
+	require.Error(t, verify(first))
+	require.NoError(t, verify(second))
+
+	// Revoking it again is an error as it doesn't exist.
+	require.ErrorIs(t, authenticator.RevokeSession(ctx, "fake", userSessions[index].Name), sessions.ErrNotFound)
+}
+
+func TestWatchResources(t *testing.T) {
+	t.Parallel()
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, cli := newAuthenticator(ctx, t, newUser(), oauth2client)
+
+	informers := &informertest.FakeInformers{
+		Scheme: getScheme(t),
+	}
+
+	require.NoError(t, authenticator.WatchResources(ctx, informers))
+
+	since := time.Now()
+
+	// Use a longer lived token so it cannot expire during the test.
+	duration := refreshTokenDuration
+
+	issueInfo := &oauth2.IssueInfo{
+		Issuer:   "https://foo.com",
+		Audience: "foo.com",
+		Subject:  "barry@foo.com",
+		Type:     oauth2.TokenTypeFederated,
+		Federated: &oauth2.FederatedClaims{
+			UserID:   "fake",
+			ClientID: "client",
+		},
+		Duration: &duration,
+	}
+
+	tokens, err := authenticator.Issue(ctx, issueInfo)
+	require.NoError(t, err)
+
+	verifyInfo := &oauth2.VerifyInfo{
+		Issuer:   "https://foo.com",
+		Audience: "foo.com",
+		Token:    tokens.AccessToken,
+	}
+
+	_, err = authenticator.Verify(ctx, verifyInfo)
+	require.NoError(t, err)
+
+	// Suspend the user as another replica would, the token is still cached.
+	user := &unikornv1.User{}
+	require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: josetesting.Namespace, Name: "fake"}, user))
+
+	suspended := user.DeepCopy()
+	suspended.Generation++
+	suspended.Spec.State = unikornv1.UserStateSuspended
+
+	require.NoError(t, cli.Update(ctx, suspended))
+
+	_, err = authenticator.Verify(ctx, verifyInfo)
+	require.NoError(t, err)
+
+	// Until the watch sees the change.
+	informer, err := informers.FakeInformerFor(ctx, &unikornv1.User{})
+	require.NoError(t, err)
+
+	informer.Update(user, suspended)
+
+	_, err = authenticator.Verify(ctx, verifyInfo)
+	require.Error(t, err)
+
+	// And the revocation is published for remote caches.
+	revocations := authenticator.Revocations(since)
+	require.False(t, revocations.Reset)
+	require.Len(t, revocations.Events, 1)
+	require.NotNil(t, revocations.Events[0].Subject)
+	require.Equal(t, "barry@foo.com", *revocations.Events[0].Subject)
+
+	// Anything before the feed started is unknown.
+	require.True(t, authenticator.Revocations(since.Add(-time.Hour)).Reset)
+}
@@ -18,9 +18,12 @@ package oidc
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
@@ -33,6 +36,8 @@ import (
 
 var (
 	ErrMissingField = errors.New("missing field")
+
+	ErrMalformedToken = errors.New("malformed token")
 )
 
 // Config returns a oauth2 configuration via service discovery.
@@ -141,3 +146,35 @@ func CodeExchange(ctx context.Context, parameters *types.CodeExchangeParameters)
 
 	return token, idTokenClaims, nil
 }
+
+// UnverifiedClaims decodes ID token claims without verifying the signature.  This
+// must only be used for tokens that have already been verified, or where the result
+// is purely informational.
+func UnverifiedClaims(idToken string, claims any) error {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("%w: expected 3 parts", ErrMalformedToken)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	return nil
+}
+
+// TokenClaims decodes the full set of ID token claims from a token returned by
+// CodeExchange, which has already verified it.
+func TokenClaims(token *oauth2.Token, claims any) error {
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return fmt.Errorf("%w: id_token not in response", ErrMissingField)
+	}
+
+	return UnverifiedClaims(idToken, claims)
+}
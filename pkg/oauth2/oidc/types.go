@@ -38,6 +38,9 @@ type Default struct {
 	AuthenticationContextClass string `json:"acr,omitempty"`
 	// AuthorizedParty is the authorized party aka the client ID.
 	AuthorizedParty string `json:"azp,omitempty"`
+	// SessionID identifies the user's session with the client, and is
+	// used to scope logout.
+	SessionID string `json:"sid,omitempty"`
 }
 
 // Profile are claims that may be returned by requesting the
@@ -88,6 +91,21 @@ type Email struct {
 	EmailVerified bool `json:"email_verified,omitempty"`
 }
 
+// BackchannelLogoutEvent is the event type that identifies a logout token.
+const BackchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+// LogoutToken defines a logout_token.
+// Reference https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken.
+type LogoutToken struct {
+	// Claims are the standard claims expected in a JWT.
+	jwt.Claims `json:",inline"`
+	// Events must contain the back-channel logout event.
+	Events map[string]struct{} `json:"events"`
+	// SessionID identifies the session being logged out, and matches the
+	// sid claim of the ID token issued for it.
+	SessionID string `json:"sid,omitempty"`
+}
+
 // IDToken defines an id_token.
 // Reference https://openid.net/specs/openid-connect-core-1_0.html#IDToken.
 type IDToken struct {
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	goerrors "errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/identity/pkg/jose"
+	"github.com/unikorn-cloud/identity/pkg/openapi"
+)
+
+const (
+	// pushedAuthorizationRequestURIPrefix is defined by RFC 9126 section 2.2.
+	pushedAuthorizationRequestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+)
+
+var (
+	// ErrPushedAuthorizationRequest is raised when a request URI cannot be used.
+	ErrPushedAuthorizationRequest = goerrors.New("pushed authorization request invalid")
+)
+
+// PushedAuthorizationRequest is encrypted into the request URI, so no server side
+// state is required and any replica can service the authorization request.
+type PushedAuthorizationRequest struct {
+	// ID is a unique identifier for the request.
+	ID string `json:"id"`
+	// ClientQuery stores the pushed authorization request parameters.
+	ClientQuery string `json:"cq"`
+	// Expiry is when the request expires in seconds since the epoch.
+	Expiry int64 `json:"exp"`
+}
+
+// isPushedAuthorizationRequest returns true if the authorization request references
+// a pushed authorization request.  Other request URIs, i.e. OIDC request objects by
+// reference, are not supported and are rejected by the usual validation.
+func isPushedAuthorizationRequest(query url.Values) bool {
+	return strings.HasPrefix(query.Get("request_uri"), pushedAuthorizationRequestURIPrefix)
+}
+
+// pushedAuthorizationRequest decodes and consumes a pushed authorization request.
+// Single use is best effort and per-replica, however the request is short lived,
+// bound to the client, and the code it yields is still subject to PKCE and redirect
+// URI checks.
+func (a *Authenticator) pushedAuthorizationRequest(r *http.Request, query url.Values) (url.Values, error) {
+	request := &PushedAuthorizationRequest{}
+
+	token := strings.TrimPrefix(query.Get("request_uri"), pushedAuthorizationRequestURIPrefix)
+
+	if err := a.issuer.DecodeJWEToken(r.Context(), token, request, jose.TokenTypePushedAuthorizationRequest); err != nil {
+		return nil, goerrors.Join(ErrPushedAuthorizationRequest, err)
+	}
+
+	if time.Now().After(time.Unix(request.Expiry, 0)) {
+		return nil, ErrPushedAuthorizationRequest
+	}
+
+	pushed, err := url.ParseQuery(request.ClientQuery)
+	if err != nil {
+		return nil, goerrors.Join(ErrPushedAuthorizationRequest, err)
+	}
+
+	// The request is bound to the client that pushed it, this is checked before
+	// the request is consumed so another party cannot burn it.
+	if pushed.Get("client_id") != query.Get("client_id") {
+		return nil, ErrPushedAuthorizationRequest
+	}
+
+	if _, ok := a.pushedAuthorizationRequestCache.Get(request.ID); ok {
+		return nil, ErrPushedAuthorizationRequest
+	}
+
+	a.pushedAuthorizationRequestCache.Add(request.ID, nil, time.Until(time.Unix(request.Expiry, 0)))
+
+	return pushed, nil
+}
+
+// PushedAuthorization implements RFC 9126, it accepts authorization request parameters
+// from an authenticated client, and returns a request URI that can be used once at the
+// authorization endpoint in their place.
+func (a *Authenticator) PushedAuthorization(w http.ResponseWriter, r *http.Request) (*openapi.PushedAuthorization, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, errors.OAuth2InvalidRequest("failed to parse form").WithError(err)
+	}
+
+	client, err := a.authenticateClient(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The client ID is optional when not using a client secret in the body, but
+	// is required to bind the request to the client at the authorization endpoint.
+	r.Form.Set("client_id", client.Name)
+
+	if r.Form.Has("request_uri") {
+		return nil, errors.OAuth2InvalidRequest("request_uri cannot be pushed")
+	}
+
+	// Redirection errors are deferred to the authorization endpoint, but we
+	// can reject requests that can never succeed.
+	if !redirectURIAllowed(client, r.Form.Get("redirect_uri")) {
+		return nil, errors.OAuth2InvalidRequest("redirect_uri is invalid")
+	}
+
+	query := url.Values{}
+
+	for key, values := range r.Form {
+		if slices.Contains([]string{"client_secret", "client_assertion", "client_assertion_type"}, key) {
+			continue
+		}
+
+		query[key] = values
+	}
+
+	id, err := randomString(32)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to generate request ID").WithError(err)
+	}
+
+	request := &PushedAuthorizationRequest{
+		ID:          id,
+		ClientQuery: query.Encode(),
+		Expiry:      time.Now().Add(a.options.PushedAuthorizationRequestDuration).Unix(),
+	}
+
+	token, err := a.issuer.EncodeJWEToken(r.Context(), request, jose.TokenTypePushedAuthorizationRequest)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to encode request URI").WithError(err)
+	}
+
+	result := &openapi.PushedAuthorization{
+		RequestUri: pushedAuthorizationRequestURIPrefix + token,
+		ExpiresIn:  int(a.options.PushedAuthorizationRequestDuration.Seconds()),
+	}
+
+	return result, nil
+}
@@ -24,4 +24,8 @@ var (
 	ErrUnauthorized = errors.New("requuest requires authentication")
 
 	ErrUnexpectedStatusCode = errors.New("unexpected status code")
+
+	ErrGroupsUnsupported = errors.New("group lookup not supported")
+
+	ErrAccessDenied = errors.New("access denied")
 )
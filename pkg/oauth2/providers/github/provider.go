@@ -20,19 +20,29 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"slices"
+	"strconv"
+	"strings"
 
 	"golang.org/x/oauth2"
 
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/common"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
+	providererrors "github.com/unikorn-cloud/identity/pkg/oauth2/providers/errors"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/types"
 )
 
 var (
 	ErrEmailLookup = errors.New("failed to lookup email")
+
+	ErrNotFound = errors.New("resource not found")
+
+	ErrOrganizationMembership = fmt.Errorf("%w: user is not a member of the github organization", providererrors.ErrAccessDenied)
 )
 
 //nolint:tagliatelle
@@ -47,20 +57,42 @@ type Email struct {
 	Primary  bool   `json:"primary"`
 }
 
-const githubAPIBase = "https://api.github.com"
+type Membership struct {
+	State string `json:"state"`
+}
+
+type Organization struct {
+	Login string `json:"login"`
+}
+
+type Team struct {
+	Slug         string       `json:"slug"`
+	Organization Organization `json:"organization"`
+}
+
+const (
+	githubAPIBase = "https://api.github.com"
+
+	// teamsPerPage is the maximum page size allowed by the API.
+	teamsPerPage = 100
+)
 
 type Client struct {
+	base  string
 	token string
 }
 
-func NewClient(token string) *Client {
+// NewClient creates a new GitHub API client, the base is typically the public
+// API, or https://github.example.com/api/v3 for GitHub Enterprise Server.
+func NewClient(base, token string) *Client {
 	return &Client{
+		base:  base,
 		token: token,
 	}
 }
 
 func (p *Client) do(ctx context.Context, path string, data interface{}) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, githubAPIBase+path, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.base+path, nil)
 	if err != nil {
 		return err
 	}
@@ -78,6 +110,14 @@ func (p *Client) do(ctx context.Context, path string, data interface{}) error {
 
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s returned %d", providererrors.ErrUnexpectedStatusCode, path, resp.StatusCode)
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return err
@@ -124,6 +164,40 @@ func (p *Client) GetPrimaryEmail(ctx context.Context) (*Email, error) {
 	return &emails[i], nil
 }
 
+// GetOrganizationMembership returns the user's membership of an organization.
+func (p *Client) GetOrganizationMembership(ctx context.Context, organization string) (*Membership, error) {
+	membership := &Membership{}
+
+	if err := p.do(ctx, "/user/memberships/orgs/"+url.PathEscape(organization), membership); err != nil {
+		return nil, err
+	}
+
+	return membership, nil
+}
+
+// GetTeams returns all teams the user is a member of.
+func (p *Client) GetTeams(ctx context.Context) ([]Team, error) {
+	var result []Team
+
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("per_page", strconv.Itoa(teamsPerPage))
+		query.Set("page", strconv.Itoa(page))
+
+		var teams []Team
+
+		if err := p.do(ctx, "/user/teams?"+query.Encode(), &teams); err != nil {
+			return nil, err
+		}
+
+		result = append(result, teams...)
+
+		if len(teams) < teamsPerPage {
+			return result, nil
+		}
+	}
+}
+
 func (p *Client) IDToken(ctx context.Context) (*oidc.IDToken, error) {
 	// User gives us information about the user...
 	user, err := p.GetUser(ctx)
@@ -157,8 +231,34 @@ func New() *Provider {
 	return &Provider{}
 }
 
+// apiBase returns the API endpoint for the provider.
+func apiBase(provider *unikornv1.OAuth2Provider) string {
+	if provider.Spec.APIBaseURI != nil {
+		return strings.TrimSuffix(*provider.Spec.APIBaseURI, "/")
+	}
+
+	return githubAPIBase
+}
+
+// organization returns the GitHub organization for the organization if configured.
+func organization(organization *unikornv1.Organization) string {
+	if organization == nil || organization.Spec.ProviderOptions == nil || organization.Spec.ProviderOptions.GitHub == nil || organization.Spec.ProviderOptions.GitHub.Organization == nil {
+		return ""
+	}
+
+	return *organization.Spec.ProviderOptions.GitHub.Organization
+}
+
 func (*Provider) Config(ctx context.Context, parameters *types.ConfigParameters) (*oauth2.Config, error) {
-	return common.Config(parameters, nil), nil
+	var scopes []string
+
+	// Private organization and team membership requires additional consent,
+	// so only ask for it when the organization is configured to use it.
+	if organization(parameters.Organization) != "" {
+		scopes = append(scopes, "read:org")
+	}
+
+	return common.Config(parameters, scopes), nil
 }
 
 func (*Provider) AuthorizationURL(config *oauth2.Config, parameters *types.AuthorizationParamters) (string, error) {
@@ -171,10 +271,49 @@ func (*Provider) CodeExchange(ctx context.Context, parameters *types.CodeExchang
 		return nil, nil, err
 	}
 
-	idToken, err := NewClient(token.AccessToken).IDToken(ctx)
+	idToken, err := NewClient(apiBase(parameters.Provider), token.AccessToken).IDToken(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	return token, idToken, nil
 }
+
+// Groups checks the user is an active member of the organization's GitHub organization,
+// and returns the teams they are a member of within it, as "organization/team".
+func (*Provider) Groups(ctx context.Context, parameters *types.GroupsParameters) ([]string, error) {
+	githubOrganization := organization(parameters.Organization)
+	if githubOrganization == "" {
+		return nil, providererrors.ErrGroupsUnsupported
+	}
+
+	client := NewClient(apiBase(parameters.Provider), parameters.Token.AccessToken)
+
+	membership, err := client.GetOrganizationMembership(ctx, githubOrganization)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrOrganizationMembership
+		}
+
+		return nil, err
+	}
+
+	if membership.State != "active" {
+		return nil, fmt.Errorf("%w: membership is %s", ErrOrganizationMembership, membership.State)
+	}
+
+	teams, err := client.GetTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+
+	for _, team := range teams {
+		if strings.EqualFold(team.Organization.Login, githubOrganization) {
+			result = append(result, githubOrganization+"/"+team.Slug)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	providererrors "github.com/unikorn-cloud/identity/pkg/oauth2/providers/errors"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/types"
+
+	"k8s.io/utils/ptr"
+)
+
+const (
+	token = "sekret"
+)
+
+// newEnterprise returns a GitHub Enterprise Server API stand-in, served under
+// the usual /api/v3 prefix, where the user is a member of "acme".
+func newEnterprise(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	// Fill the first page to force pagination.
+	teams := make([]Team, teamsPerPage+1)
+
+	for i := range teams {
+		teams[i] = Team{
+			Slug:         fmt.Sprintf("team-%d", i),
+			Organization: Organization{Login: "other"},
+		}
+	}
+
+	teams[0].Organization.Login = "acme"
+	teams[teamsPerPage].Organization.Login = "acme"
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v3/user/memberships/orgs/acme", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewEncoder(w).Encode(&Membership{State: "active"}); err != nil {
+			t.Error(err)
+		}
+	})
+
+	mux.HandleFunc("GET /api/v3/user/teams", func(w http.ResponseWriter, r *http.Request) {
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		start := min((page-1)*teamsPerPage, len(teams))
+		end := min(start+teamsPerPage, len(teams))
+
+		if err := json.NewEncoder(w).Encode(teams[start:end]); err != nil {
+			t.Error(err)
+		}
+	})
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newParameters(server *httptest.Server, githubOrganization string) *types.GroupsParameters {
+	return &types.GroupsParameters{
+		ConfigParameters: types.ConfigParameters{
+			Provider: &unikornv1.OAuth2Provider{
+				Spec: unikornv1.OAuth2ProviderSpec{
+					APIBaseURI: ptr.To(server.URL + "/api/v3/"),
+				},
+			},
+			Organization: &unikornv1.Organization{
+				Spec: unikornv1.OrganizationSpec{
+					ProviderOptions: &unikornv1.OrganizationProviderOptions{
+						GitHub: &unikornv1.OrganizationProviderGitHubSpec{
+							Organization: ptr.To(githubOrganization),
+						},
+					},
+				},
+			},
+		},
+		Token: &oauth2.Token{
+			AccessToken: token,
+		},
+	}
+}
+
+// TestGroups checks organization members get their teams within that organization.
+func TestGroups(t *testing.T) {
+	t.Parallel()
+
+	groups, err := New().Groups(context.Background(), newParameters(newEnterprise(t), "acme"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"acme/team-0", "acme/team-100"}, groups)
+}
+
+// TestGroupsNotMember checks users are denied access if not a member of the organization.
+func TestGroupsNotMember(t *testing.T) {
+	t.Parallel()
+
+	_, err := New().Groups(context.Background(), newParameters(newEnterprise(t), "umbrella"))
+	require.ErrorIs(t, err, ErrOrganizationMembership)
+	require.ErrorIs(t, err, providererrors.ErrAccessDenied)
+}
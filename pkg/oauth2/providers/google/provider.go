@@ -18,21 +18,64 @@ package google
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 
 	"golang.org/x/oauth2"
 
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
+	providererrors "github.com/unikorn-cloud/identity/pkg/oauth2/providers/errors"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/types"
 )
 
-type Provider struct{}
+const (
+	// cloudIdentityAPIBase is the Cloud Identity API endpoint.
+	cloudIdentityAPIBase = "https://cloudidentity.googleapis.com/v1"
+
+	// groupScope allows read only access to groups.
+	groupScope = "https://www.googleapis.com/auth/cloud-identity.groups.readonly"
+)
+
+var (
+	ErrEmail = errors.New("email address cannot be used in a query")
+)
+
+type Provider struct {
+	// cloudIdentityAPIBase allows the Cloud Identity API to be replaced for testing.
+	cloudIdentityAPIBase string
+}
 
 func New() *Provider {
-	return &Provider{}
+	return &Provider{
+		cloudIdentityAPIBase: cloudIdentityAPIBase,
+	}
+}
+
+// customerID returns the Google Workspace customer ID for the organization
+// if configured.
+func customerID(organization *unikornv1.Organization) string {
+	if organization == nil || organization.Spec.ProviderOptions == nil || organization.Spec.ProviderOptions.Google == nil || organization.Spec.ProviderOptions.Google.CustomerID == nil {
+		return ""
+	}
+
+	return *organization.Spec.ProviderOptions.Google.CustomerID
 }
 
 func (*Provider) Config(ctx context.Context, parameters *types.ConfigParameters) (*oauth2.Config, error) {
-	_, config, err := oidc.Config(ctx, parameters, nil)
+	var scopes []string
+
+	// Group lookup requires additional consent, so only ask for it when
+	// the organization is configured to use it.
+	if customerID(parameters.Organization) != "" {
+		scopes = append(scopes, groupScope)
+	}
+
+	_, config, err := oidc.Config(ctx, parameters, scopes)
 
 	return config, err
 }
@@ -51,3 +94,99 @@ func (*Provider) AuthorizationURL(config *oauth2.Config, parameters *types.Autho
 func (*Provider) CodeExchange(ctx context.Context, parameters *types.CodeExchangeParameters) (*oauth2.Token, *oidc.IDToken, error) {
 	return oidc.CodeExchange(ctx, parameters)
 }
+
+type groupKey struct {
+	ID string `json:"id"`
+}
+
+type membership struct {
+	GroupKey groupKey `json:"groupKey"`
+}
+
+type memberships struct {
+	Memberships   []membership `json:"memberships"`
+	NextPageToken string       `json:"nextPageToken"`
+}
+
+func (p *Provider) membershipsPage(ctx context.Context, client *http.Client, query url.Values) (*memberships, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.cloudIdentityAPIBase+"/groups/-/memberships:searchTransitiveGroups?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	// The user hasn't granted consent, or the API is disabled for the customer,
+	// either way group membership cannot be trusted, so deny the login.
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, fmt.Errorf("%w: group lookup forbidden", providererrors.ErrAccessDenied)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: group lookup returned %d", providererrors.ErrUnexpectedStatusCode, resp.StatusCode)
+	}
+
+	page := &memberships{}
+
+	if err := json.NewDecoder(resp.Body).Decode(page); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// membershipQuery returns a query for the user's groups within the customer.
+// See: https://cloud.google.com/identity/docs/how-to/query-memberships
+func membershipQuery(customer, email string) (string, error) {
+	if strings.ContainsAny(email, `'\`) {
+		return "", ErrEmail
+	}
+
+	return fmt.Sprintf("member_key_id == '%s' && 'cloudidentity.googleapis.com/groups.discussion_forum' in labels && parent == 'customers/%s'", email, customer), nil
+}
+
+// Groups returns the email addresses of the Google groups the user is a member of,
+// either directly or via nested groups.  This uses the Cloud Identity API, as unlike
+// the Directory API, it allows users to search their own memberships without any
+// administrative privileges.
+// See: https://cloud.google.com/identity/docs/reference/rest/v1/groups.memberships/searchTransitiveGroups
+func (p *Provider) Groups(ctx context.Context, parameters *types.GroupsParameters) ([]string, error) {
+	customer := customerID(parameters.Organization)
+	if customer == "" {
+		return nil, providererrors.ErrGroupsUnsupported
+	}
+
+	q, err := membershipQuery(customer, parameters.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	client := oauth2.NewClient(ctx, oauth2.StaticTokenSource(parameters.Token))
+
+	result := []string{}
+
+	query := url.Values{}
+	query.Set("query", q)
+
+	for {
+		page, err := p.membershipsPage(ctx, client, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range page.Memberships {
+			result = append(result, page.Memberships[i].GroupKey.ID)
+		}
+
+		if page.NextPageToken == "" {
+			return result, nil
+		}
+
+		query.Set("pageToken", page.NextPageToken)
+	}
+}
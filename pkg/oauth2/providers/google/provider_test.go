@@ -0,0 +1,172 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	providererrors "github.com/unikorn-cloud/identity/pkg/oauth2/providers/errors"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/types"
+
+	"k8s.io/utils/ptr"
+)
+
+const (
+	customer = "C0123abc"
+	email    = "barry@foo.com"
+	token    = "sekret"
+
+	// forbiddenToken is accepted, but lacks the required permissions.
+	forbiddenToken = "forbidden"
+)
+
+// newCloudIdentity returns a Cloud Identity API stand-in that pages membership results.
+func newCloudIdentity(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	pages := map[string]*memberships{
+		"": {
+			Memberships:   []membership{{GroupKey: groupKey{ID: "admins@foo.com"}}},
+			NextPageToken: "next",
+		},
+		"next": {
+			Memberships: []membership{{GroupKey: groupKey{ID: "developers@foo.com"}}},
+		},
+	}
+
+	expected, err := membershipQuery(customer, email)
+	require.NoError(t, err)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer " + token:
+		case "Bearer " + forbiddenToken:
+			w.WriteHeader(http.StatusForbidden)
+			return
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		query := r.URL.Query()
+
+		if r.URL.Path != "/groups/-/memberships:searchTransitiveGroups" || query.Get("query") != expected {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		page, ok := pages[query.Get("pageToken")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			t.Error(err)
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newParameters(customerID *string) *types.GroupsParameters {
+	return &types.GroupsParameters{
+		ConfigParameters: types.ConfigParameters{
+			Organization: &unikornv1.Organization{
+				Spec: unikornv1.OrganizationSpec{
+					ProviderOptions: &unikornv1.OrganizationProviderOptions{
+						Google: &unikornv1.OrganizationProviderGoogleSpec{
+							CustomerID: customerID,
+						},
+					},
+				},
+			},
+		},
+		Token: &oauth2.Token{
+			AccessToken: token,
+		},
+		Email: email,
+	}
+}
+
+// TestGroups checks group lookup follows pagination.
+func TestGroups(t *testing.T) {
+	t.Parallel()
+
+	provider := &Provider{
+		cloudIdentityAPIBase: newCloudIdentity(t).URL,
+	}
+
+	groups, err := provider.Groups(context.Background(), newParameters(ptr.To(customer)))
+	require.NoError(t, err)
+	require.Equal(t, []string{"admins@foo.com", "developers@foo.com"}, groups)
+}
+
+// TestGroupsUnconfigured checks group lookup is skipped without a customer ID.
+func TestGroupsUnconfigured(t *testing.T) {
+	t.Parallel()
+
+	provider := &Provider{
+		cloudIdentityAPIBase: newCloudIdentity(t).URL,
+	}
+
+	_, err := provider.Groups(context.Background(), newParameters(nil))
+	require.ErrorIs(t, err, providererrors.ErrGroupsUnsupported)
+}
+
+// TestGroupsError checks API errors are propagated.
+func TestGroupsError(t *testing.T) {
+	t.Parallel()
+
+	provider := &Provider{
+		cloudIdentityAPIBase: newCloudIdentity(t).URL,
+	}
+
+	parameters := newParameters(ptr.To(customer))
+	parameters.Token.AccessToken = "wrong"
+
+	_, err := provider.Groups(context.Background(), parameters)
+	require.ErrorIs(t, err, providererrors.ErrUnexpectedStatusCode)
+}
+
+// TestGroupsForbidden checks a lack of permission denies access.
+func TestGroupsForbidden(t *testing.T) {
+	t.Parallel()
+
+	provider := &Provider{
+		cloudIdentityAPIBase: newCloudIdentity(t).URL,
+	}
+
+	parameters := newParameters(ptr.To(customer))
+	parameters.Token.AccessToken = forbiddenToken
+
+	_, err := provider.Groups(context.Background(), parameters)
+	require.ErrorIs(t, err, providererrors.ErrAccessDenied)
+}
@@ -32,4 +32,7 @@ type Provider interface {
 	AuthorizationURL(config *oauth2.Config, parameters *types.AuthorizationParamters) (string, error)
 	// CodeExchange exchanges a code with an oauth2 server and returns a (possibly emulated) OIDC ID token.
 	CodeExchange(ctx context.Context, parameters *types.CodeExchangeParameters) (*oauth2.Token, *oidc.IDToken, error)
+	// Groups returns the provider groups a user is a member of, returning
+	// ErrGroupsUnsupported if the provider or organization cannot support it.
+	Groups(ctx context.Context, parameters *types.GroupsParameters) ([]string, error)
 }
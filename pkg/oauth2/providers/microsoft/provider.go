@@ -18,18 +18,53 @@ package microsoft
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 
 	gooidc "github.com/coreos/go-oidc/v3/oidc"
 	"golang.org/x/oauth2"
 
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
+	providererrors "github.com/unikorn-cloud/identity/pkg/oauth2/providers/errors"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	ErrTenantMismatch = fmt.Errorf("%w: id token tenant does not match organization", providererrors.ErrAccessDenied)
 )
 
-type Provider struct{}
+const (
+	// graphAPIBase is the Microsoft Graph API endpoint.
+	graphAPIBase = "https://graph.microsoft.com/v1.0"
+
+	// groupScope allows read only access to the user's group memberships.
+	groupScope = "https://graph.microsoft.com/GroupMember.Read.All"
+)
+
+type Provider struct {
+	// graphAPIBase allows the Graph API to be replaced for testing.
+	graphAPIBase string
+}
 
 func New() *Provider {
-	return &Provider{}
+	return &Provider{
+		graphAPIBase: graphAPIBase,
+	}
+}
+
+// tenantID returns the Entra tenant ID for the organization if configured.
+func tenantID(organization *unikornv1.Organization) string {
+	if organization == nil || organization.Spec.ProviderOptions == nil || organization.Spec.ProviderOptions.Microsoft == nil || organization.Spec.ProviderOptions.Microsoft.TenantID == nil {
+		return ""
+	}
+
+	return *organization.Spec.ProviderOptions.Microsoft.TenantID
 }
 
 func (*Provider) Config(ctx context.Context, parameters *types.ConfigParameters) (*oauth2.Config, error) {
@@ -39,8 +74,15 @@ func (*Provider) Config(ctx context.Context, parameters *types.ConfigParameters)
 	// Enables refresh tokens.
 	// See https://learn.microsoft.com/en-us/entra/identity-platform/v2-oauth2-auth-code-flow.
 	// scopes := []string{"offline_access"}
+	var scopes []string
 
-	_, config, err := oidc.Config(ctx, parameters, nil)
+	// Group lookup requires additional consent, so only ask for it when
+	// the organization is configured to use it.
+	if tenantID(parameters.Organization) != "" {
+		scopes = append(scopes, groupScope)
+	}
+
+	_, config, err := oidc.Config(ctx, parameters, scopes)
 
 	return config, err
 }
@@ -57,3 +99,139 @@ func (*Provider) CodeExchange(ctx context.Context, parameters *types.CodeExchang
 
 	return oidc.CodeExchange(ctx, parameters)
 }
+
+// groupClaims are the Entra specific ID token claims that describe group membership.
+// See: https://learn.microsoft.com/en-us/entra/identity-platform/id-token-claims-reference
+//
+//nolint:tagliatelle
+type groupClaims struct {
+	// TenantID is the tenant the user belongs to.
+	TenantID string `json:"tid"`
+	// Groups are the object IDs of the user's groups, when the application is
+	// configured to emit them.
+	Groups []string `json:"groups"`
+	// HasGroups indicates the user has too many groups to emit.
+	HasGroups bool `json:"hasgroups"`
+	// ClaimNames references claims that are too large to emit, and must be
+	// looked up via the Graph API.
+	ClaimNames map[string]string `json:"_claim_names"`
+}
+
+// overage returns true when the groups claim was omitted because the user has
+// too many groups.
+func (c *groupClaims) overage() bool {
+	if c.HasGroups {
+		return true
+	}
+
+	_, ok := c.ClaimNames["groups"]
+
+	return ok
+}
+
+type group struct {
+	ID string `json:"id"`
+}
+
+//nolint:tagliatelle
+type groups struct {
+	Value    []group `json:"value"`
+	NextLink string  `json:"@odata.nextLink"`
+}
+
+func groupsPage(ctx context.Context, client *http.Client, target string) (*groups, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: group lookup returned %d", providererrors.ErrUnexpectedStatusCode, resp.StatusCode)
+	}
+
+	page := &groups{}
+
+	if err := json.NewDecoder(resp.Body).Decode(page); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// transitiveMemberOf returns the object IDs of the groups the user is a member of,
+// either directly or via nested groups, matching the ID token groups claim.
+// See: https://learn.microsoft.com/en-us/graph/api/user-list-transitivememberof
+func (p *Provider) transitiveMemberOf(ctx context.Context, token *oauth2.Token) ([]string, error) {
+	client := oauth2.NewClient(ctx, oauth2.StaticTokenSource(token))
+
+	query := url.Values{}
+	query.Set("$select", "id")
+
+	// Note the next link is absolute and includes the original query.
+	next := p.graphAPIBase + "/me/transitiveMemberOf/microsoft.graph.group?" + query.Encode()
+
+	result := []string{}
+
+	for next != "" {
+		page, err := groupsPage(ctx, client, next)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range page.Value {
+			result = append(result, page.Value[i].ID)
+		}
+
+		next = page.NextLink
+	}
+
+	return result, nil
+}
+
+// Groups returns the object IDs of the Entra security groups the user is a member of.
+// These are taken from the ID token groups claim if present, falling back to the Graph
+// API when the claim is not configured, or the user has too many groups to fit in the
+// token.
+func (p *Provider) Groups(ctx context.Context, parameters *types.GroupsParameters) ([]string, error) {
+	log := log.FromContext(ctx)
+
+	tenant := tenantID(parameters.Organization)
+	if tenant == "" {
+		return nil, providererrors.ErrGroupsUnsupported
+	}
+
+	claims := &groupClaims{}
+
+	if err := oidc.TokenClaims(parameters.Token, claims); err != nil {
+		return nil, err
+	}
+
+	// Group object IDs are only meaningful within the organization's tenant.
+	if !strings.EqualFold(claims.TenantID, tenant) {
+		return nil, fmt.Errorf("%w: got %s", ErrTenantMismatch, claims.TenantID)
+	}
+
+	if claims.Groups != nil && !claims.overage() {
+		log.Info("microsoft: using groups from id token", "user", parameters.Email, "groups", claims.Groups)
+
+		return claims.Groups, nil
+	}
+
+	log.Info("microsoft: looking up groups with graph API", "user", parameters.Email, "overage", claims.overage())
+
+	groups, err := p.transitiveMemberOf(ctx, parameters.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("microsoft: using groups from graph API", "user", parameters.Email, "groups", groups)
+
+	return groups, nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package microsoft
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/types"
+
+	"k8s.io/utils/ptr"
+)
+
+const (
+	tenant = "2f0c4a1e-4bd1-4c6e-9a8b-0b2f4d6f1a3c"
+	email  = "barry@foo.com"
+	token  = "sekret"
+)
+
+// newGraph returns a Graph API stand-in that pages group results.
+func newGraph(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Path != "/me/transitiveMemberOf/microsoft.graph.group" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		page := &groups{
+			Value: []group{{ID: "group-a"}},
+		}
+
+		if r.URL.Query().Get("$skiptoken") == "" {
+			page.NextLink = server.URL + r.URL.Path + "?$skiptoken=next"
+		} else {
+			page.Value = []group{{ID: "group-b"}}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			t.Error(err)
+		}
+	}
+
+	server = httptest.NewServer(http.HandlerFunc(handler))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// newParameters returns group lookup parameters with an unsigned ID token
+// containing the provided claims.
+func newParameters(t *testing.T, claims map[string]any) *types.GroupsParameters {
+	t.Helper()
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	idToken := "e30." + base64.RawURLEncoding.EncodeToString(payload) + ".c2ln"
+
+	oauth2Token := &oauth2.Token{
+		AccessToken: token,
+	}
+
+	return &types.GroupsParameters{
+		ConfigParameters: types.ConfigParameters{
+			Organization: &unikornv1.Organization{
+				Spec: unikornv1.OrganizationSpec{
+					ProviderOptions: &unikornv1.OrganizationProviderOptions{
+						Microsoft: &unikornv1.OrganizationProviderMicrosoftSpec{
+							TenantID: ptr.To(tenant),
+						},
+					},
+				},
+			},
+		},
+		Token: oauth2Token.WithExtra(map[string]any{"id_token": idToken}),
+		Email: email,
+	}
+}
+
+// TestGroupsClaim checks the groups claim is used when present.
+func TestGroupsClaim(t *testing.T) {
+	t.Parallel()
+
+	provider := &Provider{
+		graphAPIBase: newGraph(t).URL,
+	}
+
+	claims := map[string]any{
+		"tid":    tenant,
+		"groups": []string{"group-c"},
+	}
+
+	groups, err := provider.Groups(context.Background(), newParameters(t, claims))
+	require.NoError(t, err)
+	require.Equal(t, []string{"group-c"}, groups)
+}
+
+// TestGroupsOverage checks the Graph API is used, following pagination, when there
+// are too many groups to fit in the token.
+func TestGroupsOverage(t *testing.T) {
+	t.Parallel()
+
+	provider := &Provider{
+		graphAPIBase: newGraph(t).URL,
+	}
+
+	claims := map[string]any{
+		"tid": tenant,
+		"_claim_names": map[string]string{
+			"groups": "src1",
+		},
+	}
+
+	groups, err := provider.Groups(context.Background(), newParameters(t, claims))
+	require.NoError(t, err)
+	require.Equal(t, []string{"group-a", "group-b"}, groups)
+}
+
+// TestGroupsTenantMismatch checks users from other tenants are rejected.
+func TestGroupsTenantMismatch(t *testing.T) {
+	t.Parallel()
+
+	provider := &Provider{
+		graphAPIBase: newGraph(t).URL,
+	}
+
+	claims := map[string]any{
+		"tid":    "another-tenant",
+		"groups": []string{"group-c"},
+	}
+
+	_, err := provider.Groups(context.Background(), newParameters(t, claims))
+	require.ErrorIs(t, err, ErrTenantMismatch)
+}
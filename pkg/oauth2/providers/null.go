@@ -22,6 +22,7 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
+	providererrors "github.com/unikorn-cloud/identity/pkg/oauth2/providers/errors"
 	"github.com/unikorn-cloud/identity/pkg/oauth2/types"
 )
 
@@ -45,3 +46,7 @@ func (*nullProvider) AuthorizationURL(config *oauth2.Config, parameters *types.A
 func (*nullProvider) CodeExchange(ctx context.Context, parameters *types.CodeExchangeParameters) (*oauth2.Token, *oidc.IDToken, error) {
 	return oidc.CodeExchange(ctx, parameters)
 }
+
+func (*nullProvider) Groups(ctx context.Context, parameters *types.GroupsParameters) ([]string, error) {
+	return nil, providererrors.ErrGroupsUnsupported
+}
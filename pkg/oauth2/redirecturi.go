@@ -0,0 +1,137 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+)
+
+//nolint:gochecknoglobals
+var (
+	// subdomainLabelRegexp is what a wildcard subdomain label may match, this is
+	// a single DNS label, so cannot be used to inject additional domains.
+	subdomainLabelRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+)
+
+// clientRedirectURIs returns all exact redirect URIs registered to the client.
+func clientRedirectURIs(client *unikornv1.OAuth2Client) []string {
+	uris := slices.Clone(client.Spec.RedirectURIs)
+
+	if client.Spec.RedirectURI != "" && !slices.Contains(uris, client.Spec.RedirectURI) {
+		uris = append([]string{client.Spec.RedirectURI}, uris...)
+	}
+
+	return uris
+}
+
+// defaultRedirectURI returns the redirect URI to use when the request's redirect URI
+// cannot be trusted yet, this is the requested one if it's allowed, or the only
+// registered one.
+func defaultRedirectURI(client *unikornv1.OAuth2Client, requested string) (string, bool) {
+	if requested != "" && redirectURIAllowed(client, requested) {
+		return requested, true
+	}
+
+	uris := clientRedirectURIs(client)
+
+	if len(uris) == 1 && len(client.Spec.RedirectURIPatterns) == 0 {
+		return uris[0], true
+	}
+
+	return "", false
+}
+
+// redirectURIAllowed checks the redirect URI is registered to the client, either
+// by exact match or by one of its patterns.
+func redirectURIAllowed(client *unikornv1.OAuth2Client, uri string) bool {
+	if slices.Contains(clientRedirectURIs(client), uri) {
+		return true
+	}
+
+	for _, pattern := range client.Spec.RedirectURIPatterns {
+		if redirectURIPatternMatch(pattern, uri) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isLoopback checks whether the host is a loopback IP literal, RFC 8252 recommends
+// against using "localhost" so we don't allow it.
+func isLoopback(host string) bool {
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// redirectURIPatternMatch checks whether a URI matches a pattern.  Patterns are
+// deliberately restricted to two forms:
+//
+//   - Loopback redirects for native applications as defined by RFC 8252, where the
+//     pattern is a loopback IP literal without a port, and any port will match e.g.
+//     "http://127.0.0.1/callback".
+//   - Preview environments, where the pattern has a wildcard as its leftmost label,
+//     and that matches exactly one label e.g. "https://*.preview.acme.org/callback".
+//     This must use HTTPS, and must have at least two labels after the wildcard.
+//
+// In all cases the path must match exactly, and neither may have a query, fragment
+// or user information.
+func redirectURIPatternMatch(pattern, uri string) bool {
+	p, err := url.Parse(pattern)
+	if err != nil {
+		return false
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+
+	if p.User != nil || u.User != nil || p.RawQuery != "" || u.RawQuery != "" || p.Fragment != "" || u.Fragment != "" {
+		return false
+	}
+
+	if p.Scheme != u.Scheme || p.Path != u.Path {
+		return false
+	}
+
+	if isLoopback(p.Hostname()) {
+		return p.Scheme == "http" && p.Port() == "" && p.Hostname() == u.Hostname()
+	}
+
+	labels := strings.Split(p.Host, ".")
+
+	if p.Scheme != "https" || p.Port() != "" || u.Port() != "" || len(labels) < 3 || labels[0] != "*" || slices.Contains(labels[1:], "*") {
+		return false
+	}
+
+	suffix := "." + strings.Join(labels[1:], ".")
+
+	label, ok := strings.CutSuffix(u.Host, suffix)
+	if !ok {
+		return false
+	}
+
+	return subdomainLabelRegexp.MatchString(label)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"slices"
+	"time"
+
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/jose"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// maxPreviousRefreshTokens bounds how many superseded refresh tokens are
+	// remembered per session.  Only recent ones are of interest for the grace
+	// period, replays of older ones are detected by the family alone.
+	maxPreviousRefreshTokens = 8
+)
+
+// tokenHash returns a hash of the token, these are high entropy so
+// a simple hash is sufficient.
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// rotateRefreshToken retires the session's current refresh token, remembering
+// when it was superseded.
+func rotateRefreshToken(session *unikornv1.UserSessionSpec) {
+	if session.RefreshToken == "" {
+		return
+	}
+
+	previous := unikornv1.UserSessionRefreshToken{
+		Hash:    tokenHash(session.RefreshToken),
+		Rotated: metav1.Now(),
+	}
+
+	session.PreviousRefreshTokens = append(session.PreviousRefreshTokens, previous)
+
+	if len(session.PreviousRefreshTokens) > maxPreviousRefreshTokens {
+		session.PreviousRefreshTokens = session.PreviousRefreshTokens[len(session.PreviousRefreshTokens)-maxPreviousRefreshTokens:]
+	}
+
+	session.RefreshToken = ""
+}
+
+// previousRefreshToken looks up a superseded refresh token in the session.
+func previousRefreshToken(session *unikornv1.UserSessionSpec, token string) *unikornv1.UserSessionRefreshToken {
+	hash := tokenHash(token)
+
+	index := slices.IndexFunc(session.PreviousRefreshTokens, func(previous unikornv1.UserSessionRefreshToken) bool {
+		return previous.Hash == hash
+	})
+
+	if index < 0 {
+		return nil
+	}
+
+	return &session.PreviousRefreshTokens[index]
+}
+
+// currentTokens returns the session's current token pair.  A refresh may be part
+// way through on another request, in which case there is nothing to return yet.
+// The access token may have expired, but the refresh token is still good, and
+// the client will simply use it.
+func (a *Authenticator) currentTokens(ctx context.Context, session *unikornv1.UserSession) (*Tokens, error) {
+	if session.Spec.AccessToken == "" || session.Spec.RefreshToken == "" {
+		return nil, errors.OAuth2InvalidGrant("refresh token has been superseded")
+	}
+
+	claims := &Claims{}
+
+	if err := a.issuer.DecodeJWEToken(ctx, session.Spec.AccessToken, claims, jose.TokenTypeAccessToken); err != nil {
+		return nil, errors.OAuth2ServerError("failed to decode access token").WithError(err)
+	}
+
+	if claims.Expiry == nil {
+		return nil, errors.OAuth2ServerError("access token has no expiry")
+	}
+
+	tokens := &Tokens{
+		Expiry:       claims.Expiry.Time(),
+		AccessToken:  session.Spec.AccessToken,
+		RefreshToken: ptr.To(session.Spec.RefreshToken),
+	}
+
+	return tokens, nil
+}
+
+// refreshTokenReuse handles a refresh token that isn't the session's current one.
+// If it's from an earlier login it's simply rejected.  If it's a superseded token
+// from the current family, then either the legitimate client or an attacker has a
+// stolen copy, and we cannot tell which, so the whole session is revoked.  Tokens
+// superseded within the grace period are most likely concurrent refreshes from the
+// same client, so get the tokens the winning refresh was issued.
+func (a *Authenticator) refreshTokenReuse(ctx context.Context, user *unikornv1.User, session *unikornv1.UserSession, token string, claims *RefreshTokenClaims) (*Tokens, error) {
+	if claims.FamilyID == "" || claims.FamilyID != session.Spec.RefreshTokenFamily {
+		return nil, errors.OAuth2InvalidGrant("refresh token reuse")
+	}
+
+	if previous := previousRefreshToken(&session.Spec, token); previous != nil && time.Since(previous.Rotated.Time) < a.options.RefreshTokenReuseGracePeriod {
+		return a.currentTokens(ctx, session)
+	}
+
+	log.FromContext(ctx).Info("security", "event", "refresh_token_reuse", "user", user.Name, "client", session.Spec.ClientID, "family", claims.FamilyID)
+
+	if err := a.deleteSession(ctx, session); err != nil {
+		return nil, errors.OAuth2ServerError("failed to revoke user session").WithError(err)
+	}
+
+	return nil, errors.OAuth2InvalidGrant("refresh token reuse, session revoked")
+}
@@ -0,0 +1,516 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	gojose "github.com/go-jose/go-jose/v3"
+	"github.com/google/uuid"
+
+	"github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/conversion"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/core/pkg/util/retry"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/jose"
+	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
+	"github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/rbac"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+)
+
+// RegistrationAccessTokenClaims authorize management of a dynamically registered
+// client as defined by RFC 7592.
+type RegistrationAccessTokenClaims struct {
+	// ID must match the client's current registration, this allows tokens
+	// to be invalidated.
+	ID string `json:"jti"`
+	// ClientID is the client the token is valid for.
+	ClientID string `json:"cid"`
+}
+
+// bearerToken returns the bearer token from the authorization header.
+func bearerToken(r *http.Request) (string, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return "", errors.OAuth2AccessDenied("authorization header missing")
+	}
+
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return "", errors.OAuth2AccessDenied("authorization scheme not allowed")
+	}
+
+	return token, nil
+}
+
+// registrationInitialAccessToken returns the initial access token if one is configured.
+func (a *Authenticator) registrationInitialAccessToken() (string, error) {
+	if a.options.RegistrationInitialAccessTokenFile == "" {
+		return "", nil
+	}
+
+	data, err := os.ReadFile(a.options.RegistrationInitialAccessTokenFile)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+// registrationAuthorize checks the caller is allowed to register clients, either
+// with the initial access token, or with an access token that grants permission
+// to create oauth2 clients.  It returns the actor for auditing purposes.
+func (a *Authenticator) registrationAuthorize(r *http.Request) (string, error) {
+	token, err := bearerToken(r)
+	if err != nil {
+		return "", err
+	}
+
+	initialAccessToken, err := a.registrationInitialAccessToken()
+	if err != nil {
+		return "", errors.OAuth2ServerError("failed to read initial access token").WithError(err)
+	}
+
+	if initialAccessToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(initialAccessToken)) == 1 {
+		return "", nil
+	}
+
+	userinfo, claims, err := a.GetUserinfo(r.Context(), r, token)
+	if err != nil {
+		return "", err
+	}
+
+	info := &authorization.Info{
+		Token:    token,
+		Userinfo: userinfo,
+	}
+
+	// System accounts and delegated tokens are bound to certificates that we
+	// cannot verify here, so are not permitted.
+	switch {
+	case claims.Actor != nil:
+		return "", errors.OAuth2AccessDenied("delegated tokens cannot register clients")
+	case claims.Type == TokenTypeFederated:
+		info.ClientID = claims.Federated.ClientID
+	case claims.Type == TokenTypeServiceAccount:
+		info.ServiceAccount = true
+	default:
+		return "", errors.OAuth2AccessDenied("token type cannot register clients")
+	}
+
+	ctx := authorization.NewContext(r.Context(), info)
+
+	acl, err := a.rbac.GetACL(ctx, "")
+	if err != nil {
+		return "", errors.OAuth2ServerError("failed to get ACL").WithError(err)
+	}
+
+	ctx = rbac.NewContext(ctx, acl)
+
+	if err := rbac.AllowGlobalScope(ctx, "identity:oauth2clients", openapi.Create); err != nil {
+		return "", err
+	}
+
+	return userinfo.Sub, nil
+}
+
+// parseRegistrationURI parses a URI supplied by an unauthenticated client, it must be
+// absolute, and cannot contain credentials or a fragment.
+func parseRegistrationURI(uri string) (*url.URL, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || !u.IsAbs() || u.Hostname() == "" || u.User != nil || u.Fragment != "" {
+		return nil, false
+	}
+
+	return u, true
+}
+
+// redirectURIValid checks a redirect URI is https, or http to a loopback IP literal
+// for native applications as defined by RFC 8252.
+func redirectURIValid(uri string) bool {
+	u, ok := parseRegistrationURI(uri)
+	if !ok {
+		return false
+	}
+
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		return isLoopback(u.Hostname())
+	}
+
+	return false
+}
+
+// serverURIValid checks a URI that this server will make requests to.  This must be
+// https, and cannot refer to a local or private address, otherwise clients could use
+// us to probe the internal network.  Host names are not resolved, so this doesn't
+// prevent DNS names resolving to private addresses, egress policy must be used for
+// that.
+func serverURIValid(uri string) bool {
+	u, ok := parseRegistrationURI(uri)
+	if !ok || u.Scheme != "https" {
+		return false
+	}
+
+	host := u.Hostname()
+
+	if host == "localhost" || isLoopback(host) {
+		return false
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// registrationValidate checks the client metadata is valid and supported.
+//
+//nolint:cyclop
+func registrationValidate(metadata *openapi.ClientMetadata) error {
+	if len(metadata.RedirectUris) == 0 {
+		return newProtocolError(http.StatusBadRequest, ErrorInvalidRedirectURI, "at least one redirect URI must be specified")
+	}
+
+	uris := slices.Clone(metadata.RedirectUris)
+
+	if metadata.PostLogoutRedirectUris != nil {
+		uris = append(uris, *metadata.PostLogoutRedirectUris...)
+	}
+
+	for _, uri := range uris {
+		if !redirectURIValid(uri) {
+			return newProtocolError(http.StatusBadRequest, ErrorInvalidRedirectURI, fmt.Sprintf("redirect URI %s is invalid", uri))
+		}
+	}
+
+	if metadata.BackchannelLogoutUri != nil && !serverURIValid(*metadata.BackchannelLogoutUri) {
+		return newProtocolError(http.StatusBadRequest, ErrorInvalidClientMetadata, "back-channel logout URI must be an absolute https URI")
+	}
+
+	if metadata.Jwks != nil && metadata.JwksUri != nil {
+		return newProtocolError(http.StatusBadRequest, ErrorInvalidClientMetadata, "jwks and jwks_uri are mutually exclusive")
+	}
+
+	if metadata.Jwks != nil {
+		data, err := json.Marshal(*metadata.Jwks)
+		if err != nil {
+			return newProtocolError(http.StatusBadRequest, ErrorInvalidClientMetadata, "jwks is invalid")
+		}
+
+		if err := json.Unmarshal(data, &gojose.JSONWebKeySet{}); err != nil {
+			return newProtocolError(http.StatusBadRequest, ErrorInvalidClientMetadata, "jwks is invalid")
+		}
+	}
+
+	if metadata.JwksUri != nil && !serverURIValid(*metadata.JwksUri) {
+		return newProtocolError(http.StatusBadRequest, ErrorInvalidClientMetadata, "jwks_uri must be an absolute https URI")
+	}
+
+	if metadata.ClientName != nil {
+		if errs := validation.IsValidLabelValue(*metadata.ClientName); len(errs) != 0 {
+			return newProtocolError(http.StatusBadRequest, ErrorInvalidClientMetadata, "client name is invalid: "+strings.Join(errs, ", "))
+		}
+	}
+
+	if metadata.TokenEndpointAuthMethod != nil {
+		switch *metadata.TokenEndpointAuthMethod {
+		case openapi.ClientSecretBasic, openapi.ClientSecretPost, openapi.ClientSecretJwt:
+		case openapi.PrivateKeyJwt:
+			if metadata.Jwks == nil && metadata.JwksUri == nil {
+				return newProtocolError(http.StatusBadRequest, ErrorInvalidClientMetadata, "private_key_jwt authentication requires jwks or jwks_uri")
+			}
+		default:
+			return newProtocolError(http.StatusBadRequest, ErrorInvalidClientMetadata, "token endpoint authentication method is not supported")
+		}
+	}
+
+	return nil
+}
+
+// registrationApply applies the client metadata to the client specification.
+// This must be called after validation.
+func registrationApply(metadata *openapi.ClientMetadata, spec *unikornv1.OAuth2ClientSpec) {
+	spec.RedirectURI = ""
+	spec.RedirectURIs = metadata.RedirectUris
+	spec.PostLogoutRedirectURIs = nil
+	spec.BackchannelLogoutURI = metadata.BackchannelLogoutUri
+	spec.RequirePushedAuthorizationRequests = ptr.Deref(metadata.RequirePushedAuthorizationRequests, false)
+	spec.JWKS = nil
+	spec.JWKSURI = metadata.JwksUri
+	spec.TokenEndpointAuthMethod = nil
+
+	if metadata.TokenEndpointAuthMethod != nil {
+		spec.TokenEndpointAuthMethod = ptr.To(unikornv1.OAuth2ClientAuthenticationMethod(*metadata.TokenEndpointAuthMethod))
+	}
+
+	if metadata.Jwks != nil {
+		data, _ := json.Marshal(*metadata.Jwks)
+
+		spec.JWKS = ptr.To(string(data))
+	}
+
+	if metadata.PostLogoutRedirectUris != nil {
+		spec.PostLogoutRedirectURIs = *metadata.PostLogoutRedirectUris
+	}
+}
+
+// registrationClientInformation generates the client information response.
+func registrationClientInformation(r *http.Request, client *unikornv1.OAuth2Client, registrationAccessToken string) *openapi.ClientInformation {
+	result := &openapi.ClientInformation{
+		ClientId:                client.Name,
+		ClientIdIssuedAt:        ptr.To(int(client.CreationTimestamp.Unix())),
+		RegistrationAccessToken: registrationAccessToken,
+		RegistrationClientUri:   "https://" + r.Host + "/oauth2/v2/register/" + client.Name,
+		RedirectUris:            clientRedirectURIs(client),
+		BackchannelLogoutUri:    client.Spec.BackchannelLogoutURI,
+		TokenEndpointAuthMethod: openapi.ClientSecretBasic,
+	}
+
+	if client.Spec.TokenEndpointAuthMethod != nil {
+		result.TokenEndpointAuthMethod = openapi.AuthMethod(*client.Spec.TokenEndpointAuthMethod)
+	}
+
+	if client.Spec.RequirePushedAuthorizationRequests {
+		result.RequirePushedAuthorizationRequests = ptr.To(true)
+	}
+
+	result.JwksUri = client.Spec.JWKSURI
+
+	if client.Spec.JWKS != nil {
+		var jwks map[string]interface{}
+
+		if err := json.Unmarshal([]byte(*client.Spec.JWKS), &jwks); err == nil {
+			result.Jwks = &jwks
+		}
+	}
+
+	if name, ok := client.Labels[constants.NameLabel]; ok {
+		result.ClientName = ptr.To(name)
+	}
+
+	if len(client.Spec.PostLogoutRedirectURIs) != 0 {
+		result.PostLogoutRedirectUris = ptr.To(client.Spec.PostLogoutRedirectURIs)
+	}
+
+	return result
+}
+
+// waitForSecret waits for the provisioner to generate the client secret, and
+// returns it, this is the only time the secret is returned.
+func (a *Authenticator) waitForSecret(ctx context.Context, id string) (*unikornv1.OAuth2Client, string, error) {
+	var client *unikornv1.OAuth2Client
+
+	var secret string
+
+	secretReady := func() error {
+		c, err := a.lookupClient(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if c.Status.SecretHash == "" {
+			return fmt.Errorf("%w: client secret not generated", ErrReference)
+		}
+
+		s, err := ClientSecret(ctx, a.client, c)
+		if err != nil {
+			return err
+		}
+
+		client = c
+		secret = s
+
+		return nil
+	}
+
+	retryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err := retry.Forever().DoWithContext(retryCtx, secretReady); err != nil {
+		return nil, "", err
+	}
+
+	return client, secret, nil
+}
+
+// Register implements RFC 7591 dynamic client registration.
+func (a *Authenticator) Register(r *http.Request, metadata *openapi.ClientMetadata) (*openapi.ClientInformation, error) {
+	ctx := r.Context()
+
+	actor, err := a.registrationAuthorize(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := registrationValidate(metadata); err != nil {
+		return nil, err
+	}
+
+	name := "registered"
+
+	if metadata.ClientName != nil {
+		name = *metadata.ClientName
+	}
+
+	claims := &RegistrationAccessTokenClaims{
+		ID: uuid.New().String(),
+	}
+
+	client := &unikornv1.OAuth2Client{
+		ObjectMeta: conversion.NewObjectMetadata(&coreapi.ResourceWriteMetadata{Name: name}, a.namespace, actor).Get(),
+		Spec: unikornv1.OAuth2ClientSpec{
+			Registration: &unikornv1.OAuth2ClientRegistration{
+				AccessTokenID: claims.ID,
+			},
+		},
+	}
+
+	registrationApply(metadata, &client.Spec)
+
+	claims.ClientID = client.Name
+
+	registrationAccessToken, err := a.issuer.EncodeJWEToken(ctx, claims, jose.TokenTypeRegistrationAccessToken)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to create registration access token").WithError(err)
+	}
+
+	if err := a.client.Create(ctx, client); err != nil {
+		return nil, errors.OAuth2ServerError("failed to create client").WithError(err)
+	}
+
+	client, secret, err := a.waitForSecret(ctx, client.Name)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to provision client secret in time").WithError(err)
+	}
+
+	result := registrationClientInformation(r, client, registrationAccessToken)
+	result.ClientSecret = ptr.To(secret)
+
+	return result, nil
+}
+
+// registrationClient authenticates the registration access token and returns
+// the client it manages, along with the token.  All failures are reported as
+// unauthorized to avoid leaking the existence of clients.
+func (a *Authenticator) registrationClient(r *http.Request, clientID string) (*unikornv1.OAuth2Client, string, error) {
+	token, err := bearerToken(r)
+	if err != nil {
+		return nil, "", err
+	}
+
+	claims := &RegistrationAccessTokenClaims{}
+
+	if err := a.issuer.DecodeJWEToken(r.Context(), token, claims, jose.TokenTypeRegistrationAccessToken); err != nil {
+		return nil, "", errors.OAuth2AccessDenied("registration access token is invalid").WithError(err)
+	}
+
+	if claims.ClientID != clientID {
+		return nil, "", errors.OAuth2AccessDenied("registration access token is invalid")
+	}
+
+	client, err := a.lookupClient(r.Context(), clientID)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, "", errors.OAuth2AccessDenied("registration access token is invalid").WithError(err)
+		}
+
+		return nil, "", errors.OAuth2ServerError("failed to lookup client").WithError(err)
+	}
+
+	if client.Spec.Registration == nil || subtle.ConstantTimeCompare([]byte(client.Spec.Registration.AccessTokenID), []byte(claims.ID)) != 1 {
+		return nil, "", errors.OAuth2AccessDenied("registration access token is invalid")
+	}
+
+	return client, token, nil
+}
+
+// RegistrationRead implements RFC 7592 client read.
+func (a *Authenticator) RegistrationRead(r *http.Request, clientID string) (*openapi.ClientInformation, error) {
+	client, token, err := a.registrationClient(r, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return registrationClientInformation(r, client, token), nil
+}
+
+// RegistrationUpdate implements RFC 7592 client update.  The client ID and
+// secret are immutable.
+func (a *Authenticator) RegistrationUpdate(r *http.Request, clientID string, metadata *openapi.ClientMetadata) (*openapi.ClientInformation, error) {
+	client, token, err := a.registrationClient(r, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := registrationValidate(metadata); err != nil {
+		return nil, err
+	}
+
+	updated := client.DeepCopy()
+
+	if metadata.ClientName != nil {
+		updated.Labels[constants.NameLabel] = *metadata.ClientName
+	}
+
+	registrationApply(metadata, &updated.Spec)
+
+	if err := a.client.Update(r.Context(), updated); err != nil {
+		return nil, errors.OAuth2ServerError("failed to update client").WithError(err)
+	}
+
+	return registrationClientInformation(r, updated, token), nil
+}
+
+// RegistrationDelete implements RFC 7592 client delete.
+func (a *Authenticator) RegistrationDelete(r *http.Request, clientID string) error {
+	client, _, err := a.registrationClient(r, clientID)
+	if err != nil {
+		return err
+	}
+
+	if err := a.client.Delete(r.Context(), client); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.OAuth2ServerError("failed to delete client").WithError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,352 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/unikorn-cloud/core/pkg/constants"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/openapi"
+
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// revocationFeedSize bounds how many revocations are remembered, consumers
+	// that fall further behind than this must discard their caches.
+	revocationFeedSize = 4096
+)
+
+// revocationEvent records either all tokens for a subject, or a single
+// token being revoked.
+type revocationEvent struct {
+	time      time.Time
+	subject   string
+	tokenHash string
+}
+
+// revocationFeed records recent revocations so remote token caches can
+// evict them.  Every replica watches the same resources, so they all
+// publish the same revocations.
+type revocationFeed struct {
+	lock sync.Mutex
+	// start is the time from which the feed is complete.
+	start time.Time
+	// events are the recent revocations, in time order.
+	events []revocationEvent
+}
+
+func newRevocationFeed() *revocationFeed {
+	return &revocationFeed{
+		start: time.Now(),
+	}
+}
+
+func (f *revocationFeed) add(event revocationEvent) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.events = append(f.events, event)
+
+	if len(f.events) > revocationFeedSize {
+		f.start = f.events[0].time
+		f.events = f.events[1:]
+	}
+}
+
+func (f *revocationFeed) since(t time.Time) *openapi.Revocations {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	result := &openapi.Revocations{
+		Time:   time.Now(),
+		Events: openapi.RevocationEvents{},
+	}
+
+	// The feed is incomplete, but it is from the start time onward, so only
+	// tokens cached before then need discarding, this keeps a new replica from
+	// flushing every remote cache.
+	if !t.After(f.start) {
+		result.Reset = true
+		result.Start = ptr.To(f.start)
+
+		return result
+	}
+
+	for i := range f.events {
+		event := &f.events[i]
+
+		if event.time.Before(t) {
+			continue
+		}
+
+		out := openapi.RevocationEvent{
+			Time: event.time,
+		}
+
+		if event.subject != "" {
+			out.Subject = ptr.To(event.subject)
+		}
+
+		if event.tokenHash != "" {
+			out.TokenHash = ptr.To(event.tokenHash)
+		}
+
+		result.Events = append(result.Events, out)
+	}
+
+	return result
+}
+
+// Revocations returns tokens revoked at or after the given time, so remote token
+// caches can evict them.
+func (a *Authenticator) Revocations(since time.Time) *openapi.Revocations {
+	return a.revocations.since(since)
+}
+
+// invalidate evicts cached token verifications that match the predicate.
+func (a *Authenticator) invalidate(predicate func(*Claims) bool) {
+	for _, key := range a.tokenCache.Keys() {
+		value, ok := a.tokenCache.Get(key)
+		if !ok {
+			continue
+		}
+
+		if claims, ok := value.(*Claims); ok && predicate(claims) {
+			a.tokenCache.Remove(key)
+		}
+	}
+}
+
+// revokeCachedToken evicts a single token and publishes the revocation.
+func (a *Authenticator) revokeCachedToken(token string) {
+	if token == "" {
+		return
+	}
+
+	a.tokenCache.Remove(token)
+
+	a.revocations.add(revocationEvent{
+		time:      time.Now(),
+		tokenHash: tokenHash(token),
+	})
+}
+
+// revokeCachedUser evicts all of a user's tokens and publishes the revocation.
+func (a *Authenticator) revokeCachedUser(user *unikornv1.User) {
+	a.invalidate(func(claims *Claims) bool {
+		return claims.Type == TokenTypeFederated && claims.Federated.UserID == user.Name
+	})
+
+	a.revocations.add(revocationEvent{
+		time:    time.Now(),
+		subject: user.Spec.Subject,
+	})
+}
+
+// revokeCachedUserID looks up the user before revoking their cached tokens.
+func (a *Authenticator) revokeCachedUserID(ctx context.Context, userID string) {
+	user := &unikornv1.User{}
+
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: a.namespace, Name: userID}, user); err != nil {
+		log.FromContext(ctx).Info("oauth2: failed to get user for token revocation", "user", userID, "error", err)
+
+		return
+	}
+
+	a.revokeCachedUser(user)
+}
+
+// revokeCachedOrganizationUser revokes the cached tokens of the user an organization
+// user refers to.
+func (a *Authenticator) revokeCachedOrganizationUser(ctx context.Context, namespace, name string) {
+	organizationUser := &unikornv1.OrganizationUser{}
+
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, organizationUser); err != nil {
+		log.FromContext(ctx).Info("oauth2: failed to get organization user for token revocation", "user", name, "error", err)
+
+		return
+	}
+
+	a.revokeCachedUserID(ctx, organizationUser.Labels[constants.UserLabel])
+}
+
+// revokeCachedServiceAccount evicts a service account's tokens and publishes the
+// revocation.
+func (a *Authenticator) revokeCachedServiceAccount(serviceAccountID string) {
+	a.invalidate(func(claims *Claims) bool {
+		return claims.Type == TokenTypeServiceAccount && claims.Subject == serviceAccountID
+	})
+
+	a.revocations.add(revocationEvent{
+		time:    time.Now(),
+		subject: serviceAccountID,
+	})
+}
+
+// resourceEventHandler calls the update function when a resource is updated or
+// deleted, creation cannot affect any existing tokens.
+func resourceEventHandler[T client.Object](update func(oldObj, newObj T), deleted func(obj T)) toolscache.ResourceEventHandler {
+	return toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj any) {
+			o, ok := oldObj.(T)
+			if !ok {
+				return
+			}
+
+			n, ok := newObj.(T)
+			if !ok {
+				return
+			}
+
+			update(o, n)
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+
+			o, ok := obj.(T)
+			if !ok {
+				return
+			}
+
+			deleted(o)
+		},
+	}
+}
+
+// specChanged filters out status updates.
+func specChanged(oldObj, newObj client.Object) bool {
+	return oldObj.GetGeneration() != newObj.GetGeneration()
+}
+
+// WatchResources evicts cached token verifications when the resources they depend
+// upon change, and publishes the revocations for remote token caches.  Changes may
+// be made by any replica, so this keeps them all consistent.
+func (a *Authenticator) WatchResources(ctx context.Context, informers cache.Informers) error {
+	user := resourceEventHandler(
+		func(oldObj, newObj *unikornv1.User) {
+			if specChanged(oldObj, newObj) {
+				a.revokeCachedUser(newObj)
+			}
+		},
+		a.revokeCachedUser,
+	)
+
+	organizationUser := resourceEventHandler(
+		func(oldObj, newObj *unikornv1.OrganizationUser) {
+			if specChanged(oldObj, newObj) {
+				a.revokeCachedUserID(ctx, newObj.Labels[constants.UserLabel])
+			}
+		},
+		func(obj *unikornv1.OrganizationUser) {
+			a.revokeCachedUserID(ctx, obj.Labels[constants.UserLabel])
+		},
+	)
+
+	serviceAccount := resourceEventHandler(
+		func(oldObj, newObj *unikornv1.ServiceAccount) {
+			if specChanged(oldObj, newObj) {
+				a.revokeCachedServiceAccount(newObj.Name)
+			}
+		},
+		func(obj *unikornv1.ServiceAccount) {
+			a.revokeCachedServiceAccount(obj.Name)
+		},
+	)
+
+	// Both former and current members are affected by a group change.
+	groupMembers := func(groups ...*unikornv1.Group) {
+		userIDs := map[string]bool{}
+		serviceAccountIDs := map[string]bool{}
+
+		for _, group := range groups {
+			for _, id := range group.Spec.UserIDs {
+				userIDs[id] = true
+			}
+
+			for _, id := range group.Spec.ServiceAccountIDs {
+				serviceAccountIDs[id] = true
+			}
+		}
+
+		for id := range userIDs {
+			a.revokeCachedOrganizationUser(ctx, groups[0].Namespace, id)
+		}
+
+		for id := range serviceAccountIDs {
+			a.revokeCachedServiceAccount(id)
+		}
+	}
+
+	group := resourceEventHandler(
+		func(oldObj, newObj *unikornv1.Group) {
+			if specChanged(oldObj, newObj) {
+				groupMembers(oldObj, newObj)
+			}
+		},
+		func(obj *unikornv1.Group) {
+			groupMembers(obj)
+		},
+	)
+
+	// Sessions are updated on every token issue, so only revoke when the
+	// access token is replaced.
+	userSession := resourceEventHandler(
+		func(oldObj, newObj *unikornv1.UserSession) {
+			if oldObj.Spec.AccessToken != newObj.Spec.AccessToken {
+				a.revokeCachedToken(oldObj.Spec.AccessToken)
+			}
+		},
+		func(obj *unikornv1.UserSession) {
+			a.revokeCachedToken(obj.Spec.AccessToken)
+		},
+	)
+
+	handlers := []struct {
+		object  client.Object
+		handler toolscache.ResourceEventHandler
+	}{
+		{&unikornv1.User{}, user},
+		{&unikornv1.OrganizationUser{}, organizationUser},
+		{&unikornv1.ServiceAccount{}, serviceAccount},
+		{&unikornv1.Group{}, group},
+		{&unikornv1.UserSession{}, userSession},
+	}
+
+	for i := range handlers {
+		informer, err := informers.GetInformer(ctx, handlers[i].object)
+		if err != nil {
+			return err
+		}
+
+		if _, err := informer.AddEventHandler(handlers[i].handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
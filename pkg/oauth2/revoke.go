@@ -0,0 +1,189 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	goerrors "errors"
+	"net/http"
+	"slices"
+
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/jose"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/sessions"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// removeUserSession deletes a user's session for the client if it matches the predicate.
+// Deletion of the session removes both the access and refresh tokens, thus revoking
+// both at once.
+func (a *Authenticator) removeUserSession(ctx context.Context, userID, clientID string, predicate func(*unikornv1.UserSession) bool) error {
+	lookupSession := func(session *unikornv1.UserSession) bool {
+		return session.Spec.ClientID == clientID && predicate(session)
+	}
+
+	session, err := a.findSession(ctx, userID, lookupSession)
+	if err != nil {
+		if goerrors.Is(err, sessions.ErrNotFound) {
+			return nil
+		}
+
+		return errors.OAuth2ServerError("failed to lookup user session").WithError(err)
+	}
+
+	if err := a.deleteSession(ctx, session); err != nil {
+		return errors.OAuth2ServerError("failed to revoke user session").WithError(err)
+	}
+
+	return nil
+}
+
+// revokeServiceAccountToken removes the access token from the service account if it
+// is the current one, it will then fail verification.
+func (a *Authenticator) revokeServiceAccountToken(ctx context.Context, token string, claims *Claims) error {
+	organization := &unikornv1.Organization{}
+
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: a.namespace, Name: claims.ServiceAccount.OrganizationID}, organization); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.OAuth2ServerError("failed to lookup organization").WithError(err)
+	}
+
+	current := &unikornv1.ServiceAccount{}
+
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: organization.Status.Namespace, Name: claims.Subject}, current); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.OAuth2ServerError("failed to lookup service account").WithError(err)
+	}
+
+	if current.Spec.AccessToken != token {
+		return nil
+	}
+
+	updated := current.DeepCopy()
+	updated.Spec.AccessToken = ""
+
+	if err := a.client.Patch(ctx, updated, client.MergeFrom(current)); err != nil {
+		return errors.OAuth2ServerError("failed to revoke service account token").WithError(err)
+	}
+
+	a.InvalidateToken(ctx, token)
+
+	return nil
+}
+
+// revokeAccessToken attempts to revoke the token as an access token, returning
+// false if the token isn't an access token.
+func (a *Authenticator) revokeAccessToken(ctx context.Context, oauth2client *unikornv1.OAuth2Client, token string) (bool, error) {
+	claims := &Claims{}
+
+	if err := a.issuer.DecodeJWEToken(ctx, token, claims, jose.TokenTypeAccessToken); err != nil {
+		//nolint:nilerr
+		return false, nil
+	}
+
+	switch claims.Type {
+	case TokenTypeFederated:
+		if claims.Federated.ClientID != oauth2client.Name {
+			return true, errors.OAuth2UnauthorizedClient("token was not issued to the client")
+		}
+
+		predicate := func(session *unikornv1.UserSession) bool {
+			return session.Spec.AccessToken == token
+		}
+
+		return true, a.removeUserSession(ctx, claims.Federated.UserID, claims.Federated.ClientID, predicate)
+	case TokenTypeServiceAccount:
+		return true, a.revokeServiceAccountToken(ctx, token, claims)
+	}
+
+	// Service tokens have no backing state and are short lived, so there's
+	// nothing that can be done, RFC 7009 section 2.2.1 defines the error.
+	return true, newProtocolError(http.StatusBadRequest, ErrorUnsupportedTokenType, "token type cannot be revoked")
+}
+
+// revokeRefreshToken attempts to revoke the token as a refresh token, returning
+// false if the token isn't a refresh token.
+func (a *Authenticator) revokeRefreshToken(ctx context.Context, oauth2client *unikornv1.OAuth2Client, token string) (bool, error) {
+	claims := &RefreshTokenClaims{}
+
+	if err := a.issuer.DecodeJWEToken(ctx, token, claims, jose.TokenTypeRefreshToken); err != nil {
+		//nolint:nilerr
+		return false, nil
+	}
+
+	if claims.Federated.ClientID != oauth2client.Name {
+		return true, errors.OAuth2UnauthorizedClient("token was not issued to the client")
+	}
+
+	predicate := func(session *unikornv1.UserSession) bool {
+		return session.Spec.RefreshToken == token
+	}
+
+	return true, a.removeUserSession(ctx, claims.Federated.UserID, claims.Federated.ClientID, predicate)
+}
+
+// Revoke implements RFC 7009 token revocation.
+func (a *Authenticator) Revoke(w http.ResponseWriter, r *http.Request) error {
+	if err := r.ParseForm(); err != nil {
+		return errors.OAuth2InvalidRequest("failed to parse form data").WithError(err)
+	}
+
+	oauth2client, err := a.authenticateClient(r)
+	if err != nil {
+		return err
+	}
+
+	token := r.Form.Get("token")
+	if token == "" {
+		return errors.OAuth2InvalidRequest("token not specified")
+	}
+
+	revokers := []func(context.Context, *unikornv1.OAuth2Client, string) (bool, error){
+		a.revokeAccessToken,
+		a.revokeRefreshToken,
+	}
+
+	// The hint just allows us to try the right thing first.
+	if r.Form.Get("token_type_hint") == "refresh_token" {
+		slices.Reverse(revokers)
+	}
+
+	for _, revoker := range revokers {
+		ok, err := revoker(r.Context(), oauth2client, token)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			return nil
+		}
+	}
+
+	// Invalid tokens, including ones we cannot decode, are not an error
+	// as per RFC 7009 section 2.2.
+	return nil
+}
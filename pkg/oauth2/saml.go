@@ -0,0 +1,284 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	"encoding/xml"
+	goerrors "errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	gosaml "github.com/crewjam/saml"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/jose"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/providers"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/saml"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// SAMLStateCookie binds the login state to the browser across the call to
+	// the identity provider.  SAML limits the relay state to 80 bytes, so that
+	// carries the request ID rather than the state itself.
+	SAMLStateCookie = "unikorn-identity-saml"
+)
+
+var (
+	ErrSAMLMetadata = goerrors.New("saml metadata error")
+)
+
+// isSAML checks whether the provider uses SAML rather than OAuth2.
+func isSAML(provider *unikornv1.OAuth2Provider) bool {
+	return provider.Spec.Type != nil && *provider.Spec.Type == unikornv1.SAML
+}
+
+// samlServiceProvider returns a service provider for the identity provider, caching
+// its metadata as this is fetched on every login.
+func (a *Authenticator) samlServiceProvider(ctx context.Context, host string, provider *unikornv1.OAuth2Provider) (*gosaml.ServiceProvider, error) {
+	if value, ok := a.samlMetadataCache.Get(provider.Spec.Issuer); ok {
+		metadata, ok := value.(*gosaml.EntityDescriptor)
+		if !ok {
+			return nil, ErrSAMLMetadata
+		}
+
+		return saml.ServiceProvider(host, metadata), nil
+	}
+
+	metadata, err := saml.FetchMetadata(ctx, provider.Spec.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	a.samlMetadataCache.Add(provider.Spec.Issuer, metadata, time.Hour)
+
+	return saml.ServiceProvider(host, metadata), nil
+}
+
+// samlAuthenticationRequest kicks off the authentication flow with a SAML identity
+// provider using the HTTP-Redirect binding, requesting the response be returned
+// using the HTTP-POST binding.
+func (a *Authenticator) samlAuthenticationRequest(w http.ResponseWriter, r *http.Request, redirector *redirector, provider *unikornv1.OAuth2Provider, organization *unikornv1.Organization, query url.Values) {
+	sp, err := a.samlServiceProvider(r.Context(), r.Host, provider)
+	if err != nil {
+		redirector.raise(ErrorServerError, "unable to get saml metadata: "+err.Error())
+		return
+	}
+
+	location := sp.GetSSOBindingLocation(gosaml.HTTPRedirectBinding)
+	if location == "" {
+		redirector.raise(ErrorServerError, "saml provider does not support the redirect binding")
+		return
+	}
+
+	request, err := sp.MakeAuthenticationRequest(location, gosaml.HTTPRedirectBinding, gosaml.HTTPPostBinding)
+	if err != nil {
+		redirector.raise(ErrorServerError, "unable to create saml authentication request: "+err.Error())
+		return
+	}
+
+	samlState := &State{
+		OAuth2Provider: provider.Name,
+		ClientQuery:    query.Encode(),
+		SAMLRequestID:  request.ID,
+	}
+
+	if organization != nil {
+		samlState.OrganizationID = organization.Name
+	}
+
+	state, err := a.issuer.EncodeJWEToken(r.Context(), samlState, jose.TokenTypeLoginState)
+	if err != nil {
+		redirector.raise(ErrorServerError, "failed to encode saml state: "+err.Error())
+		return
+	}
+
+	url, err := request.Redirect(request.ID, sp)
+	if err != nil {
+		redirector.raise(ErrorServerError, "unable to create saml redirect: "+err.Error())
+		return
+	}
+
+	// The response is a cross site POST, so the cookie must be allowed to
+	// accompany it.
+	cookie := &http.Cookie{
+		Name:     SAMLStateCookie,
+		Path:     "/saml/acs",
+		Value:    state,
+		MaxAge:   int((10 * time.Minute).Seconds()),
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteNoneMode,
+	}
+
+	http.SetCookie(w, cookie)
+	http.Redirect(w, r, url.String(), http.StatusFound)
+}
+
+// SAMLMetadata returns the service provider metadata for registration with
+// identity providers.
+func (a *Authenticator) SAMLMetadata(w http.ResponseWriter, r *http.Request) {
+	body, err := xml.MarshalIndent(saml.Metadata(r.Host), "", "  ")
+	if err != nil {
+		htmlError(w, r, http.StatusInternalServerError, "failed to marshal saml metadata")
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/samlmetadata+xml")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(body); err != nil {
+		log.FromContext(r.Context()).Info("saml: failed to write metadata response")
+		return
+	}
+}
+
+// samlAssertionTTL is how long an assertion ID must be remembered for in order to
+// detect replays.
+func samlAssertionTTL(assertion *gosaml.Assertion) time.Duration {
+	if assertion.Conditions != nil && !assertion.Conditions.NotOnOrAfter.IsZero() {
+		return time.Until(assertion.Conditions.NotOnOrAfter) + gosaml.MaxClockSkew
+	}
+
+	return gosaml.MaxIssueDelay + gosaml.MaxClockSkew
+}
+
+// SAMLAssertionConsumer is called by the identity provider in order to return a SAML
+// response back to us.  The response and assertion signatures, audience, validity
+// and request binding are checked before the login proceeds as it would for OIDC.
+//
+//nolint:cyclop
+func (a *Authenticator) SAMLAssertionConsumer(w http.ResponseWriter, r *http.Request) {
+	log := log.FromContext(r.Context())
+
+	if err := r.ParseForm(); err != nil {
+		htmlError(w, r, http.StatusBadRequest, "form parse failure")
+		return
+	}
+
+	cookie, err := r.Cookie(SAMLStateCookie)
+	if err != nil {
+		htmlError(w, r, http.StatusBadRequest, "saml state is required")
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     SAMLStateCookie,
+		Path:     "/saml/acs",
+		MaxAge:   -1,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteNoneMode,
+	})
+
+	state := &State{}
+
+	if err := a.issuer.DecodeJWEToken(r.Context(), cookie.Value, state, jose.TokenTypeLoginState); err != nil {
+		htmlError(w, r, http.StatusBadRequest, "saml state failed to decode")
+		return
+	}
+
+	// Unsolicited responses are not accepted.
+	if state.SAMLRequestID == "" || r.Form.Get("RelayState") != state.SAMLRequestID {
+		htmlError(w, r, http.StatusBadRequest, "saml relay state mismatch")
+		return
+	}
+
+	clientQuery, err := url.ParseQuery(state.ClientQuery)
+	if err != nil {
+		htmlError(w, r, http.StatusBadRequest, "client query failed to decode")
+		return
+	}
+
+	redirector := newRedirector(w, r, clientQuery.Get("redirect_uri"), clientQuery.Get("state"))
+
+	var organization *unikornv1.Organization
+
+	if state.OrganizationID != "" {
+		organization = &unikornv1.Organization{}
+
+		if err := a.client.Get(r.Context(), client.ObjectKey{Namespace: a.namespace, Name: state.OrganizationID}, organization); err != nil {
+			redirector.raise(ErrorServerError, "failed to get organization")
+			return
+		}
+	}
+
+	provider, err := a.lookupProviderByID(r.Context(), state.OAuth2Provider, organization)
+	if err != nil {
+		redirector.raise(ErrorServerError, "failed to get oauth2 provider")
+		return
+	}
+
+	if !isSAML(provider) {
+		redirector.raise(ErrorServerError, "provider is not a saml provider")
+		return
+	}
+
+	sp, err := a.samlServiceProvider(r.Context(), r.Host, provider)
+	if err != nil {
+		redirector.raise(ErrorServerError, "unable to get saml metadata: "+err.Error())
+		return
+	}
+
+	assertion, err := sp.ParseResponse(r, []string{state.SAMLRequestID})
+	if err != nil {
+		// The public error is deliberately vague, the reason is only logged.
+		var invalid *gosaml.InvalidResponseError
+
+		if goerrors.As(err, &invalid) {
+			err = invalid.PrivateErr
+		}
+
+		log.Info("saml: response validation failed", "provider", provider.Name, "error", err)
+
+		redirector.raise(ErrorAccessDenied, "saml response validation failed")
+
+		return
+	}
+
+	if _, ok := a.samlAssertionCache.Get(assertion.ID); ok {
+		redirector.raise(ErrorAccessDenied, "saml assertion has already been used")
+		return
+	}
+
+	a.samlAssertionCache.Add(assertion.ID, nil, samlAssertionTTL(assertion))
+
+	idToken, err := saml.IDToken(provider, assertion)
+	if err != nil {
+		redirector.raise(ErrorAccessDenied, "saml attribute mapping failed: "+err.Error())
+		return
+	}
+
+	// SAML has no group lookup, but organization login restrictions still
+	// apply, and memberships synchronized by other logins need revoking.
+	sync := func(ctx context.Context, user *unikornv1.User) error {
+		parameters := &types.ConfigParameters{
+			Host:         r.Host,
+			Provider:     provider,
+			Organization: organization,
+		}
+
+		return a.syncGroups(ctx, providers.New(provider.Spec.Type), parameters, nil, user)
+	}
+
+	a.federatedLogin(w, r, redirector, state, clientQuery, idToken, sync)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package saml
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	gosaml "github.com/crewjam/saml"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/oidc"
+	providererrors "github.com/unikorn-cloud/identity/pkg/oauth2/providers/errors"
+)
+
+const (
+	// metadataMaxSize bounds the size of identity provider metadata, federation
+	// metadata can be large, but not unboundedly so.
+	metadataMaxSize = 4 << 20
+
+	// metadataTimeout bounds how long a login can be held up fetching metadata.
+	metadataTimeout = 10 * time.Second
+)
+
+var (
+	ErrMetadata = errors.New("metadata error")
+
+	ErrMissingAttribute = errors.New("required attribute missing")
+)
+
+// Default attribute names, these cover common conventions and the claim URIs
+// used by ADFS and Entra.
+//
+//nolint:gochecknoglobals
+var (
+	defaultEmailAttributes = []string{
+		"email",
+		"mail",
+		"emailAddress",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+	}
+
+	defaultNameAttributes = []string{
+		"name",
+		"displayName",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name",
+	}
+
+	defaultGivenNameAttributes = []string{
+		"givenName",
+		"firstName",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname",
+	}
+
+	defaultFamilyNameAttributes = []string{
+		"sn",
+		"surname",
+		"lastName",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname",
+	}
+)
+
+// MetadataURL is where the service provider publishes its metadata, this is also
+// used as the entity ID.
+func MetadataURL(host string) url.URL {
+	return url.URL{
+		Scheme: "https",
+		Host:   host,
+		Path:   "/saml/metadata",
+	}
+}
+
+// AssertionConsumerServiceURL is where identity providers return assertions to.
+func AssertionConsumerServiceURL(host string) url.URL {
+	return url.URL{
+		Scheme: "https",
+		Host:   host,
+		Path:   "/saml/acs",
+	}
+}
+
+// FetchMetadata returns the identity provider's metadata.  This may be wrapped in an
+// EntitiesDescriptor, in which case the first identity provider is used.
+func FetchMetadata(ctx context.Context, metadataURL string) (*gosaml.EntityDescriptor, error) {
+	ctx, cancel := context.WithTimeout(ctx, metadataTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: metadata fetch returned %d", providererrors.ErrUnexpectedStatusCode, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, metadataMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > metadataMaxSize {
+		return nil, fmt.Errorf("%w: metadata exceeds %d bytes", ErrMetadata, metadataMaxSize)
+	}
+
+	entity := &gosaml.EntityDescriptor{}
+
+	if err := xml.Unmarshal(data, entity); err == nil {
+		return entity, nil
+	}
+
+	entities := &gosaml.EntitiesDescriptor{}
+
+	if err := xml.Unmarshal(data, entities); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMetadata, err)
+	}
+
+	for i := range entities.EntityDescriptors {
+		if len(entities.EntityDescriptors[i].IDPSSODescriptors) != 0 {
+			return &entities.EntityDescriptors[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no identity provider found", ErrMetadata)
+}
+
+// ServiceProvider returns a service provider for the identity provider.  Requests
+// are not signed, and encrypted assertions are not supported, so no key is required,
+// however assertions must be signed by the identity provider.
+func ServiceProvider(host string, metadata *gosaml.EntityDescriptor) *gosaml.ServiceProvider {
+	return &gosaml.ServiceProvider{
+		MetadataURL:       MetadataURL(host),
+		AcsURL:            AssertionConsumerServiceURL(host),
+		IDPMetadata:       metadata,
+		AuthnNameIDFormat: gosaml.EmailAddressNameIDFormat,
+	}
+}
+
+// Metadata returns the service provider's metadata for registration with identity
+// providers.
+func Metadata(host string) *gosaml.EntityDescriptor {
+	return ServiceProvider(host, nil).Metadata()
+}
+
+// attributes flattens assertion attributes, these may be referred to by either
+// name or friendly name.
+func attributes(assertion *gosaml.Assertion) map[string][]string {
+	result := map[string][]string{}
+
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			for _, value := range attribute.Values {
+				result[attribute.Name] = append(result[attribute.Name], value.Value)
+
+				if attribute.FriendlyName != "" {
+					result[attribute.FriendlyName] = append(result[attribute.FriendlyName], value.Value)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// first returns the first value of the configured attribute, or the first of the
+// defaults that is present.
+func first(attributes map[string][]string, configured *string, defaults []string) string {
+	names := defaults
+
+	if configured != nil {
+		names = []string{*configured}
+	}
+
+	for _, name := range names {
+		if values := attributes[name]; len(values) != 0 {
+			return values[0]
+		}
+	}
+
+	return ""
+}
+
+// IDToken maps a validated assertion onto an ID token so SAML users can share the
+// same login flow as OIDC users.  The email address is taken from an attribute, or
+// the subject if it is an email address.
+func IDToken(provider *unikornv1.OAuth2Provider, assertion *gosaml.Assertion) (*oidc.IDToken, error) {
+	options := provider.Spec.SAML
+	if options == nil {
+		options = &unikornv1.OAuth2ProviderSAMLSpec{}
+	}
+
+	values := attributes(assertion)
+
+	idToken := &oidc.IDToken{}
+	idToken.Issuer = assertion.Issuer.Value
+
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		idToken.Subject = assertion.Subject.NameID.Value
+	}
+
+	idToken.Email.Email = first(values, options.EmailAttribute, defaultEmailAttributes)
+
+	if idToken.Email.Email == "" && options.EmailAttribute == nil && strings.Contains(idToken.Subject, "@") {
+		idToken.Email.Email = idToken.Subject
+	}
+
+	if idToken.Email.Email == "" {
+		return nil, fmt.Errorf("%w: email", ErrMissingAttribute)
+	}
+
+	// The identity provider is trusted to have verified the address, just like
+	// any other corporate OIDC provider.
+	idToken.Email.EmailVerified = true
+
+	idToken.Profile.Name = first(values, options.NameAttribute, defaultNameAttributes)
+	idToken.Profile.GivenName = first(values, options.GivenNameAttribute, defaultGivenNameAttributes)
+	idToken.Profile.FamilyName = first(values, options.FamilyNameAttribute, defaultFamilyNameAttributes)
+
+	return idToken, nil
+}
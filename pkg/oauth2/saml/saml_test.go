@@ -0,0 +1,162 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package saml_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gosaml "github.com/crewjam/saml"
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/saml"
+
+	"k8s.io/utils/ptr"
+)
+
+// metadata is typical of an ADFS federation, where the identity provider is
+// wrapped in an EntitiesDescriptor.
+const metadata = `<?xml version="1.0" encoding="utf-8"?>
+<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata">
+  <EntityDescriptor entityID="https://sp.acme.com">
+    <SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol"/>
+  </EntityDescriptor>
+  <EntityDescriptor entityID="https://adfs.acme.com/adfs/services/trust">
+    <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+      <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://adfs.acme.com/adfs/ls/"/>
+    </IDPSSODescriptor>
+  </EntityDescriptor>
+</EntitiesDescriptor>`
+
+func newAssertion(nameID string, attributes map[string]string) *gosaml.Assertion {
+	statement := gosaml.AttributeStatement{}
+
+	for name, value := range attributes {
+		statement.Attributes = append(statement.Attributes, gosaml.Attribute{
+			Name:   name,
+			Values: []gosaml.AttributeValue{{Value: value}},
+		})
+	}
+
+	return &gosaml.Assertion{
+		Issuer: gosaml.Issuer{
+			Value: "https://adfs.acme.com/adfs/services/trust",
+		},
+		Subject: &gosaml.Subject{
+			NameID: &gosaml.NameID{
+				Value: nameID,
+			},
+		},
+		AttributeStatements: []gosaml.AttributeStatement{statement},
+	}
+}
+
+// TestFetchMetadata checks the identity provider is selected from a federation.
+func TestFetchMetadata(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(metadata)); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	entity, err := saml.FetchMetadata(context.Background(), server.URL)
+	require.NoError(t, err)
+	require.Equal(t, "https://adfs.acme.com/adfs/services/trust", entity.EntityID)
+
+	sp := saml.ServiceProvider("identity.acme.com", entity)
+	require.Equal(t, "https://adfs.acme.com/adfs/ls/", sp.GetSSOBindingLocation(gosaml.HTTPRedirectBinding))
+}
+
+// TestFetchMetadataTooLarge checks unbounded metadata is rejected.
+func TestFetchMetadataTooLarge(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(metadata + strings.Repeat(" ", 5<<20))); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := saml.FetchMetadata(context.Background(), server.URL)
+	require.ErrorIs(t, err, saml.ErrMetadata)
+}
+
+// TestIDToken checks ADFS claim URIs are mapped by default.
+func TestIDToken(t *testing.T) {
+	t.Parallel()
+
+	attributes := map[string]string{
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress": "barry@acme.com",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname":    "Barry",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname":      "Chuckle",
+	}
+
+	idToken, err := saml.IDToken(&unikornv1.OAuth2Provider{}, newAssertion("ACME\\barry", attributes))
+	require.NoError(t, err)
+	require.Equal(t, "https://adfs.acme.com/adfs/services/trust", idToken.Issuer)
+	require.Equal(t, "ACME\\barry", idToken.Subject)
+	require.Equal(t, "barry@acme.com", idToken.Email.Email)
+	require.True(t, idToken.Email.EmailVerified)
+	require.Equal(t, "Barry", idToken.Profile.GivenName)
+	require.Equal(t, "Chuckle", idToken.Profile.FamilyName)
+}
+
+// TestIDTokenConfigured checks configured attributes take precedence, and the
+// subject is not used as a fallback when the email attribute is configured.
+func TestIDTokenConfigured(t *testing.T) {
+	t.Parallel()
+
+	provider := &unikornv1.OAuth2Provider{
+		Spec: unikornv1.OAuth2ProviderSpec{
+			SAML: &unikornv1.OAuth2ProviderSAMLSpec{
+				EmailAttribute: ptr.To("upn"),
+				NameAttribute:  ptr.To("cn"),
+			},
+		},
+	}
+
+	attributes := map[string]string{
+		"upn":  "barry@acme.com",
+		"mail": "barry.chuckle@acme.com",
+		"cn":   "Barry Chuckle",
+	}
+
+	idToken, err := saml.IDToken(provider, newAssertion("barry.chuckle@acme.com", attributes))
+	require.NoError(t, err)
+	require.Equal(t, "barry@acme.com", idToken.Email.Email)
+	require.Equal(t, "Barry Chuckle", idToken.Profile.Name)
+
+	_, err = saml.IDToken(provider, newAssertion("barry.chuckle@acme.com", nil))
+	require.ErrorIs(t, err, saml.ErrMissingAttribute)
+}
+
+// TestIDTokenSubject checks an email address subject is used when no attribute is present.
+func TestIDTokenSubject(t *testing.T) {
+	t.Parallel()
+
+	idToken, err := saml.IDToken(&unikornv1.OAuth2Provider{}, newAssertion("barry@acme.com", nil))
+	require.NoError(t, err)
+	require.Equal(t, "barry@acme.com", idToken.Email.Email)
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	gosaml "github.com/crewjam/saml"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/core/pkg/constants"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/jose"
+	josetesting "github.com/unikorn-cloud/identity/pkg/jose/testing"
+	"github.com/unikorn-cloud/identity/pkg/oauth2"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/saml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// newIdentityProvider creates a SAML identity provider that signs its responses, and
+// serves its metadata so it can be referenced by an OAuth2Provider.
+func newIdentityProvider(t *testing.T) *gosaml.IdentityProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: "idp.acme.com",
+		},
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+
+	idp := &gosaml.IdentityProvider{
+		Key:         key,
+		Certificate: certificate,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(idp.ServeMetadata))
+	t.Cleanup(server.Close)
+
+	metadataURL, err := url.Parse(server.URL + "/metadata")
+	require.NoError(t, err)
+
+	ssoURL, err := url.Parse(server.URL + "/sso")
+	require.NoError(t, err)
+
+	idp.MetadataURL = *metadataURL
+	idp.SSOURL = *ssoURL
+
+	return idp
+}
+
+// samlResponse creates a base64 encoded response to the request ID for the given
+// service provider entity ID, as would be posted to the assertion consumer service.
+func samlResponse(t *testing.T, idp *gosaml.IdentityProvider, requestID, entityID string, signed bool) string {
+	t.Helper()
+
+	request := &gosaml.IdpAuthnRequest{
+		IDP:         idp,
+		HTTPRequest: httptest.NewRequest(http.MethodGet, "/sso", nil),
+		Request: gosaml.AuthnRequest{
+			ID:           requestID,
+			IssueInstant: time.Now(),
+		},
+		ServiceProviderMetadata: &gosaml.EntityDescriptor{
+			EntityID: entityID,
+		},
+		SPSSODescriptor: &gosaml.SPSSODescriptor{},
+		ACSEndpoint: &gosaml.IndexedEndpoint{
+			Binding:  gosaml.HTTPPostBinding,
+			Location: "https://example.com/saml/acs",
+		},
+		Now: time.Now(),
+	}
+
+	session := &gosaml.Session{
+		ID:         "session",
+		CreateTime: time.Now(),
+		NameID:     "barry@foo.com",
+	}
+
+	require.NoError(t, gosaml.DefaultAssertionMaker{}.MakeAssertion(request, session))
+
+	if signed {
+		form, err := request.PostBinding()
+		require.NoError(t, err)
+
+		return form.SAMLResponse
+	}
+
+	response := &gosaml.Response{
+		ID:           "response",
+		InResponseTo: requestID,
+		Version:      "2.0",
+		IssueInstant: time.Now(),
+		Destination:  request.ACSEndpoint.Location,
+		Issuer: &gosaml.Issuer{
+			Value: idp.MetadataURL.String(),
+		},
+		Status: gosaml.Status{
+			StatusCode: gosaml.StatusCode{
+				Value: gosaml.StatusSuccess,
+			},
+		},
+		Assertion: request.Assertion,
+	}
+
+	data, err := xml.Marshal(response)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// TestSAMLAssertionConsumer checks responses must be signed by the identity provider,
+// be for us, be in response to a request we made, and can only be used once.
+func TestSAMLAssertionConsumer(t *testing.T) {
+	t.Parallel()
+
+	idp := newIdentityProvider(t)
+
+	provider := &unikornv1.OAuth2Provider{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "saml",
+		},
+		Spec: unikornv1.OAuth2ProviderSpec{
+			Type:   ptr.To(unikornv1.SAML),
+			Issuer: idp.MetadataURL.String(),
+		},
+	}
+
+	oauth2client := &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "client",
+		},
+		Spec: unikornv1.OAuth2ClientSpec{
+			RedirectURI: "https://foo.com/callback",
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	authenticator, issuer, cli := newAuthenticatorWithIssuer(ctx, t, newUser(), oauth2client, provider)
+
+	clientQuery := url.Values{
+		"response_type": []string{"code"},
+		"client_id":     []string{"client"},
+		"redirect_uri":  []string{"https://foo.com/callback"},
+	}
+
+	// consume posts the response as the browser would, along with the state
+	// cookie set when the authentication request was made.
+	consume := func(requestID, relayState, response string) *httptest.ResponseRecorder {
+		state := &oauth2.State{
+			OAuth2Provider: "saml",
+			ClientQuery:    clientQuery.Encode(),
+			SAMLRequestID:  requestID,
+		}
+
+		cookie, err := issuer.EncodeJWEToken(ctx, state, jose.TokenTypeLoginState)
+		require.NoError(t, err)
+
+		form := url.Values{
+			"SAMLResponse": []string{response},
+			"RelayState":   []string{relayState},
+		}
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "https://example.com/saml/acs", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.AddCookie(&http.Cookie{Name: oauth2.SAMLStateCookie, Value: cookie})
+
+		w := httptest.NewRecorder()
+
+		authenticator.SAMLAssertionConsumer(w, r)
+
+		return w
+	}
+
+	accepted := func(w *httptest.ResponseRecorder) bool {
+		return w.Code == http.StatusFound && strings.Contains(w.Header().Get("Location"), "code=")
+	}
+
+	entityID := saml.MetadataURL("example.com")
+
+	// Signed responses are accepted.
+	response := samlResponse(t, idp, "request-signed", entityID.String(), true)
+	require.True(t, accepted(consume("request-signed", "request-signed", response)))
+
+	// Assertions can only be used once.
+	w := consume("request-signed", "request-signed", response)
+	require.False(t, accepted(w))
+	require.Contains(t, w.Header().Get("Location"), "error=access_denied")
+
+	// Unsigned responses are rejected.
+	w = consume("request-unsigned", "request-unsigned", samlResponse(t, idp, "request-unsigned", entityID.String(), false))
+	require.False(t, accepted(w))
+	require.Contains(t, w.Header().Get("Location"), "error=access_denied")
+
+	// Tampered responses are rejected.
+	data, err := base64.StdEncoding.DecodeString(samlResponse(t, idp, "request-tampered", entityID.String(), true))
+	require.NoError(t, err)
+
+	tampered := strings.ReplaceAll(string(data), "barry@foo.com", "admin@foo.com")
+	require.NotEqual(t, string(data), tampered)
+
+	w = consume("request-tampered", "request-tampered", base64.StdEncoding.EncodeToString([]byte(tampered)))
+	require.False(t, accepted(w))
+	require.Contains(t, w.Header().Get("Location"), "error=access_denied")
+
+	// Responses for another service provider are rejected.
+	w = consume("request-audience", "request-audience", samlResponse(t, idp, "request-audience", "https://evil.com/saml/metadata", true))
+	require.False(t, accepted(w))
+	require.Contains(t, w.Header().Get("Location"), "error=access_denied")
+
+	// Unsolicited responses are rejected.
+	response = samlResponse(t, idp, "request-unsolicited", entityID.String(), true)
+	require.Equal(t, http.StatusBadRequest, consume("request-other", "request-unsolicited", response).Code)
+
+	// Responses to a different request are rejected.
+	w = consume("request-other", "request-other", response)
+	require.False(t, accepted(w))
+	require.Contains(t, w.Header().Get("Location"), "error=access_denied")
+
+	// Group memberships synchronized from a provider cannot be checked by other
+	// logins, so are revoked.
+	synchronized := &unikornv1.Organization{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "beta",
+		},
+		Spec: unikornv1.OrganizationSpec{
+			ProviderOptions: &unikornv1.OrganizationProviderOptions{
+				Google: &unikornv1.OrganizationProviderGoogleSpec{
+					CustomerID: ptr.To("C0123abc"),
+				},
+			},
+		},
+		Status: unikornv1.OrganizationStatus{
+			Namespace: "organization-beta",
+		},
+	}
+
+	synchronizedUser := &unikornv1.OrganizationUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "organization-beta",
+			Name:      "beta-fake",
+			Labels: map[string]string{
+				constants.OrganizationLabel: "beta",
+				constants.UserLabel:         "fake",
+			},
+		},
+	}
+
+	group := &unikornv1.Group{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "organization-beta",
+			Name:      "admins",
+		},
+		Spec: unikornv1.GroupSpec{
+			ProviderGroups: []string{"admins@foo.com"},
+			UserIDs:        []string{"beta-fake"},
+		},
+	}
+
+	require.NoError(t, cli.Create(ctx, synchronized))
+	require.NoError(t, cli.Create(ctx, synchronizedUser))
+	require.NoError(t, cli.Create(ctx, group))
+
+	require.True(t, accepted(consume("request-synchronized", "request-synchronized", samlResponse(t, idp, "request-synchronized", entityID.String(), true))))
+
+	require.NoError(t, cli.Get(ctx, client.ObjectKeyFromObject(group), group))
+	require.Empty(t, group.Spec.UserIDs)
+
+	// Members of an organization restricted to a GitHub organization cannot
+	// login any way that doesn't check that membership.
+	organization := &unikornv1.Organization{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: josetesting.Namespace,
+			Name:      "acme",
+		},
+		Spec: unikornv1.OrganizationSpec{
+			ProviderOptions: &unikornv1.OrganizationProviderOptions{
+				GitHub: &unikornv1.OrganizationProviderGitHubSpec{
+					Organization: ptr.To("acme"),
+				},
+			},
+		},
+	}
+
+	organizationUser := &unikornv1.OrganizationUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "organization-acme",
+			Name:      "acme-fake",
+			Labels: map[string]string{
+				constants.OrganizationLabel: "acme",
+				constants.UserLabel:         "fake",
+			},
+		},
+	}
+
+	require.NoError(t, cli.Create(ctx, organization))
+	require.NoError(t, cli.Create(ctx, organizationUser))
+
+	w = consume("request-restricted", "request-restricted", samlResponse(t, idp, "request-restricted", entityID.String(), true))
+	require.False(t, accepted(w))
+	require.Contains(t, w.Header().Get("Location"), "error=access_denied")
+}
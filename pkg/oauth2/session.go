@@ -0,0 +1,92 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/sessions"
+)
+
+// sourceIP returns the address of the user agent.  We are expected to sit behind
+// an ingress controller, so the first forwarded address is preferred.  This is
+// for information only, and must not be relied upon for security.
+func sourceIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		address, _, _ := strings.Cut(forwarded, ",")
+
+		return strings.TrimSpace(address)
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// findSession returns the first of the user's sessions that matches the predicate.
+func (a *Authenticator) findSession(ctx context.Context, userID string, predicate func(*unikornv1.UserSession) bool) (*unikornv1.UserSession, error) {
+	userSessions, err := a.sessions.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	index := slices.IndexFunc(userSessions, func(session unikornv1.UserSession) bool {
+		return predicate(&session)
+	})
+
+	if index < 0 {
+		return nil, sessions.ErrNotFound
+	}
+
+	return &userSessions[index], nil
+}
+
+// deleteSession deletes the session, revoking both its access and refresh tokens.
+func (a *Authenticator) deleteSession(ctx context.Context, session *unikornv1.UserSession) error {
+	if err := a.sessions.Delete(ctx, session); err != nil {
+		return err
+	}
+
+	a.InvalidateToken(ctx, session.Spec.AccessToken)
+
+	return nil
+}
+
+// ListSessions returns all of the user's sessions.
+func (a *Authenticator) ListSessions(ctx context.Context, userID string) ([]unikornv1.UserSession, error) {
+	return a.sessions.List(ctx, userID)
+}
+
+// RevokeSession revokes a single user session, identified by its resource name.
+func (a *Authenticator) RevokeSession(ctx context.Context, userID, id string) error {
+	session, err := a.findSession(ctx, userID, func(session *unikornv1.UserSession) bool {
+		return session.Name == id
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.deleteSession(ctx, session)
+}
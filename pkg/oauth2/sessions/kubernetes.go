@@ -0,0 +1,216 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessions
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/unikorn-cloud/core/pkg/constants"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Kubernetes stores sessions as UserSession resources, owned by the user so
+// they are garbage collected with it.
+type Kubernetes struct {
+	client client.Client
+	// reader is an uncached reader, used to confirm what's in the cache.
+	reader    client.Reader
+	namespace string
+
+	// migrated records users known to have no embedded sessions.  Nothing
+	// writes embedded sessions any longer, so once migrated a user stays
+	// that way, and the check can be skipped.
+	migrated sync.Map
+}
+
+// Ensure the interface is implemented.
+var _ Store = &Kubernetes{}
+
+// NewKubernetes creates a new Kubernetes session store.
+func NewKubernetes(client client.Client, reader client.Reader, namespace string) *Kubernetes {
+	return &Kubernetes{
+		client:    client,
+		reader:    reader,
+		namespace: namespace,
+	}
+}
+
+func (s *Kubernetes) getUser(ctx context.Context, userID string) (*unikornv1.User, error) {
+	user := &unikornv1.User{}
+
+	if err := s.client.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: userID}, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// newSession creates a session owned by the user.
+func newSession(user *unikornv1.User, spec *unikornv1.UserSessionSpec) *unikornv1.UserSession {
+	return &unikornv1.UserSession{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: user.Namespace,
+			Name:      uuid.New().String(),
+			Labels: map[string]string{
+				constants.UserLabel: user.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: unikornv1.SchemeGroupVersion.String(),
+					Kind:       "User",
+					Name:       user.Name,
+					UID:        user.UID,
+					Controller: ptr.To(true),
+				},
+			},
+		},
+		Spec: *spec,
+	}
+}
+
+// migrate moves sessions embedded in the user to their own resources.  The user
+// is updated first, so that a stale read cannot resurrect a revoked session, at
+// worst a session is lost and the user has to log in again.
+func (s *Kubernetes) migrate(ctx context.Context, userID string) error {
+	if _, ok := s.migrated.Load(userID); ok {
+		return nil
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if len(user.Spec.Sessions) == 0 {
+		s.migrated.Store(userID, nil)
+
+		return nil
+	}
+
+	legacy := user.Spec.Sessions
+
+	user.Spec.Sessions = nil
+
+	if err := s.client.Update(ctx, user); err != nil {
+		// Someone else got there first, or our view is stale, either way
+		// there's nothing more to do until our view is consistent.
+		if kerrors.IsConflict(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	for i := range legacy {
+		if err := s.client.Create(ctx, newSession(user, &legacy[i])); err != nil {
+			return err
+		}
+	}
+
+	s.migrated.Store(userID, nil)
+
+	return nil
+}
+
+func (s *Kubernetes) List(ctx context.Context, userID string) ([]unikornv1.UserSession, error) {
+	if err := s.migrate(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	options := &client.ListOptions{
+		Namespace: s.namespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.UserLabel: userID,
+		}),
+	}
+
+	var sessions unikornv1.UserSessionList
+
+	if err := s.client.List(ctx, &sessions, options); err != nil {
+		return nil, err
+	}
+
+	return sessions.Items, nil
+}
+
+func (s *Kubernetes) Get(ctx context.Context, userID, clientID, id string) (*unikornv1.UserSession, error) {
+	sessions, err := s.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lookup(sessions, clientID, id)
+}
+
+func (s *Kubernetes) Create(ctx context.Context, userID string, session *unikornv1.UserSession) error {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	*session = *newSession(user, &session.Spec)
+
+	return s.client.Create(ctx, session)
+}
+
+func (s *Kubernetes) Update(ctx context.Context, session *unikornv1.UserSession) error {
+	if err := s.client.Update(ctx, session); err != nil {
+		if kerrors.IsNotFound(err) {
+			return ErrNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *Kubernetes) Reload(ctx context.Context, session *unikornv1.UserSession) (*unikornv1.UserSession, error) {
+	result := &unikornv1.UserSession{}
+
+	if err := s.reader.Get(ctx, client.ObjectKeyFromObject(session), result); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Kubernetes) Delete(ctx context.Context, session *unikornv1.UserSession) error {
+	if err := s.client.Delete(ctx, session); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessions
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/unikorn-cloud/core/pkg/constants"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+)
+
+// Memory stores sessions in memory, and is intended for testing only.
+type Memory struct {
+	lock     sync.Mutex
+	sessions map[string]*unikornv1.UserSession
+}
+
+// Ensure the interface is implemented.
+var _ Store = &Memory{}
+
+// NewMemory creates a new in-memory session store.
+func NewMemory() *Memory {
+	return &Memory{
+		sessions: map[string]*unikornv1.UserSession{},
+	}
+}
+
+func (s *Memory) List(ctx context.Context, userID string) ([]unikornv1.UserSession, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var result []unikornv1.UserSession
+
+	for _, session := range s.sessions {
+		if session.Labels[constants.UserLabel] == userID {
+			result = append(result, *session.DeepCopy())
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Memory) Get(ctx context.Context, userID, clientID, id string) (*unikornv1.UserSession, error) {
+	sessions, err := s.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lookup(sessions, clientID, id)
+}
+
+func (s *Memory) Create(ctx context.Context, userID string, session *unikornv1.UserSession) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	session.Name = uuid.New().String()
+	session.Labels = map[string]string{
+		constants.UserLabel: userID,
+	}
+
+	s.sessions[session.Name] = session.DeepCopy()
+
+	return nil
+}
+
+func (s *Memory) Update(ctx context.Context, session *unikornv1.UserSession) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.sessions[session.Name]; !ok {
+		return ErrNotFound
+	}
+
+	s.sessions[session.Name] = session.DeepCopy()
+
+	return nil
+}
+
+func (s *Memory) Reload(ctx context.Context, session *unikornv1.UserSession) (*unikornv1.UserSession, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	result, ok := s.sessions[session.Name]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return result.DeepCopy(), nil
+}
+
+func (s *Memory) Delete(ctx context.Context, session *unikornv1.UserSession) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.sessions, session.Name)
+
+	return nil
+}
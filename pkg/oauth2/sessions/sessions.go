@@ -0,0 +1,60 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sessions provides storage for user sessions.  Sessions are updated on
+// every token issue and refresh, so are kept apart from user records to avoid
+// write conflicts and unbounded growth of the user.
+package sessions
+
+import (
+	"context"
+	"errors"
+
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+)
+
+var (
+	// ErrNotFound is raised when a session doesn't exist.
+	ErrNotFound = errors.New("session not found")
+)
+
+// Store persists user sessions.
+type Store interface {
+	// List returns all of the user's sessions.
+	List(ctx context.Context, userID string) ([]unikornv1.UserSession, error)
+	// Get returns the user's session with a client, with the given ID.
+	Get(ctx context.Context, userID, clientID, id string) (*unikornv1.UserSession, error)
+	// Create adds a new session for the user.
+	Create(ctx context.Context, userID string, session *unikornv1.UserSession) error
+	// Update updates an existing session.
+	Update(ctx context.Context, session *unikornv1.UserSession) error
+	// Reload re-reads a session, bypassing any caching, to confirm a possibly
+	// stale view before acting on it.
+	Reload(ctx context.Context, session *unikornv1.UserSession) (*unikornv1.UserSession, error)
+	// Delete removes a session, it's not an error if it doesn't exist.
+	Delete(ctx context.Context, session *unikornv1.UserSession) error
+}
+
+// lookup finds the client's session with the given ID.
+func lookup(sessions []unikornv1.UserSession, clientID, id string) (*unikornv1.UserSession, error) {
+	for i := range sessions {
+		if sessions[i].Spec.ClientID == clientID && sessions[i].Spec.ID == id {
+			return &sessions[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
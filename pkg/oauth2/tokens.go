@@ -29,8 +29,10 @@ import (
 
 	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/identity/pkg/jose"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/sessions"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -42,6 +44,10 @@ var (
 
 	// ErrTokenVerification is raised when token verification fails.
 	ErrTokenVerification = errors.New("failed to verify token")
+
+	// ErrSessionExpired is raised when the absolute session lifetime has
+	// elapsed.
+	ErrSessionExpired = errors.New("session expired")
 )
 
 type TokenType string
@@ -60,6 +66,8 @@ type FederatedClaims struct {
 	Provider string `json:"idp"`
 	// ClientID is the oauth2 client that the user is using.
 	ClientID string `json:"cid"`
+	// SessionID is the user session the token was issued for.
+	SessionID string `json:"sid,omitempty"`
 	// UserID is set when the token is issued to a user.
 	// TODO: this should be the subject.
 	UserID string `json:"uid"`
@@ -78,6 +86,26 @@ type ServiceClaims struct {
 	X509Thumbprint string `json:"x5t@S256,omitempty"`
 }
 
+// ActorClaims identify the party acting on behalf of the subject for tokens issued
+// by token exchange, as defined by RFC 8693.
+type ActorClaims struct {
+	// Subject is the actor's identity.
+	Subject string `json:"sub"`
+	// X509Thumbprint binds the token to the actor's certificate.
+	//nolint: tagliatelle
+	X509Thumbprint string `json:"x5t@S256,omitempty"`
+	// SubjectTokenHash binds the token to the subject token it was exchanged
+	// for, so service account token rotation also revokes delegated tokens.
+	SubjectTokenHash string `json:"sth,omitempty"`
+}
+
+// ConfirmationClaims bind a token to a proof of possession key, as defined by
+// RFC 7800.
+type ConfirmationClaims struct {
+	// JWKThumbprint binds the token to a DPoP proof key as defined by RFC 9449.
+	JWKThumbprint string `json:"jkt"`
+}
+
 // Claims is an application specific set of claims.
 // TODO: this technically isn't conformant to oauth2 in that we don't specify
 // the client_id claim, and there are probably others.
@@ -91,6 +119,10 @@ type Claims struct {
 	ServiceAccount *ServiceAccountClaims `json:"sa,omitempty"`
 	// Service is set when the type is a service.
 	Service *ServiceClaims `json:"svc,omitempty"`
+	// Actor is set when the token has been delegated to another party.
+	Actor *ActorClaims `json:"act,omitempty"`
+	// Confirmation is set when the token is sender constrained.
+	Confirmation *ConfirmationClaims `json:"cnf,omitempty"`
 }
 
 // RefreshTokenClaims is a basic set of JWT claims, plus a wrapper for the
@@ -99,6 +131,32 @@ type RefreshTokenClaims struct {
 	jwt.Claims `json:",inline"`
 	// Federated is set when the type is a federated user.
 	Federated *FederatedClaims `json:"fed,omitempty"`
+	// Confirmation is set when the token is sender constrained.
+	Confirmation *ConfirmationClaims `json:"cnf,omitempty"`
+	// SessionStart is when the user logged in, and is preserved across
+	// refresh token rotation to enforce absolute session lifetimes.
+	SessionStart *jwt.NumericDate `json:"sst,omitempty"`
+	// FamilyID identifies the chain of refresh tokens issued from a single
+	// login, and is preserved across refresh token rotation to detect replays.
+	FamilyID string `json:"fam,omitempty"`
+}
+
+// sessionStart returns when the session started, tokens issued before this was
+// recorded use the time they were issued at.
+func (c *RefreshTokenClaims) sessionStart() *time.Time {
+	start := c.SessionStart
+
+	if start == nil {
+		start = c.IssuedAt
+	}
+
+	if start == nil {
+		return nil
+	}
+
+	t := start.Time()
+
+	return &t
 }
 
 // Tokens is the set of tokens and metadata returned by a token issue.
@@ -107,6 +165,8 @@ type Tokens struct {
 	AccessToken            string
 	RefreshToken           *string
 	LastAuthenticationTime time.Time
+	// SessionID is the federated user's session the tokens belong to.
+	SessionID string
 }
 
 // IssueInfo controls how the access token is encoded.
@@ -115,6 +175,8 @@ type IssueInfo struct {
 	Issuer string
 	// Audience should be from the HTTP Host header, as only we can decipher the token.
 	Audience string
+	// TargetAudience optionally restricts the token for use with a specific service.
+	TargetAudience string
 	// Subject is the user, or service account ID, the token is valid for.  This is used
 	// for RBAC.
 	Subject string
@@ -126,68 +188,235 @@ type IssueInfo struct {
 	ServiceAccount *ServiceAccountClaims `json:"sa,omitempty"`
 	// Service is set when the type is a service.
 	Service *ServiceClaims `json:"svc,omitempty"`
+	// Actor is set when the token is being delegated to another party.
+	Actor *ActorClaims `json:"act,omitempty"`
+	// Confirmation binds the access and refresh tokens to a proof of possession key.
+	Confirmation *ConfirmationClaims `json:"cnf,omitempty"`
 	// Duration is the token lifetime.  Please note this should only be used for
-	// service account tokens that by definition need to be long lived.
+	// service account tokens that by definition need to be long lived, and
+	// delegated tokens that should be short lived.
 	Duration *time.Duration
 	// Interactive declares whether this is an interactive login
 	// or not (e.g. cookie based).
 	Interactive bool `json:"int"`
 	// AuthorizationCodeID is required when doing code exchange and records the
 	AuthorizationCodeID *string
+	// SessionStart is when the user logged in, and is only set when refreshing,
+	// otherwise a new session is started.
+	SessionStart *time.Time
+	// RefreshTokenFamily is the refresh token family, and is only set when
+	// refreshing, otherwise a new family is started.
+	RefreshTokenFamily string
+	// SourceIP is the address the user authenticated from, if known.
+	SourceIP string
+}
+
+// tokenLifetimes defines how long tokens are valid for.
+type tokenLifetimes struct {
+	// accessToken is the access token lifetime.
+	accessToken time.Duration
+	// refreshToken is the refresh token lifetime.
+	refreshToken time.Duration
+	// refreshTokenIdleTimeout optionally limits how long a refresh token
+	// is valid for without being used.
+	refreshTokenIdleTimeout *time.Duration
+	// sessionEnd optionally limits all tokens to the absolute session lifetime.
+	sessionEnd *time.Time
+}
+
+// lifetimes returns the token lifetimes for the token, these are the defaults
+// defined by the authenticator, overridden by the client's policy for federated
+// user tokens.
+func (a *Authenticator) lifetimes(ctx context.Context, now time.Time, info *IssueInfo) (*tokenLifetimes, error) {
+	lifetimes := &tokenLifetimes{
+		accessToken:  a.options.AccessTokenDuration,
+		refreshToken: a.options.RefreshTokenDuration,
+	}
+
+	if info.Federated == nil {
+		return lifetimes, nil
+	}
+
+	client, err := a.lookupClient(ctx, info.Federated.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.Spec.AccessTokenLifetime != nil {
+		lifetimes.accessToken = client.Spec.AccessTokenLifetime.Duration
+	}
+
+	// Refresh tokens cannot outlive the signing keys.
+	if client.Spec.RefreshTokenLifetime != nil {
+		lifetimes.refreshToken = min(lifetimes.refreshToken, client.Spec.RefreshTokenLifetime.Duration)
+	}
+
+	if client.Spec.RefreshTokenIdleTimeout != nil {
+		lifetimes.refreshTokenIdleTimeout = &client.Spec.RefreshTokenIdleTimeout.Duration
+	}
+
+	if client.Spec.AbsoluteSessionLifetime != nil {
+		start := now
+
+		if info.SessionStart != nil {
+			start = *info.SessionStart
+		}
+
+		end := start.Add(client.Spec.AbsoluteSessionLifetime.Duration)
+
+		lifetimes.sessionEnd = &end
+	}
+
+	return lifetimes, nil
 }
 
 // expiry calculates when the token should expire.  By default we use the duration
-// defined by the authenticator.  If the token is federated, we pick the lower of
-// the default, and the expiry time of the federated acces token.  If the token
-// is for a service account, these need to be long lived for automation, so we can
-// override the default for this only.
-func (a *Authenticator) expiry(now time.Time, info *IssueInfo) time.Time {
+// defined by the client, or authenticator.  If the token is for a service account,
+// these need to be long lived for automation, so we can override the default for
+// this only.  Tokens never outlive the absolute session lifetime.
+func (a *Authenticator) expiry(now time.Time, info *IssueInfo, lifetimes *tokenLifetimes) time.Time {
+	expiry := now.Add(lifetimes.accessToken)
+
 	if info.Duration != nil {
-		return now.Add(*info.Duration)
+		expiry = now.Add(*info.Duration)
+	}
+
+	if lifetimes.sessionEnd != nil && lifetimes.sessionEnd.Before(expiry) {
+		expiry = *lifetimes.sessionEnd
+	}
+
+	return expiry
+}
+
+// refreshExpiry calculates when the refresh token should expire.  This is the
+// lower of the refresh token lifetime, the idle timeout, as a new refresh token is
+// issued on each use, and the absolute session lifetime.
+func (a *Authenticator) refreshExpiry(now time.Time, lifetimes *tokenLifetimes) time.Time {
+	expiry := now.Add(lifetimes.refreshToken)
+
+	if lifetimes.refreshTokenIdleTimeout != nil && *lifetimes.refreshTokenIdleTimeout < lifetimes.refreshToken {
+		expiry = now.Add(*lifetimes.refreshTokenIdleTimeout)
+	}
+
+	if lifetimes.sessionEnd != nil && lifetimes.sessionEnd.Before(expiry) {
+		expiry = *lifetimes.sessionEnd
+	}
+
+	return expiry
+}
+
+// evictSessions removes the oldest sessions for a client so that a new one can be
+// added without exceeding the maximum number of sessions per client.
+func (a *Authenticator) evictSessions(ctx context.Context, userID, clientID string) error {
+	if a.options.MaxSessionsPerClient <= 0 {
+		return nil
+	}
+
+	userSessions, err := a.sessions.List(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	userSessions = slices.DeleteFunc(userSessions, func(session unikornv1.UserSession) bool {
+		return session.Spec.ClientID != clientID
+	})
+
+	// Sessions without a creation time predate it, so are the oldest.
+	slices.SortStableFunc(userSessions, func(a, b unikornv1.UserSession) int {
+		switch {
+		case a.Spec.Created == nil && b.Spec.Created == nil:
+			return 0
+		case a.Spec.Created == nil:
+			return -1
+		case b.Spec.Created == nil:
+			return 1
+		}
+
+		return a.Spec.Created.Compare(b.Spec.Created.Time)
+	})
+
+	for i := 0; i <= len(userSessions)-a.options.MaxSessionsPerClient; i++ {
+		if err := a.deleteSession(ctx, &userSessions[i]); err != nil {
+			return err
+		}
 	}
 
-	return now.Add(a.options.AccessTokenDuration)
+	return nil
 }
 
-// updateSession updates the user record to indicate the current access token and single-use refresh
-// token bound to a specific client.  This ensures only a single session can be active per-client
-// at a time, tokens are automatically revoked when reissued etc.
-func (a *Authenticator) updateSession(ctx context.Context, user *unikornv1.User, info *IssueInfo, tokens *Tokens, authorizationCodeID *string) (time.Time, error) {
-	session, err := user.Session(info.Federated.ClientID)
+// updateSession updates the user session to indicate the current access token and single-use refresh
+// token.  A user may have multiple sessions per-client, up to a limit, tokens are automatically revoked
+// when reissued etc.
+//
+//nolint:cyclop
+func (a *Authenticator) updateSession(ctx context.Context, userID string, info *IssueInfo, tokens *Tokens, family string, authorizationCodeID *string) (time.Time, error) {
+	create := false
+
+	session, err := a.sessions.Get(ctx, userID, info.Federated.ClientID, info.Federated.SessionID)
 	if err != nil {
-		user.Spec.Sessions = append(user.Spec.Sessions, unikornv1.UserSession{
-			ClientID: info.Federated.ClientID,
-		})
+		if !errors.Is(err, sessions.ErrNotFound) {
+			return time.Time{}, err
+		}
+
+		if err := a.evictSessions(ctx, userID, info.Federated.ClientID); err != nil {
+			return time.Time{}, err
+		}
+
+		session = &unikornv1.UserSession{
+			Spec: unikornv1.UserSessionSpec{
+				ID:       info.Federated.SessionID,
+				ClientID: info.Federated.ClientID,
+				Created:  ptr.To(metav1.Now()),
+			},
+		}
 
-		session = &user.Spec.Sessions[len(user.Spec.Sessions)-1]
+		create = true
 	} else {
-		a.InvalidateToken(ctx, session.AccessToken)
+		a.InvalidateToken(ctx, session.Spec.AccessToken)
 	}
 
-	session.AccessToken = tokens.AccessToken
+	session.Spec.AccessToken = tokens.AccessToken
+	session.Spec.Issued = ptr.To(metav1.Now())
+	session.Spec.OAuth2Provider = info.Federated.Provider
+
+	if info.SourceIP != "" {
+		session.Spec.SourceIP = info.SourceIP
+	}
 
 	if tokens.RefreshToken != nil {
-		session.RefreshToken = *tokens.RefreshToken
+		session.Spec.RefreshToken = *tokens.RefreshToken
+	}
+
+	// A new login starts a new refresh token family, so forget the old one.
+	if session.Spec.RefreshTokenFamily != family {
+		session.Spec.RefreshTokenFamily = family
+		session.Spec.PreviousRefreshTokens = nil
 	}
 
 	if info.Interactive {
-		session.LastAuthentication = &metav1.Time{
+		session.Spec.LastAuthentication = &metav1.Time{
 			Time: time.Now(),
 		}
 	}
 
 	if authorizationCodeID != nil {
-		session.AuthorizationCodeID = *authorizationCodeID
+		session.Spec.AuthorizationCodeID = *authorizationCodeID
+	}
+
+	if create {
+		err = a.sessions.Create(ctx, userID, session)
+	} else {
+		err = a.sessions.Update(ctx, session)
 	}
 
-	if err := a.client.Update(ctx, user); err != nil {
+	if err != nil {
 		return time.Time{}, err
 	}
 
 	lastAuthenticationTime := time.Now()
 
-	if session.LastAuthentication != nil {
-		lastAuthenticationTime = session.LastAuthentication.Time
+	if session.Spec.LastAuthentication != nil {
+		lastAuthenticationTime = session.Spec.LastAuthentication.Time
 	}
 
 	return lastAuthenticationTime, nil
@@ -197,19 +426,53 @@ func (a *Authenticator) updateSession(ctx context.Context, user *unikornv1.User,
 func (a *Authenticator) Issue(ctx context.Context, info *IssueInfo) (*Tokens, error) {
 	now := time.Now()
 
-	expiry := a.expiry(now, info)
+	lifetimes, err := a.lifetimes(ctx, now, info)
+	if err != nil {
+		return nil, err
+	}
+
+	// Once the absolute session lifetime has elapsed, the user must log in again.
+	if lifetimes.sessionEnd != nil && !now.Before(*lifetimes.sessionEnd) {
+		return nil, ErrSessionExpired
+	}
+
+	expiry := a.expiry(now, info, lifetimes)
+
+	sessionStart := now
+
+	if info.SessionStart != nil {
+		sessionStart = *info.SessionStart
+	}
+
+	// New logins start a new session, whereas refreshes, silent re-authentication
+	// and delegation continue an existing one.
+	if info.Federated != nil && info.Federated.SessionID == "" && info.SessionStart == nil && info.Actor == nil {
+		federated := *info.Federated
+		federated.SessionID = uuid.New().String()
+
+		newInfo := *info
+		newInfo.Federated = &federated
+
+		info = &newInfo
+	}
 
 	nowRFC7519 := jwt.NewNumericDate(now)
 	atExpiresAtRFC7519 := jwt.NewNumericDate(expiry)
-	rtExpiresAtRFC7519 := jwt.NewNumericDate(now.Add(a.options.RefreshTokenDuration))
+	rtExpiresAtRFC7519 := jwt.NewNumericDate(a.refreshExpiry(now, lifetimes))
+
+	audience := jwt.Audience{
+		info.Audience,
+	}
+
+	if info.TargetAudience != "" && info.TargetAudience != info.Audience {
+		audience = append(audience, info.TargetAudience)
+	}
 
 	atClaims := &Claims{
 		Claims: jwt.Claims{
-			ID:      uuid.New().String(),
-			Subject: info.Subject,
-			Audience: jwt.Audience{
-				info.Audience,
-			},
+			ID:        uuid.New().String(),
+			Subject:   info.Subject,
+			Audience:  audience,
 			Issuer:    info.Issuer,
 			IssuedAt:  nowRFC7519,
 			NotBefore: nowRFC7519,
@@ -219,6 +482,8 @@ func (a *Authenticator) Issue(ctx context.Context, info *IssueInfo) (*Tokens, er
 		Federated:      info.Federated,
 		ServiceAccount: info.ServiceAccount,
 		Service:        info.Service,
+		Actor:          info.Actor,
+		Confirmation:   info.Confirmation,
 	}
 
 	at, err := a.issuer.EncodeJWEToken(ctx, atClaims, jose.TokenTypeAccessToken)
@@ -233,9 +498,16 @@ func (a *Authenticator) Issue(ctx context.Context, info *IssueInfo) (*Tokens, er
 	}
 
 	if info.Federated != nil {
-		user, err := a.getUser(ctx, info.Federated.UserID)
-		if err != nil {
-			return nil, err
+		tokens.SessionID = info.Federated.SessionID
+	}
+
+	// Delegated tokens are bound to the subject's existing session, and cannot
+	// be refreshed.
+	if info.Federated != nil && info.Actor == nil {
+		family := info.RefreshTokenFamily
+
+		if family == "" {
+			family = uuid.New().String()
 		}
 
 		rtClaims := &RefreshTokenClaims{
@@ -250,7 +522,10 @@ func (a *Authenticator) Issue(ctx context.Context, info *IssueInfo) (*Tokens, er
 				NotBefore: nowRFC7519,
 				Expiry:    rtExpiresAtRFC7519,
 			},
-			Federated: info.Federated,
+			Federated:    info.Federated,
+			Confirmation: info.Confirmation,
+			SessionStart: jwt.NewNumericDate(sessionStart),
+			FamilyID:     family,
 		}
 
 		rt, err := a.issuer.EncodeJWEToken(ctx, rtClaims, jose.TokenTypeRefreshToken)
@@ -260,7 +535,7 @@ func (a *Authenticator) Issue(ctx context.Context, info *IssueInfo) (*Tokens, er
 
 		tokens.RefreshToken = &rt
 
-		authTime, err := a.updateSession(ctx, user, info, tokens, info.AuthorizationCodeID)
+		authTime, err := a.updateSession(ctx, info.Federated.UserID, info, tokens, family, info.AuthorizationCodeID)
 		if err != nil {
 			return nil, err
 		}
@@ -299,11 +574,9 @@ func (a *Authenticator) Verify(ctx context.Context, info *VerifyInfo) (*Claims,
 		return nil, fmt.Errorf("failed to decrypt claims: %w", err)
 	}
 
-	// Verify the claims.
+	// Verify the claims.  Delegated tokens may have an additional audience, so
+	// that's checked separately.
 	expected := jwt.Expected{
-		Audience: jwt.Audience{
-			info.Audience,
-		},
 		Issuer: info.Issuer,
 		Time:   time.Now(),
 	}
@@ -312,6 +585,10 @@ func (a *Authenticator) Verify(ctx context.Context, info *VerifyInfo) (*Claims,
 		return nil, fmt.Errorf("failed to validate claims: %w", err)
 	}
 
+	if !claims.Audience.Contains(info.Audience) {
+		return nil, fmt.Errorf("%w: invalid audience", ErrTokenVerification)
+	}
+
 	if err := a.verifyServiceAccount(ctx, info, claims); err != nil {
 		return nil, err
 	}
@@ -352,6 +629,16 @@ func (a *Authenticator) verifyServiceAccount(ctx context.Context, info *VerifyIn
 		return err
 	}
 
+	// Delegated tokens are valid for as long as the service account token they
+	// were exchanged for is.
+	if claims.Actor != nil {
+		if claims.Actor.SubjectTokenHash != tokenHash(serviceAccount.Spec.AccessToken) {
+			return fmt.Errorf("%w: delegated service account token invalid", ErrTokenVerification)
+		}
+
+		return nil
+	}
+
 	if info.Token != serviceAccount.Spec.AccessToken {
 		return fmt.Errorf("%w: service account token invalid", ErrTokenVerification)
 	}
@@ -370,24 +657,26 @@ func (a *Authenticator) verifyUserSession(ctx context.Context, info *VerifyInfo,
 		return err
 	}
 
-	lookupSession := func(session unikornv1.UserSession) bool {
-		return session.ClientID == claims.Federated.ClientID
-	}
+	session, err := a.sessions.Get(ctx, user.Name, claims.Federated.ClientID, claims.Federated.SessionID)
+	if err != nil {
+		if errors.Is(err, sessions.ErrNotFound) {
+			return fmt.Errorf("%w: no active session for token", ErrTokenVerification)
+		}
 
-	index := slices.IndexFunc(user.Spec.Sessions, lookupSession)
-	if index < 0 {
-		return fmt.Errorf("%w: no active session for token", ErrTokenVerification)
+		return err
 	}
 
-	if user.Spec.Sessions[index].AccessToken != info.Token {
+	// Delegated tokens are valid for as long as the session is.
+	if claims.Actor == nil && session.Spec.AccessToken != info.Token {
 		return fmt.Errorf("%w: token invalid for active session", ErrTokenVerification)
 	}
 
 	return nil
 }
 
-// InvalidateToken immediately invalidates the token so it's unusable again.
-// TODO: this only considers caching in the identity service, it's still usable.
+// InvalidateToken evicts the token from the verification cache.  This must be called
+// after removing the token from its backing user session or service account, as it
+// is that which permanently revokes the token, and the cache would otherwise hide it.
 func (a *Authenticator) InvalidateToken(ctx context.Context, token string) {
 	a.tokenCache.Remove(token)
 }
@@ -19,6 +19,8 @@ package types
 import (
 	"net/url"
 
+	"golang.org/x/oauth2"
+
 	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 )
 
@@ -28,6 +30,9 @@ type ConfigParameters struct {
 	Host string
 	// Provider describes the oauth2 provider.
 	Provider *unikornv1.OAuth2Provider
+	// Organization is the user's organization, if known, and allows
+	// organization specific provider options to be applied.
+	Organization *unikornv1.Organization
 }
 
 // AuthorizationParamters are common parameters when starting the oauth2
@@ -63,3 +68,14 @@ type CodeExchangeParameters struct {
 	// microsoft.  OIDC only.
 	SkipIssuerCheck bool
 }
+
+// GroupsParameters are common parameters when looking up the groups a user
+// is a member of.
+type GroupsParameters struct {
+	// ConfigParameters are used to contact the authorization server.
+	ConfigParameters
+	// Token is the token returned by code exchange.
+	Token *oauth2.Token
+	// Email is the user's email address.
+	Email string
+}
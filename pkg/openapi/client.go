@@ -96,6 +96,9 @@ type ClientInterface interface {
 	// GetApiV1Acl request
 	GetApiV1Acl(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostApiV1Oauth2clientsClientIDRotate request
+	PostApiV1Oauth2clientsClientIDRotate(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApiV1Oauth2providers request
 	GetApiV1Oauth2providers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -156,6 +159,11 @@ type ClientInterface interface {
 
 	PutApiV1OrganizationsOrganizationIDOauth2providersProviderID(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithBody request with any body
+	PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithBody(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApiV1OrganizationsOrganizationIDProjects request
 	GetApiV1OrganizationsOrganizationIDProjects(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -237,6 +245,27 @@ type ClientInterface interface {
 
 	PutApiV1OrganizationsOrganizationIDUsersUserID(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, body PutApiV1OrganizationsOrganizationIDUsersUserIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions request
+	DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1OrganizationsOrganizationIDUsersUserIDSessions request
+	GetApiV1OrganizationsOrganizationIDUsersUserIDSessions(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID request
+	DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, sessionID SessionIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1Revocations request
+	GetApiV1Revocations(ctx context.Context, params *GetApiV1RevocationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1Sessions request
+	DeleteApiV1Sessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1Sessions request
+	GetApiV1Sessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1SessionsSessionID request
+	DeleteApiV1SessionsSessionID(ctx context.Context, sessionID SessionIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApiV1Signup request
 	GetApiV1Signup(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -248,6 +277,29 @@ type ClientInterface interface {
 
 	PostOauth2V2AuthorizationWithFormdataBody(ctx context.Context, body PostOauth2V2AuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetOauth2V2Device request
+	GetOauth2V2Device(ctx context.Context, params *GetOauth2V2DeviceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOauth2V2DeviceWithBody request with any body
+	PostOauth2V2DeviceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOauth2V2DeviceWithFormdataBody(ctx context.Context, body PostOauth2V2DeviceFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOauth2V2DeviceApproveWithBody request with any body
+	PostOauth2V2DeviceApproveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOauth2V2DeviceApproveWithFormdataBody(ctx context.Context, body PostOauth2V2DeviceApproveFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOauth2V2DeviceAuthorizationWithBody request with any body
+	PostOauth2V2DeviceAuthorizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOauth2V2DeviceAuthorizationWithFormdataBody(ctx context.Context, body PostOauth2V2DeviceAuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOauth2V2IntrospectWithBody request with any body
+	PostOauth2V2IntrospectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOauth2V2IntrospectWithFormdataBody(ctx context.Context, body PostOauth2V2IntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetOauth2V2Jwks request
 	GetOauth2V2Jwks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -256,11 +308,45 @@ type ClientInterface interface {
 
 	PostOauth2V2LoginWithFormdataBody(ctx context.Context, body PostOauth2V2LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetOauth2V2Logout request
+	GetOauth2V2Logout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOauth2V2LogoutWithBody request with any body
+	PostOauth2V2LogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOauth2V2LogoutWithFormdataBody(ctx context.Context, body PostOauth2V2LogoutFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostOauth2V2OnboardWithBody request with any body
 	PostOauth2V2OnboardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostOauth2V2OnboardWithFormdataBody(ctx context.Context, body PostOauth2V2OnboardFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostOauth2V2ParWithBody request with any body
+	PostOauth2V2ParWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOauth2V2ParWithFormdataBody(ctx context.Context, body PostOauth2V2ParFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOauth2V2RegisterWithBody request with any body
+	PostOauth2V2RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOauth2V2Register(ctx context.Context, body PostOauth2V2RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOauth2V2RegisterClientID request
+	DeleteOauth2V2RegisterClientID(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOauth2V2RegisterClientID request
+	GetOauth2V2RegisterClientID(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutOauth2V2RegisterClientIDWithBody request with any body
+	PutOauth2V2RegisterClientIDWithBody(ctx context.Context, clientID ClientIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutOauth2V2RegisterClientID(ctx context.Context, clientID ClientIDParameter, body PutOauth2V2RegisterClientIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOauth2V2RevokeWithBody request with any body
+	PostOauth2V2RevokeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOauth2V2RevokeWithFormdataBody(ctx context.Context, body PostOauth2V2RevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostOauth2V2TokenWithBody request with any body
 	PostOauth2V2TokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -276,6 +362,14 @@ type ClientInterface interface {
 
 	// GetOidcCallback request
 	GetOidcCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSamlAcsWithBody request with any body
+	PostSamlAcsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSamlAcsWithFormdataBody(ctx context.Context, body PostSamlAcsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSamlMetadata request
+	GetSamlMetadata(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetWellKnownOpenidConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -302,6 +396,18 @@ func (c *Client) GetApiV1Acl(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostApiV1Oauth2clientsClientIDRotate(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1Oauth2clientsClientIDRotateRequest(c.Server, clientID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetApiV1Oauth2providers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetApiV1Oauth2providersRequest(c.Server)
 	if err != nil {
@@ -566,6 +672,30 @@ func (c *Client) PutApiV1OrganizationsOrganizationIDOauth2providersProviderID(ct
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithBody(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunRequestWithBody(c.Server, organizationID, providerID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunRequest(c.Server, organizationID, providerID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetApiV1OrganizationsOrganizationIDProjects(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetApiV1OrganizationsOrganizationIDProjectsRequest(c.Server, organizationID)
 	if err != nil {
@@ -926,8 +1056,8 @@ func (c *Client) PutApiV1OrganizationsOrganizationIDUsersUserID(ctx context.Cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1Signup(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1SignupRequest(c.Server)
+func (c *Client) DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsRequest(c.Server, organizationID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -938,8 +1068,8 @@ func (c *Client) GetApiV1Signup(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOauth2V2Authorization(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOauth2V2AuthorizationRequest(c.Server)
+func (c *Client) GetApiV1OrganizationsOrganizationIDUsersUserIDSessions(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1OrganizationsOrganizationIDUsersUserIDSessionsRequest(c.Server, organizationID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -950,8 +1080,8 @@ func (c *Client) GetOauth2V2Authorization(ctx context.Context, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostOauth2V2AuthorizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOauth2V2AuthorizationRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, sessionID SessionIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDRequest(c.Server, organizationID, userID, sessionID)
 	if err != nil {
 		return nil, err
 	}
@@ -962,8 +1092,8 @@ func (c *Client) PostOauth2V2AuthorizationWithBody(ctx context.Context, contentT
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostOauth2V2AuthorizationWithFormdataBody(ctx context.Context, body PostOauth2V2AuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOauth2V2AuthorizationRequestWithFormdataBody(c.Server, body)
+func (c *Client) GetApiV1Revocations(ctx context.Context, params *GetApiV1RevocationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1RevocationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -974,8 +1104,8 @@ func (c *Client) PostOauth2V2AuthorizationWithFormdataBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOauth2V2Jwks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOauth2V2JwksRequest(c.Server)
+func (c *Client) DeleteApiV1Sessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1SessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -986,8 +1116,8 @@ func (c *Client) GetOauth2V2Jwks(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostOauth2V2LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOauth2V2LoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetApiV1Sessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1SessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -998,8 +1128,8 @@ func (c *Client) PostOauth2V2LoginWithBody(ctx context.Context, contentType stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostOauth2V2LoginWithFormdataBody(ctx context.Context, body PostOauth2V2LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOauth2V2LoginRequestWithFormdataBody(c.Server, body)
+func (c *Client) DeleteApiV1SessionsSessionID(ctx context.Context, sessionID SessionIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1SessionsSessionIDRequest(c.Server, sessionID)
 	if err != nil {
 		return nil, err
 	}
@@ -1010,8 +1140,8 @@ func (c *Client) PostOauth2V2LoginWithFormdataBody(ctx context.Context, body Pos
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostOauth2V2OnboardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOauth2V2OnboardRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetApiV1Signup(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1SignupRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1022,8 +1152,8 @@ func (c *Client) PostOauth2V2OnboardWithBody(ctx context.Context, contentType st
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostOauth2V2OnboardWithFormdataBody(ctx context.Context, body PostOauth2V2OnboardFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOauth2V2OnboardRequestWithFormdataBody(c.Server, body)
+func (c *Client) GetOauth2V2Authorization(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOauth2V2AuthorizationRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1034,8 +1164,8 @@ func (c *Client) PostOauth2V2OnboardWithFormdataBody(ctx context.Context, body P
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostOauth2V2TokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOauth2V2TokenRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostOauth2V2AuthorizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2AuthorizationRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1046,8 +1176,8 @@ func (c *Client) PostOauth2V2TokenWithBody(ctx context.Context, contentType stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostOauth2V2TokenWithFormdataBody(ctx context.Context, body PostOauth2V2TokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOauth2V2TokenRequestWithFormdataBody(c.Server, body)
+func (c *Client) PostOauth2V2AuthorizationWithFormdataBody(ctx context.Context, body PostOauth2V2AuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2AuthorizationRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1058,8 +1188,8 @@ func (c *Client) PostOauth2V2TokenWithFormdataBody(ctx context.Context, body Pos
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOauth2V2Userinfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOauth2V2UserinfoRequest(c.Server)
+func (c *Client) GetOauth2V2Device(ctx context.Context, params *GetOauth2V2DeviceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOauth2V2DeviceRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1070,8 +1200,8 @@ func (c *Client) GetOauth2V2Userinfo(ctx context.Context, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostOauth2V2UserinfoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOauth2V2UserinfoRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostOauth2V2DeviceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2DeviceRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1082,8 +1212,8 @@ func (c *Client) PostOauth2V2UserinfoWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostOauth2V2UserinfoWithFormdataBody(ctx context.Context, body PostOauth2V2UserinfoFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOauth2V2UserinfoRequestWithFormdataBody(c.Server, body)
+func (c *Client) PostOauth2V2DeviceWithFormdataBody(ctx context.Context, body PostOauth2V2DeviceFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2DeviceRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1094,8 +1224,8 @@ func (c *Client) PostOauth2V2UserinfoWithFormdataBody(ctx context.Context, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOidcCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOidcCallbackRequest(c.Server)
+func (c *Client) PostOauth2V2DeviceApproveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2DeviceApproveRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1106,308 +1236,400 @@ func (c *Client) GetOidcCallback(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
-// NewGetWellKnownOpenidConfigurationRequest generates requests for GetWellKnownOpenidConfiguration
-func NewGetWellKnownOpenidConfigurationRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostOauth2V2DeviceApproveWithFormdataBody(ctx context.Context, body PostOauth2V2DeviceApproveFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2DeviceApproveRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/.well-known/openid-configuration")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostOauth2V2DeviceAuthorizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2DeviceAuthorizationRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1AclRequest generates requests for GetApiV1Acl
-func NewGetApiV1AclRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostOauth2V2DeviceAuthorizationWithFormdataBody(ctx context.Context, body PostOauth2V2DeviceAuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2DeviceAuthorizationRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/acl")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostOauth2V2IntrospectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2IntrospectRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1Oauth2providersRequest generates requests for GetApiV1Oauth2providers
-func NewGetApiV1Oauth2providersRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostOauth2V2IntrospectWithFormdataBody(ctx context.Context, body PostOauth2V2IntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2IntrospectRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/oauth2providers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetOauth2V2Jwks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOauth2V2JwksRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1OrganizationsRequest generates requests for GetApiV1Organizations
-func NewGetApiV1OrganizationsRequest(server string, params *GetApiV1OrganizationsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostOauth2V2LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2LoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/organizations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostOauth2V2LoginWithFormdataBody(ctx context.Context, body PostOauth2V2LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2LoginRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Email != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "email", runtime.ParamLocationQuery, *params.Email); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostApiV1OrganizationsRequest calls the generic PostApiV1Organizations builder with application/json body
-func NewPostApiV1OrganizationsRequest(server string, body PostApiV1OrganizationsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetOauth2V2Logout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOauth2V2LogoutRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiV1OrganizationsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiV1OrganizationsRequestWithBody generates requests for PostApiV1Organizations with any type of body
-func NewPostApiV1OrganizationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostOauth2V2LogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2LogoutRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/organizations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostOauth2V2LogoutWithFormdataBody(ctx context.Context, body PostOauth2V2LogoutFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2LogoutRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+func (c *Client) PostOauth2V2OnboardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2OnboardRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1OrganizationsOrganizationIDRequest generates requests for GetApiV1OrganizationsOrganizationID
-func NewGetApiV1OrganizationsOrganizationIDRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+func (c *Client) PostOauth2V2OnboardWithFormdataBody(ctx context.Context, body PostOauth2V2OnboardFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2OnboardRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostOauth2V2ParWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2ParRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostOauth2V2ParWithFormdataBody(ctx context.Context, body PostOauth2V2ParFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2ParRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostOauth2V2RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2RegisterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutApiV1OrganizationsOrganizationIDRequest calls the generic PutApiV1OrganizationsOrganizationID builder with application/json body
-func NewPutApiV1OrganizationsOrganizationIDRequest(server string, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostOauth2V2Register(ctx context.Context, body PostOauth2V2RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2RegisterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutApiV1OrganizationsOrganizationIDRequestWithBody(server, organizationID, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutApiV1OrganizationsOrganizationIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationID with any type of body
-func NewPutApiV1OrganizationsOrganizationIDRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+func (c *Client) DeleteOauth2V2RegisterClientID(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOauth2V2RegisterClientIDRequest(c.Server, clientID)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOauth2V2RegisterClientID(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOauth2V2RegisterClientIDRequest(c.Server, clientID)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutOauth2V2RegisterClientIDWithBody(ctx context.Context, clientID ClientIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOauth2V2RegisterClientIDRequestWithBody(c.Server, clientID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) PutOauth2V2RegisterClientID(ctx context.Context, clientID ClientIDParameter, body PutOauth2V2RegisterClientIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOauth2V2RegisterClientIDRequest(c.Server, clientID, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1OrganizationsOrganizationIDAclRequest generates requests for GetApiV1OrganizationsOrganizationIDAcl
-func NewGetApiV1OrganizationsOrganizationIDAclRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
-	var err error
+func (c *Client) PostOauth2V2RevokeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2RevokeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) PostOauth2V2RevokeWithFormdataBody(ctx context.Context, body PostOauth2V2RevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2RevokeRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+func (c *Client) PostOauth2V2TokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2TokenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostOauth2V2TokenWithFormdataBody(ctx context.Context, body PostOauth2V2TokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2TokenRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/acl", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) GetOauth2V2Userinfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOauth2V2UserinfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostOauth2V2UserinfoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2UserinfoRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostOauth2V2UserinfoWithFormdataBody(ctx context.Context, body PostOauth2V2UserinfoFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOauth2V2UserinfoRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) GetOidcCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOidcCallbackRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1OrganizationsOrganizationIDAllocationsRequest generates requests for GetApiV1OrganizationsOrganizationIDAllocations
-func NewGetApiV1OrganizationsOrganizationIDAllocationsRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
-	var err error
+func (c *Client) PostSamlAcsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSamlAcsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) PostSamlAcsWithFormdataBody(ctx context.Context, body PostSamlAcsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSamlAcsRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+func (c *Client) GetSamlMetadata(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSamlMetadataRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetWellKnownOpenidConfigurationRequest generates requests for GetWellKnownOpenidConfiguration
+func NewGetWellKnownOpenidConfigurationRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/allocations", pathParam0)
+	operationPath := fmt.Sprintf("/.well-known/openid-configuration")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1425,23 +1647,16 @@ func NewGetApiV1OrganizationsOrganizationIDAllocationsRequest(server string, org
 	return req, nil
 }
 
-// NewGetApiV1OrganizationsOrganizationIDGroupsRequest generates requests for GetApiV1OrganizationsOrganizationIDGroups
-func NewGetApiV1OrganizationsOrganizationIDGroupsRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
+// NewGetApiV1AclRequest generates requests for GetApiV1Acl
+func NewGetApiV1AclRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/groups", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/acl")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1459,24 +1674,13 @@ func NewGetApiV1OrganizationsOrganizationIDGroupsRequest(server string, organiza
 	return req, nil
 }
 
-// NewPostApiV1OrganizationsOrganizationIDGroupsRequest calls the generic PostApiV1OrganizationsOrganizationIDGroups builder with application/json body
-func NewPostApiV1OrganizationsOrganizationIDGroupsRequest(server string, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDGroupsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiV1OrganizationsOrganizationIDGroupsRequestWithBody(server, organizationID, "application/json", bodyReader)
-}
-
-// NewPostApiV1OrganizationsOrganizationIDGroupsRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDGroups with any type of body
-func NewPostApiV1OrganizationsOrganizationIDGroupsRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostApiV1Oauth2clientsClientIDRotateRequest generates requests for PostApiV1Oauth2clientsClientIDRotate
+func NewPostApiV1Oauth2clientsClientIDRotateRequest(server string, clientID ClientIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clientID", runtime.ParamLocationPath, clientID)
 	if err != nil {
 		return nil, err
 	}
@@ -1486,7 +1690,7 @@ func NewPostApiV1OrganizationsOrganizationIDGroupsRequestWithBody(server string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/groups", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/oauth2clients/%s/rotate", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1496,40 +1700,24 @@ func NewPostApiV1OrganizationsOrganizationIDGroupsRequestWithBody(server string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteApiV1OrganizationsOrganizationIDGroupsGroupidRequest generates requests for DeleteApiV1OrganizationsOrganizationIDGroupsGroupid
-func NewDeleteApiV1OrganizationsOrganizationIDGroupsGroupidRequest(server string, organizationID OrganizationIDParameter, groupid GroupidParameter) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupid", runtime.ParamLocationPath, groupid)
-	if err != nil {
-		return nil, err
-	}
+// NewGetApiV1Oauth2providersRequest generates requests for GetApiV1Oauth2providers
+func NewGetApiV1Oauth2providersRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/groups/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/oauth2providers")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1539,7 +1727,7 @@ func NewDeleteApiV1OrganizationsOrganizationIDGroupsGroupidRequest(server string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1547,30 +1735,16 @@ func NewDeleteApiV1OrganizationsOrganizationIDGroupsGroupidRequest(server string
 	return req, nil
 }
 
-// NewGetApiV1OrganizationsOrganizationIDGroupsGroupidRequest generates requests for GetApiV1OrganizationsOrganizationIDGroupsGroupid
-func NewGetApiV1OrganizationsOrganizationIDGroupsGroupidRequest(server string, organizationID OrganizationIDParameter, groupid GroupidParameter) (*http.Request, error) {
+// NewGetApiV1OrganizationsRequest generates requests for GetApiV1Organizations
+func NewGetApiV1OrganizationsRequest(server string, params *GetApiV1OrganizationsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupid", runtime.ParamLocationPath, groupid)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/groups/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1580,6 +1754,28 @@ func NewGetApiV1OrganizationsOrganizationIDGroupsGroupidRequest(server string, o
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Email != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "email", runtime.ParamLocationQuery, *params.Email); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1588,41 +1784,27 @@ func NewGetApiV1OrganizationsOrganizationIDGroupsGroupidRequest(server string, o
 	return req, nil
 }
 
-// NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequest calls the generic PutApiV1OrganizationsOrganizationIDGroupsGroupid builder with application/json body
-func NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequest(server string, organizationID OrganizationIDParameter, groupid GroupidParameter, body PutApiV1OrganizationsOrganizationIDGroupsGroupidJSONRequestBody) (*http.Request, error) {
+// NewPostApiV1OrganizationsRequest calls the generic PostApiV1Organizations builder with application/json body
+func NewPostApiV1OrganizationsRequest(server string, body PostApiV1OrganizationsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequestWithBody(server, organizationID, groupid, "application/json", bodyReader)
+	return NewPostApiV1OrganizationsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDGroupsGroupid with any type of body
-func NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequestWithBody(server string, organizationID OrganizationIDParameter, groupid GroupidParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostApiV1OrganizationsRequestWithBody generates requests for PostApiV1Organizations with any type of body
+func NewPostApiV1OrganizationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupid", runtime.ParamLocationPath, groupid)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/groups/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1632,7 +1814,7 @@ func NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequestWithBody(server s
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1642,8 +1824,8 @@ func NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequestWithBody(server s
 	return req, nil
 }
 
-// NewGetApiV1OrganizationsOrganizationIDOauth2providersRequest generates requests for GetApiV1OrganizationsOrganizationIDOauth2providers
-func NewGetApiV1OrganizationsOrganizationIDOauth2providersRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDRequest generates requests for GetApiV1OrganizationsOrganizationID
+func NewGetApiV1OrganizationsOrganizationIDRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1658,7 +1840,7 @@ func NewGetApiV1OrganizationsOrganizationIDOauth2providersRequest(server string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/oauth2providers", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1676,19 +1858,19 @@ func NewGetApiV1OrganizationsOrganizationIDOauth2providersRequest(server string,
 	return req, nil
 }
 
-// NewPostApiV1OrganizationsOrganizationIDOauth2providersRequest calls the generic PostApiV1OrganizationsOrganizationIDOauth2providers builder with application/json body
-func NewPostApiV1OrganizationsOrganizationIDOauth2providersRequest(server string, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersJSONRequestBody) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDRequest calls the generic PutApiV1OrganizationsOrganizationID builder with application/json body
+func NewPutApiV1OrganizationsOrganizationIDRequest(server string, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostApiV1OrganizationsOrganizationIDOauth2providersRequestWithBody(server, organizationID, "application/json", bodyReader)
+	return NewPutApiV1OrganizationsOrganizationIDRequestWithBody(server, organizationID, "application/json", bodyReader)
 }
 
-// NewPostApiV1OrganizationsOrganizationIDOauth2providersRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDOauth2providers with any type of body
-func NewPostApiV1OrganizationsOrganizationIDOauth2providersRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationID with any type of body
+func NewPutApiV1OrganizationsOrganizationIDRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1703,7 +1885,7 @@ func NewPostApiV1OrganizationsOrganizationIDOauth2providersRequestWithBody(serve
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/oauth2providers", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1713,7 +1895,7 @@ func NewPostApiV1OrganizationsOrganizationIDOauth2providersRequestWithBody(serve
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1723,8 +1905,8 @@ func NewPostApiV1OrganizationsOrganizationIDOauth2providersRequestWithBody(serve
 	return req, nil
 }
 
-// NewDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderID
-func NewDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest(server string, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDAclRequest generates requests for GetApiV1OrganizationsOrganizationIDAcl
+func NewGetApiV1OrganizationsOrganizationIDAclRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1734,19 +1916,12 @@ func NewDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest(s
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "providerID", runtime.ParamLocationPath, providerID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/oauth2providers/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/acl", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1756,7 +1931,7 @@ func NewDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest(s
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1764,19 +1939,8 @@ func NewDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest(s
 	return req, nil
 }
 
-// NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest calls the generic PutApiV1OrganizationsOrganizationIDOauth2providersProviderID builder with application/json body
-func NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest(server string, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequestWithBody(server, organizationID, providerID, "application/json", bodyReader)
-}
-
-// NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDOauth2providersProviderID with any type of body
-func NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequestWithBody(server string, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDAllocationsRequest generates requests for GetApiV1OrganizationsOrganizationIDAllocations
+func NewGetApiV1OrganizationsOrganizationIDAllocationsRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1786,19 +1950,12 @@ func NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequestWithB
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "providerID", runtime.ParamLocationPath, providerID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/oauth2providers/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/allocations", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1808,18 +1965,16 @@ func NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequestWithB
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetApiV1OrganizationsOrganizationIDProjectsRequest generates requests for GetApiV1OrganizationsOrganizationIDProjects
-func NewGetApiV1OrganizationsOrganizationIDProjectsRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDGroupsRequest generates requests for GetApiV1OrganizationsOrganizationIDGroups
+func NewGetApiV1OrganizationsOrganizationIDGroupsRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1834,7 +1989,7 @@ func NewGetApiV1OrganizationsOrganizationIDProjectsRequest(server string, organi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/groups", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1852,19 +2007,19 @@ func NewGetApiV1OrganizationsOrganizationIDProjectsRequest(server string, organi
 	return req, nil
 }
 
-// NewPostApiV1OrganizationsOrganizationIDProjectsRequest calls the generic PostApiV1OrganizationsOrganizationIDProjects builder with application/json body
-func NewPostApiV1OrganizationsOrganizationIDProjectsRequest(server string, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsJSONRequestBody) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDGroupsRequest calls the generic PostApiV1OrganizationsOrganizationIDGroups builder with application/json body
+func NewPostApiV1OrganizationsOrganizationIDGroupsRequest(server string, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDGroupsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostApiV1OrganizationsOrganizationIDProjectsRequestWithBody(server, organizationID, "application/json", bodyReader)
+	return NewPostApiV1OrganizationsOrganizationIDGroupsRequestWithBody(server, organizationID, "application/json", bodyReader)
 }
 
-// NewPostApiV1OrganizationsOrganizationIDProjectsRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDProjects with any type of body
-func NewPostApiV1OrganizationsOrganizationIDProjectsRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDGroupsRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDGroups with any type of body
+func NewPostApiV1OrganizationsOrganizationIDGroupsRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1879,7 +2034,7 @@ func NewPostApiV1OrganizationsOrganizationIDProjectsRequestWithBody(server strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/groups", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1899,8 +2054,8 @@ func NewPostApiV1OrganizationsOrganizationIDProjectsRequestWithBody(server strin
 	return req, nil
 }
 
-// NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDProjectsProjectID
-func NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter) (*http.Request, error) {
+// NewDeleteApiV1OrganizationsOrganizationIDGroupsGroupidRequest generates requests for DeleteApiV1OrganizationsOrganizationIDGroupsGroupid
+func NewDeleteApiV1OrganizationsOrganizationIDGroupsGroupidRequest(server string, organizationID OrganizationIDParameter, groupid GroupidParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1912,7 +2067,7 @@ func NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server st
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupid", runtime.ParamLocationPath, groupid)
 	if err != nil {
 		return nil, err
 	}
@@ -1922,7 +2077,7 @@ func NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server st
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/groups/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1940,8 +2095,8 @@ func NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server st
 	return req, nil
 }
 
-// NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDRequest generates requests for GetApiV1OrganizationsOrganizationIDProjectsProjectID
-func NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDGroupsGroupidRequest generates requests for GetApiV1OrganizationsOrganizationIDGroupsGroupid
+func NewGetApiV1OrganizationsOrganizationIDGroupsGroupidRequest(server string, organizationID OrganizationIDParameter, groupid GroupidParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1953,7 +2108,7 @@ func NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server strin
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupid", runtime.ParamLocationPath, groupid)
 	if err != nil {
 		return nil, err
 	}
@@ -1963,7 +2118,7 @@ func NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/groups/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1981,19 +2136,19 @@ func NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server strin
 	return req, nil
 }
 
-// NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequest calls the generic PutApiV1OrganizationsOrganizationIDProjectsProjectID builder with application/json body
-func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDJSONRequestBody) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequest calls the generic PutApiV1OrganizationsOrganizationIDGroupsGroupid builder with application/json body
+func NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequest(server string, organizationID OrganizationIDParameter, groupid GroupidParameter, body PutApiV1OrganizationsOrganizationIDGroupsGroupidJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequestWithBody(server, organizationID, projectID, "application/json", bodyReader)
+	return NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequestWithBody(server, organizationID, groupid, "application/json", bodyReader)
 }
 
-// NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDProjectsProjectID with any type of body
-func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequestWithBody(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDGroupsGroupid with any type of body
+func NewPutApiV1OrganizationsOrganizationIDGroupsGroupidRequestWithBody(server string, organizationID OrganizationIDParameter, groupid GroupidParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2005,7 +2160,7 @@ func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequestWithBody(serv
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupid", runtime.ParamLocationPath, groupid)
 	if err != nil {
 		return nil, err
 	}
@@ -2015,7 +2170,7 @@ func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequestWithBody(serv
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/groups/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2035,19 +2190,8 @@ func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequestWithBody(serv
 	return req, nil
 }
 
-// NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequest calls the generic PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocations builder with application/json body
-func NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequestWithBody(server, organizationID, projectID, "application/json", bodyReader)
-}
-
-// NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocations with any type of body
-func NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequestWithBody(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDOauth2providersRequest generates requests for GetApiV1OrganizationsOrganizationIDOauth2providers
+func NewGetApiV1OrganizationsOrganizationIDOauth2providersRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2057,19 +2201,12 @@ func NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequestW
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/allocations", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/oauth2providers", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2079,18 +2216,27 @@ func NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequestW
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID
-func NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDOauth2providersRequest calls the generic PostApiV1OrganizationsOrganizationIDOauth2providers builder with application/json body
+func NewPostApiV1OrganizationsOrganizationIDOauth2providersRequest(server string, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1OrganizationsOrganizationIDOauth2providersRequestWithBody(server, organizationID, "application/json", bodyReader)
+}
+
+// NewPostApiV1OrganizationsOrganizationIDOauth2providersRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDOauth2providers with any type of body
+func NewPostApiV1OrganizationsOrganizationIDOauth2providersRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2100,16 +2246,45 @@ func NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAlloca
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/oauth2providers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "allocationID", runtime.ParamLocationPath, allocationID)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderID
+func NewDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest(server string, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "providerID", runtime.ParamLocationPath, providerID)
 	if err != nil {
 		return nil, err
 	}
@@ -2119,7 +2294,7 @@ func NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAlloca
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/allocations/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/oauth2providers/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2137,8 +2312,19 @@ func NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAlloca
 	return req, nil
 }
 
-// NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest generates requests for GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID
-func NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest calls the generic PutApiV1OrganizationsOrganizationIDOauth2providersProviderID builder with application/json body
+func NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequest(server string, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequestWithBody(server, organizationID, providerID, "application/json", bodyReader)
+}
+
+// NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDOauth2providersProviderID with any type of body
+func NewPutApiV1OrganizationsOrganizationIDOauth2providersProviderIDRequestWithBody(server string, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2150,14 +2336,7 @@ func NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocatio
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "allocationID", runtime.ParamLocationPath, allocationID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "providerID", runtime.ParamLocationPath, providerID)
 	if err != nil {
 		return nil, err
 	}
@@ -2167,7 +2346,7 @@ func NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocatio
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/allocations/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/oauth2providers/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2177,27 +2356,29 @@ func NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocatio
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest calls the generic PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID builder with application/json body
-func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDJSONRequestBody) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunRequest calls the generic PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun builder with application/json body
+func NewPostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunRequest(server string, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequestWithBody(server, organizationID, projectID, allocationID, "application/json", bodyReader)
+	return NewPostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunRequestWithBody(server, organizationID, providerID, "application/json", bodyReader)
 }
 
-// NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID with any type of body
-func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequestWithBody(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun with any type of body
+func NewPostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunRequestWithBody(server string, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2209,14 +2390,7 @@ func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocatio
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "allocationID", runtime.ParamLocationPath, allocationID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "providerID", runtime.ParamLocationPath, providerID)
 	if err != nil {
 		return nil, err
 	}
@@ -2226,7 +2400,7 @@ func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocatio
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/allocations/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/oauth2providers/%s/groupmappings/dryrun", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2236,7 +2410,7 @@ func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocatio
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2246,8 +2420,8 @@ func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocatio
 	return req, nil
 }
 
-// NewGetApiV1OrganizationsOrganizationIDQuotasRequest generates requests for GetApiV1OrganizationsOrganizationIDQuotas
-func NewGetApiV1OrganizationsOrganizationIDQuotasRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDProjectsRequest generates requests for GetApiV1OrganizationsOrganizationIDProjects
+func NewGetApiV1OrganizationsOrganizationIDProjectsRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2262,7 +2436,7 @@ func NewGetApiV1OrganizationsOrganizationIDQuotasRequest(server string, organiza
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/quotas", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2280,19 +2454,19 @@ func NewGetApiV1OrganizationsOrganizationIDQuotasRequest(server string, organiza
 	return req, nil
 }
 
-// NewPutApiV1OrganizationsOrganizationIDQuotasRequest calls the generic PutApiV1OrganizationsOrganizationIDQuotas builder with application/json body
-func NewPutApiV1OrganizationsOrganizationIDQuotasRequest(server string, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDQuotasJSONRequestBody) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDProjectsRequest calls the generic PostApiV1OrganizationsOrganizationIDProjects builder with application/json body
+func NewPostApiV1OrganizationsOrganizationIDProjectsRequest(server string, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutApiV1OrganizationsOrganizationIDQuotasRequestWithBody(server, organizationID, "application/json", bodyReader)
+	return NewPostApiV1OrganizationsOrganizationIDProjectsRequestWithBody(server, organizationID, "application/json", bodyReader)
 }
 
-// NewPutApiV1OrganizationsOrganizationIDQuotasRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDQuotas with any type of body
-func NewPutApiV1OrganizationsOrganizationIDQuotasRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDProjectsRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDProjects with any type of body
+func NewPostApiV1OrganizationsOrganizationIDProjectsRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2307,7 +2481,7 @@ func NewPutApiV1OrganizationsOrganizationIDQuotasRequestWithBody(server string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/quotas", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2317,7 +2491,7 @@ func NewPutApiV1OrganizationsOrganizationIDQuotasRequestWithBody(server string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2327,8 +2501,8 @@ func NewPutApiV1OrganizationsOrganizationIDQuotasRequestWithBody(server string,
 	return req, nil
 }
 
-// NewGetApiV1OrganizationsOrganizationIDRolesRequest generates requests for GetApiV1OrganizationsOrganizationIDRoles
-func NewGetApiV1OrganizationsOrganizationIDRolesRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
+// NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDProjectsProjectID
+func NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2338,12 +2512,19 @@ func NewGetApiV1OrganizationsOrganizationIDRolesRequest(server string, organizat
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/roles", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2353,7 +2534,7 @@ func NewGetApiV1OrganizationsOrganizationIDRolesRequest(server string, organizat
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2361,8 +2542,8 @@ func NewGetApiV1OrganizationsOrganizationIDRolesRequest(server string, organizat
 	return req, nil
 }
 
-// NewGetApiV1OrganizationsOrganizationIDServiceaccountsRequest generates requests for GetApiV1OrganizationsOrganizationIDServiceaccounts
-func NewGetApiV1OrganizationsOrganizationIDServiceaccountsRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDRequest generates requests for GetApiV1OrganizationsOrganizationIDProjectsProjectID
+func NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2372,12 +2553,19 @@ func NewGetApiV1OrganizationsOrganizationIDServiceaccountsRequest(server string,
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/serviceaccounts", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2395,55 +2583,19 @@ func NewGetApiV1OrganizationsOrganizationIDServiceaccountsRequest(server string,
 	return req, nil
 }
 
-// NewPostApiV1OrganizationsOrganizationIDServiceaccountsRequest calls the generic PostApiV1OrganizationsOrganizationIDServiceaccounts builder with application/json body
-func NewPostApiV1OrganizationsOrganizationIDServiceaccountsRequest(server string, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDServiceaccountsJSONRequestBody) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequest calls the generic PutApiV1OrganizationsOrganizationIDProjectsProjectID builder with application/json body
+func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostApiV1OrganizationsOrganizationIDServiceaccountsRequestWithBody(server, organizationID, "application/json", bodyReader)
-}
-
-// NewPostApiV1OrganizationsOrganizationIDServiceaccountsRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDServiceaccounts with any type of body
-func NewPostApiV1OrganizationsOrganizationIDServiceaccountsRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/serviceaccounts", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequestWithBody(server, organizationID, projectID, "application/json", bodyReader)
 }
 
-// NewDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID
-func NewDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequest(server string, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDProjectsProjectID with any type of body
+func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDRequestWithBody(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2455,7 +2607,7 @@ func NewDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDReq
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountID", runtime.ParamLocationPath, serviceAccountID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -2465,7 +2617,7 @@ func NewDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDReq
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/serviceaccounts/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2475,27 +2627,29 @@ func NewDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDReq
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequest calls the generic PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID builder with application/json body
-func NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequest(server string, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, body PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDJSONRequestBody) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequest calls the generic PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocations builder with application/json body
+func NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequestWithBody(server, organizationID, serviceAccountID, "application/json", bodyReader)
+	return NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequestWithBody(server, organizationID, projectID, "application/json", bodyReader)
 }
 
-// NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID with any type of body
-func NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequestWithBody(server string, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocations with any type of body
+func NewPostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRequestWithBody(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2507,7 +2661,7 @@ func NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDReques
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountID", runtime.ParamLocationPath, serviceAccountID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -2517,7 +2671,7 @@ func NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDReques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/serviceaccounts/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/allocations", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2527,7 +2681,7 @@ func NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDReques
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2537,8 +2691,8 @@ func NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDReques
 	return req, nil
 }
 
-// NewPostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateRequest generates requests for PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotate
-func NewPostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateRequest(server string, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter) (*http.Request, error) {
+// NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID
+func NewDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2550,7 +2704,14 @@ func NewPostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotat
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountID", runtime.ParamLocationPath, serviceAccountID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "allocationID", runtime.ParamLocationPath, allocationID)
 	if err != nil {
 		return nil, err
 	}
@@ -2560,7 +2721,7 @@ func NewPostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotat
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/serviceaccounts/%s/rotate", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/allocations/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2570,7 +2731,7 @@ func NewPostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotat
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2578,8 +2739,8 @@ func NewPostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotat
 	return req, nil
 }
 
-// NewGetApiV1OrganizationsOrganizationIDUsersRequest generates requests for GetApiV1OrganizationsOrganizationIDUsers
-func NewGetApiV1OrganizationsOrganizationIDUsersRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest generates requests for GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID
+func NewGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2589,12 +2750,26 @@ func NewGetApiV1OrganizationsOrganizationIDUsersRequest(server string, organizat
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "allocationID", runtime.ParamLocationPath, allocationID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/allocations/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2612,19 +2787,19 @@ func NewGetApiV1OrganizationsOrganizationIDUsersRequest(server string, organizat
 	return req, nil
 }
 
-// NewPostApiV1OrganizationsOrganizationIDUsersRequest calls the generic PostApiV1OrganizationsOrganizationIDUsers builder with application/json body
-func NewPostApiV1OrganizationsOrganizationIDUsersRequest(server string, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDUsersJSONRequestBody) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest calls the generic PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID builder with application/json body
+func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequest(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostApiV1OrganizationsOrganizationIDUsersRequestWithBody(server, organizationID, "application/json", bodyReader)
+	return NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequestWithBody(server, organizationID, projectID, allocationID, "application/json", bodyReader)
 }
 
-// NewPostApiV1OrganizationsOrganizationIDUsersRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDUsers with any type of body
-func NewPostApiV1OrganizationsOrganizationIDUsersRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID with any type of body
+func NewPutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDRequestWithBody(server string, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2634,33 +2809,47 @@ func NewPostApiV1OrganizationsOrganizationIDUsersRequestWithBody(server string,
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam2 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "allocationID", runtime.ParamLocationPath, allocationID)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/allocations/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
 
 	return req, nil
 }
 
-// NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDUsersUserID
-func NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDRequest(server string, organizationID OrganizationIDParameter, userID UserIDParameter) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDQuotasRequest generates requests for GetApiV1OrganizationsOrganizationIDQuotas
+func NewGetApiV1OrganizationsOrganizationIDQuotasRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2670,19 +2859,12 @@ func NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDRequest(server string,
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/quotas", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2692,7 +2874,7 @@ func NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDRequest(server string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2700,19 +2882,19 @@ func NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDRequest(server string,
 	return req, nil
 }
 
-// NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequest calls the generic PutApiV1OrganizationsOrganizationIDUsersUserID builder with application/json body
-func NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequest(server string, organizationID OrganizationIDParameter, userID UserIDParameter, body PutApiV1OrganizationsOrganizationIDUsersUserIDJSONRequestBody) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDQuotasRequest calls the generic PutApiV1OrganizationsOrganizationIDQuotas builder with application/json body
+func NewPutApiV1OrganizationsOrganizationIDQuotasRequest(server string, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDQuotasJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequestWithBody(server, organizationID, userID, "application/json", bodyReader)
+	return NewPutApiV1OrganizationsOrganizationIDQuotasRequestWithBody(server, organizationID, "application/json", bodyReader)
 }
 
-// NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDUsersUserID with any type of body
-func NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequestWithBody(server string, organizationID OrganizationIDParameter, userID UserIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDQuotasRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDQuotas with any type of body
+func NewPutApiV1OrganizationsOrganizationIDQuotasRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2722,19 +2904,12 @@ func NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequestWithBody(server str
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/quotas", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2754,16 +2929,23 @@ func NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequestWithBody(server str
 	return req, nil
 }
 
-// NewGetApiV1SignupRequest generates requests for GetApiV1Signup
-func NewGetApiV1SignupRequest(server string) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDRolesRequest generates requests for GetApiV1OrganizationsOrganizationIDRoles
+func NewGetApiV1OrganizationsOrganizationIDRolesRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/signup")
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/roles", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2781,16 +2963,23 @@ func NewGetApiV1SignupRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetOauth2V2AuthorizationRequest generates requests for GetOauth2V2Authorization
-func NewGetOauth2V2AuthorizationRequest(server string) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDServiceaccountsRequest generates requests for GetApiV1OrganizationsOrganizationIDServiceaccounts
+func NewGetApiV1OrganizationsOrganizationIDServiceaccountsRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth2/v2/authorization")
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/serviceaccounts", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2808,27 +2997,34 @@ func NewGetOauth2V2AuthorizationRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPostOauth2V2AuthorizationRequestWithFormdataBody calls the generic PostOauth2V2Authorization builder with application/x-www-form-urlencoded body
-func NewPostOauth2V2AuthorizationRequestWithFormdataBody(server string, body PostOauth2V2AuthorizationFormdataRequestBody) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDServiceaccountsRequest calls the generic PostApiV1OrganizationsOrganizationIDServiceaccounts builder with application/json body
+func NewPostApiV1OrganizationsOrganizationIDServiceaccountsRequest(server string, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDServiceaccountsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewPostOauth2V2AuthorizationRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1OrganizationsOrganizationIDServiceaccountsRequestWithBody(server, organizationID, "application/json", bodyReader)
 }
 
-// NewPostOauth2V2AuthorizationRequestWithBody generates requests for PostOauth2V2Authorization with any type of body
-func NewPostOauth2V2AuthorizationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDServiceaccountsRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDServiceaccounts with any type of body
+func NewPostApiV1OrganizationsOrganizationIDServiceaccountsRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth2/v2/authorization")
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/serviceaccounts", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2848,54 +3044,30 @@ func NewPostOauth2V2AuthorizationRequestWithBody(server string, contentType stri
 	return req, nil
 }
 
-// NewGetOauth2V2JwksRequest generates requests for GetOauth2V2Jwks
-func NewGetOauth2V2JwksRequest(server string) (*http.Request, error) {
+// NewDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID
+func NewDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequest(server string, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/oauth2/v2/jwks")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	var pathParam1 string
 
-// NewPostOauth2V2LoginRequestWithFormdataBody calls the generic PostOauth2V2Login builder with application/x-www-form-urlencoded body
-func NewPostOauth2V2LoginRequestWithFormdataBody(server string, body PostOauth2V2LoginFormdataRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountID", runtime.ParamLocationPath, serviceAccountID)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewPostOauth2V2LoginRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
-}
-
-// NewPostOauth2V2LoginRequestWithBody generates requests for PostOauth2V2Login with any type of body
-func NewPostOauth2V2LoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth2/v2/login")
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/serviceaccounts/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2905,37 +3077,49 @@ func NewPostOauth2V2LoginRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPostOauth2V2OnboardRequestWithFormdataBody calls the generic PostOauth2V2Onboard builder with application/x-www-form-urlencoded body
-func NewPostOauth2V2OnboardRequestWithFormdataBody(server string, body PostOauth2V2OnboardFormdataRequestBody) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequest calls the generic PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID builder with application/json body
+func NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequest(server string, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, body PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewPostOauth2V2OnboardRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequestWithBody(server, organizationID, serviceAccountID, "application/json", bodyReader)
 }
 
-// NewPostOauth2V2OnboardRequestWithBody generates requests for PostOauth2V2Onboard with any type of body
-func NewPostOauth2V2OnboardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID with any type of body
+func NewPutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRequestWithBody(server string, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountID", runtime.ParamLocationPath, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth2/v2/onboard")
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/serviceaccounts/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2945,7 +3129,7 @@ func NewPostOauth2V2OnboardRequestWithBody(server string, contentType string, bo
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2955,27 +3139,30 @@ func NewPostOauth2V2OnboardRequestWithBody(server string, contentType string, bo
 	return req, nil
 }
 
-// NewPostOauth2V2TokenRequestWithFormdataBody calls the generic PostOauth2V2Token builder with application/x-www-form-urlencoded body
-func NewPostOauth2V2TokenRequestWithFormdataBody(server string, body PostOauth2V2TokenFormdataRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
+// NewPostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateRequest generates requests for PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotate
+func NewPostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateRequest(server string, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewPostOauth2V2TokenRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
-}
 
-// NewPostOauth2V2TokenRequestWithBody generates requests for PostOauth2V2Token with any type of body
-func NewPostOauth2V2TokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountID", runtime.ParamLocationPath, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth2/v2/token")
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/serviceaccounts/%s/rotate", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2985,26 +3172,31 @@ func NewPostOauth2V2TokenRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetOauth2V2UserinfoRequest generates requests for GetOauth2V2Userinfo
-func NewGetOauth2V2UserinfoRequest(server string) (*http.Request, error) {
+// NewGetApiV1OrganizationsOrganizationIDUsersRequest generates requests for GetApiV1OrganizationsOrganizationIDUsers
+func NewGetApiV1OrganizationsOrganizationIDUsersRequest(server string, organizationID OrganizationIDParameter) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth2/v2/userinfo")
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3022,27 +3214,34 @@ func NewGetOauth2V2UserinfoRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPostOauth2V2UserinfoRequestWithFormdataBody calls the generic PostOauth2V2Userinfo builder with application/x-www-form-urlencoded body
-func NewPostOauth2V2UserinfoRequestWithFormdataBody(server string, body PostOauth2V2UserinfoFormdataRequestBody) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDUsersRequest calls the generic PostApiV1OrganizationsOrganizationIDUsers builder with application/json body
+func NewPostApiV1OrganizationsOrganizationIDUsersRequest(server string, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDUsersJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewPostOauth2V2UserinfoRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1OrganizationsOrganizationIDUsersRequestWithBody(server, organizationID, "application/json", bodyReader)
 }
 
-// NewPostOauth2V2UserinfoRequestWithBody generates requests for PostOauth2V2Userinfo with any type of body
-func NewPostOauth2V2UserinfoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostApiV1OrganizationsOrganizationIDUsersRequestWithBody generates requests for PostApiV1OrganizationsOrganizationIDUsers with any type of body
+func NewPostApiV1OrganizationsOrganizationIDUsersRequestWithBody(server string, organizationID OrganizationIDParameter, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth2/v2/userinfo")
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3062,2121 +3261,5216 @@ func NewPostOauth2V2UserinfoRequestWithBody(server string, contentType string, b
 	return req, nil
 }
 
-// NewGetOidcCallbackRequest generates requests for GetOidcCallback
-func NewGetOidcCallbackRequest(server string) (*http.Request, error) {
+// NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDUsersUserID
+func NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDRequest(server string, organizationID OrganizationIDParameter, userID UserIDParameter) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oidc/callback")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam1 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequest calls the generic PutApiV1OrganizationsOrganizationIDUsersUserID builder with application/json body
+func NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequest(server string, organizationID OrganizationIDParameter, userID UserIDParameter, body PutApiV1OrganizationsOrganizationIDUsersUserIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequestWithBody(server, organizationID, userID, "application/json", bodyReader)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetWellKnownOpenidConfigurationWithResponse request
-	GetWellKnownOpenidConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownOpenidConfigurationResponse, error)
+// NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequestWithBody generates requests for PutApiV1OrganizationsOrganizationIDUsersUserID with any type of body
+func NewPutApiV1OrganizationsOrganizationIDUsersUserIDRequestWithBody(server string, organizationID OrganizationIDParameter, userID UserIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetApiV1AclWithResponse request
-	GetApiV1AclWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AclResponse, error)
+	var pathParam0 string
 
-	// GetApiV1Oauth2providersWithResponse request
-	GetApiV1Oauth2providersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1Oauth2providersResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1OrganizationsWithResponse request
-	GetApiV1OrganizationsWithResponse(ctx context.Context, params *GetApiV1OrganizationsParams, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsResponse, error)
+	var pathParam1 string
 
-	// PostApiV1OrganizationsWithBodyWithResponse request with any body
-	PostApiV1OrganizationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
 
-	PostApiV1OrganizationsWithResponse(ctx context.Context, body PostApiV1OrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1OrganizationsOrganizationIDWithResponse request
-	GetApiV1OrganizationsOrganizationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PutApiV1OrganizationsOrganizationIDWithBodyWithResponse request with any body
-	PutApiV1OrganizationsOrganizationIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PutApiV1OrganizationsOrganizationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1OrganizationsOrganizationIDAclWithResponse request
-	GetApiV1OrganizationsOrganizationIDAclWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDAclResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetApiV1OrganizationsOrganizationIDAllocationsWithResponse request
-	GetApiV1OrganizationsOrganizationIDAllocationsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDAllocationsResponse, error)
+	return req, nil
+}
 
-	// GetApiV1OrganizationsOrganizationIDGroupsWithResponse request
-	GetApiV1OrganizationsOrganizationIDGroupsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDGroupsResponse, error)
+// NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsRequest generates requests for DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions
+func NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsRequest(server string, organizationID OrganizationIDParameter, userID UserIDParameter) (*http.Request, error) {
+	var err error
 
-	// PostApiV1OrganizationsOrganizationIDGroupsWithBodyWithResponse request with any body
-	PostApiV1OrganizationsOrganizationIDGroupsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDGroupsResponse, error)
+	var pathParam0 string
 
-	PostApiV1OrganizationsOrganizationIDGroupsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDGroupsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse request
-	DeleteApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error)
+	var pathParam1 string
 
-	// GetApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse request
-	GetApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutApiV1OrganizationsOrganizationIDGroupsGroupidWithBodyWithResponse request with any body
-	PutApiV1OrganizationsOrganizationIDGroupsGroupidWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PutApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, body PutApiV1OrganizationsOrganizationIDGroupsGroupidJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users/%s/sessions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetApiV1OrganizationsOrganizationIDOauth2providersWithResponse request
-	GetApiV1OrganizationsOrganizationIDOauth2providersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDOauth2providersResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostApiV1OrganizationsOrganizationIDOauth2providersWithBodyWithResponse request with any body
-	PostApiV1OrganizationsOrganizationIDOauth2providersWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PostApiV1OrganizationsOrganizationIDOauth2providersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersResponse, error)
+	return req, nil
+}
 
-	// DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse request
-	DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error)
+// NewGetApiV1OrganizationsOrganizationIDUsersUserIDSessionsRequest generates requests for GetApiV1OrganizationsOrganizationIDUsersUserIDSessions
+func NewGetApiV1OrganizationsOrganizationIDUsersUserIDSessionsRequest(server string, organizationID OrganizationIDParameter, userID UserIDParameter) (*http.Request, error) {
+	var err error
 
-	// PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithBodyWithResponse request with any body
-	PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error)
+	var pathParam0 string
 
-	PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1OrganizationsOrganizationIDProjectsWithResponse request
-	GetApiV1OrganizationsOrganizationIDProjectsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsResponse, error)
+	var pathParam1 string
 
-	// PostApiV1OrganizationsOrganizationIDProjectsWithBodyWithResponse request with any body
-	PostApiV1OrganizationsOrganizationIDProjectsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
 
-	PostApiV1OrganizationsOrganizationIDProjectsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse request
-	DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users/%s/sessions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse request
-	GetApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithBodyWithResponse request with any body
-	PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error)
+	return req, nil
+}
 
-	// PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithBodyWithResponse request with any body
-	PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse, error)
+// NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDRequest generates requests for DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID
+func NewDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDRequest(server string, organizationID OrganizationIDParameter, userID UserIDParameter, sessionID SessionIDParameter) (*http.Request, error) {
+	var err error
 
-	PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse, error)
+	var pathParam0 string
 
-	// DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse request
-	DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationID", runtime.ParamLocationPath, organizationID)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse request
-	GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error)
+	var pathParam1 string
 
-	// PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithBodyWithResponse request with any body
-	PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
 
-	PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error)
+	var pathParam2 string
 
-	// GetApiV1OrganizationsOrganizationIDQuotasWithResponse request
-	GetApiV1OrganizationsOrganizationIDQuotasWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDQuotasResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "sessionID", runtime.ParamLocationPath, sessionID)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutApiV1OrganizationsOrganizationIDQuotasWithBodyWithResponse request with any body
-	PutApiV1OrganizationsOrganizationIDQuotasWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDQuotasResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PutApiV1OrganizationsOrganizationIDQuotasWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDQuotasJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDQuotasResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/users/%s/sessions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetApiV1OrganizationsOrganizationIDRolesWithResponse request
-	GetApiV1OrganizationsOrganizationIDRolesWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDRolesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1OrganizationsOrganizationIDServiceaccountsWithResponse request
-	GetApiV1OrganizationsOrganizationIDServiceaccountsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDServiceaccountsResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostApiV1OrganizationsOrganizationIDServiceaccountsWithBodyWithResponse request with any body
-	PostApiV1OrganizationsOrganizationIDServiceaccountsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsResponse, error)
+	return req, nil
+}
 
-	PostApiV1OrganizationsOrganizationIDServiceaccountsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDServiceaccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsResponse, error)
+// NewGetApiV1RevocationsRequest generates requests for GetApiV1Revocations
+func NewGetApiV1RevocationsRequest(server string, params *GetApiV1RevocationsParams) (*http.Request, error) {
+	var err error
 
-	// DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse request
-	DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithBodyWithResponse request with any body
-	PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error)
-
-	PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, body PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/revocations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateWithResponse request
-	PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1OrganizationsOrganizationIDUsersWithResponse request
-	GetApiV1OrganizationsOrganizationIDUsersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDUsersResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// PostApiV1OrganizationsOrganizationIDUsersWithBodyWithResponse request with any body
-	PostApiV1OrganizationsOrganizationIDUsersWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDUsersResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PostApiV1OrganizationsOrganizationIDUsersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDUsersResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// DeleteApiV1OrganizationsOrganizationIDUsersUserIDWithResponse request
-	DeleteApiV1OrganizationsOrganizationIDUsersUserIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutApiV1OrganizationsOrganizationIDUsersUserIDWithBodyWithResponse request with any body
-	PutApiV1OrganizationsOrganizationIDUsersUserIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDUsersUserIDResponse, error)
+	return req, nil
+}
 
-	PutApiV1OrganizationsOrganizationIDUsersUserIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, body PutApiV1OrganizationsOrganizationIDUsersUserIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDUsersUserIDResponse, error)
+// NewDeleteApiV1SessionsRequest generates requests for DeleteApiV1Sessions
+func NewDeleteApiV1SessionsRequest(server string) (*http.Request, error) {
+	var err error
 
-	// GetApiV1SignupWithResponse request
-	GetApiV1SignupWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1SignupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOauth2V2AuthorizationWithResponse request
-	GetOauth2V2AuthorizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2AuthorizationResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostOauth2V2AuthorizationWithBodyWithResponse request with any body
-	PostOauth2V2AuthorizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2AuthorizationResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PostOauth2V2AuthorizationWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2AuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2AuthorizationResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOauth2V2JwksWithResponse request
-	GetOauth2V2JwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2JwksResponse, error)
+	return req, nil
+}
 
-	// PostOauth2V2LoginWithBodyWithResponse request with any body
-	PostOauth2V2LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2LoginResponse, error)
+// NewGetApiV1SessionsRequest generates requests for GetApiV1Sessions
+func NewGetApiV1SessionsRequest(server string) (*http.Request, error) {
+	var err error
 
-	PostOauth2V2LoginWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2LoginResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostOauth2V2OnboardWithBodyWithResponse request with any body
-	PostOauth2V2OnboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2OnboardResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	PostOauth2V2OnboardWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2OnboardFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2OnboardResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostOauth2V2TokenWithBodyWithResponse request with any body
-	PostOauth2V2TokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2TokenResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PostOauth2V2TokenWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2TokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2TokenResponse, error)
+	return req, nil
+}
 
-	// GetOauth2V2UserinfoWithResponse request
-	GetOauth2V2UserinfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2UserinfoResponse, error)
+// NewDeleteApiV1SessionsSessionIDRequest generates requests for DeleteApiV1SessionsSessionID
+func NewDeleteApiV1SessionsSessionIDRequest(server string, sessionID SessionIDParameter) (*http.Request, error) {
+	var err error
 
-	// PostOauth2V2UserinfoWithBodyWithResponse request with any body
-	PostOauth2V2UserinfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2UserinfoResponse, error)
+	var pathParam0 string
 
-	PostOauth2V2UserinfoWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2UserinfoFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2UserinfoResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionID", runtime.ParamLocationPath, sessionID)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOidcCallbackWithResponse request
-	GetOidcCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOidcCallbackResponse, error)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type GetWellKnownOpenidConfigurationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OpenidConfigurationResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetWellKnownOpenidConfigurationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetWellKnownOpenidConfigurationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1AclResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AclResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1AclResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetApiV1SignupRequest generates requests for GetApiV1Signup
+func NewGetApiV1SignupRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1AclResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/signup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetApiV1Oauth2providersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SystemOauth2ProvidersResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1Oauth2providersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1Oauth2providersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetOauth2V2AuthorizationRequest generates requests for GetOauth2V2Authorization
+func NewGetOauth2V2AuthorizationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OrganizationsResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/authorization")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostApiV1OrganizationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON202      *OrganizationRead
-	JSON400      *externalRef0.BadRequestResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1OrganizationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostOauth2V2AuthorizationRequestWithFormdataBody calls the generic PostOauth2V2Authorization builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2AuthorizationRequestWithFormdataBody(server string, body PostOauth2V2AuthorizationFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2AuthorizationRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1OrganizationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewPostOauth2V2AuthorizationRequestWithBody generates requests for PostOauth2V2Authorization with any type of body
+func NewPostOauth2V2AuthorizationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetApiV1OrganizationsOrganizationIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OrganizationResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/oauth2/v2/authorization")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutApiV1OrganizationsOrganizationIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutApiV1OrganizationsOrganizationIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetOauth2V2DeviceRequest generates requests for GetOauth2V2Device
+func NewGetOauth2V2DeviceRequest(server string, params *GetOauth2V2DeviceParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiV1OrganizationsOrganizationIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/oauth2/v2/device")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDAclResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AclResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDAclResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserCode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_code", runtime.ParamLocationQuery, *params.UserCode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDAclResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDAllocationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AllocationsResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDAllocationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostOauth2V2DeviceRequestWithFormdataBody calls the generic PostOauth2V2Device builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2DeviceRequestWithFormdataBody(server string, body PostOauth2V2DeviceFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2DeviceRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDAllocationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2DeviceRequestWithBody generates requests for PostOauth2V2Device with any type of body
+func NewPostOauth2V2DeviceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDGroupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GroupsResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/device")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDGroupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDGroupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostApiV1OrganizationsOrganizationIDGroupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *GroupResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON409      *externalRef0.ConflictResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1OrganizationsOrganizationIDGroupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostOauth2V2DeviceApproveRequestWithFormdataBody calls the generic PostOauth2V2DeviceApprove builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2DeviceApproveRequestWithFormdataBody(server string, body PostOauth2V2DeviceApproveFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2DeviceApproveRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1OrganizationsOrganizationIDGroupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2DeviceApproveRequestWithBody generates requests for PostOauth2V2DeviceApprove with any type of body
+func NewPostOauth2V2DeviceApproveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/device/approve")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GroupResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostOauth2V2DeviceAuthorizationRequestWithFormdataBody calls the generic PostOauth2V2DeviceAuthorization builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2DeviceAuthorizationRequestWithFormdataBody(server string, body PostOauth2V2DeviceAuthorizationFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2DeviceAuthorizationRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2DeviceAuthorizationRequestWithBody generates requests for PostOauth2V2DeviceAuthorization with any type of body
+func NewPostOauth2V2DeviceAuthorizationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/device_authorization")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDOauth2providersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Oauth2ProvidersResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDOauth2providersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDOauth2providersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2IntrospectRequestWithFormdataBody calls the generic PostOauth2V2Introspect builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2IntrospectRequestWithFormdataBody(server string, body PostOauth2V2IntrospectFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2IntrospectRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-type PostApiV1OrganizationsOrganizationIDOauth2providersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Oauth2ProviderResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON409      *externalRef0.ConflictResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+// NewPostOauth2V2IntrospectRequestWithBody generates requests for PostOauth2V2Introspect with any type of body
+func NewPostOauth2V2IntrospectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1OrganizationsOrganizationIDOauth2providersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1OrganizationsOrganizationIDOauth2providersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/oauth2/v2/introspect")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetOauth2V2JwksRequest generates requests for GetOauth2V2Jwks
+func NewGetOauth2V2JwksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/jwks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectsResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostOauth2V2LoginRequestWithFormdataBody calls the generic PostOauth2V2Login builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2LoginRequestWithFormdataBody(server string, body PostOauth2V2LoginFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2LoginRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2LoginRequestWithBody generates requests for PostOauth2V2Login with any type of body
+func NewPostOauth2V2LoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostApiV1OrganizationsOrganizationIDProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON202      *ProjectResponse
-	JSON400      *externalRef0.BadRequestResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON409      *externalRef0.ConflictResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1OrganizationsOrganizationIDProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1OrganizationsOrganizationIDProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *externalRef0.BadRequestResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetOauth2V2LogoutRequest generates requests for GetOauth2V2Logout
+func NewGetOauth2V2LogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/oauth2/v2/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectResponse
-	JSON400      *externalRef0.BadRequestResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *externalRef0.BadRequestResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostOauth2V2LogoutRequestWithFormdataBody calls the generic PostOauth2V2Logout builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2LogoutRequestWithFormdataBody(server string, body PostOauth2V2LogoutFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2LogoutRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2LogoutRequestWithBody generates requests for PostOauth2V2Logout with any type of body
+func NewPostOauth2V2LogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *AllocationResponse
-	JSON400      *externalRef0.BadRequestResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON409      *externalRef0.ConflictResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2OnboardRequestWithFormdataBody calls the generic PostOauth2V2Onboard builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2OnboardRequestWithFormdataBody(server string, body PostOauth2V2OnboardFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2OnboardRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-type GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AllocationResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+// NewPostOauth2V2OnboardRequestWithBody generates requests for PostOauth2V2Onboard with any type of body
+func NewPostOauth2V2OnboardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/oauth2/v2/onboard")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AllocationResponse
-	JSON400      *externalRef0.BadRequestResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDQuotasResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *QuotasResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDQuotasResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostOauth2V2ParRequestWithFormdataBody calls the generic PostOauth2V2Par builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2ParRequestWithFormdataBody(server string, body PostOauth2V2ParFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2ParRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDQuotasResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2ParRequestWithBody generates requests for PostOauth2V2Par with any type of body
+func NewPostOauth2V2ParRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutApiV1OrganizationsOrganizationIDQuotasResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *QuotasResponse
-	JSON400      *externalRef0.BadRequestResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/par")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutApiV1OrganizationsOrganizationIDQuotasResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiV1OrganizationsOrganizationIDQuotasResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDRolesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RolesResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDRolesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostOauth2V2RegisterRequest calls the generic PostOauth2V2Register builder with application/json body
+func NewPostOauth2V2RegisterRequest(server string, body PostOauth2V2RegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostOauth2V2RegisterRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDRolesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2RegisterRequestWithBody generates requests for PostOauth2V2Register with any type of body
+func NewPostOauth2V2RegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDServiceaccountsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ServiceAccountsResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDServiceaccountsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDServiceaccountsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostApiV1OrganizationsOrganizationIDServiceaccountsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ServiceAccountCreateResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1OrganizationsOrganizationIDServiceaccountsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeleteOauth2V2RegisterClientIDRequest generates requests for DeleteOauth2V2RegisterClientID
+func NewDeleteOauth2V2RegisterClientIDRequest(server string, clientID ClientIDParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clientID", runtime.ParamLocationPath, clientID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1OrganizationsOrganizationIDServiceaccountsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/register/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ServiceAccountResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetOauth2V2RegisterClientIDRequest generates requests for GetOauth2V2RegisterClientID
+func NewGetOauth2V2RegisterClientIDRequest(server string, clientID ClientIDParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clientID", runtime.ParamLocationPath, clientID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ServiceAccountCreateResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/register/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1OrganizationsOrganizationIDUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UsersResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1OrganizationsOrganizationIDUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPutOauth2V2RegisterClientIDRequest calls the generic PutOauth2V2RegisterClientID builder with application/json body
+func NewPutOauth2V2RegisterClientIDRequest(server string, clientID ClientIDParameter, body PutOauth2V2RegisterClientIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPutOauth2V2RegisterClientIDRequestWithBody(server, clientID, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1OrganizationsOrganizationIDUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewPutOauth2V2RegisterClientIDRequestWithBody generates requests for PutOauth2V2RegisterClientID with any type of body
+func NewPutOauth2V2RegisterClientIDRequestWithBody(server string, clientID ClientIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type PostApiV1OrganizationsOrganizationIDUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *UserResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON409      *externalRef0.ConflictResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1OrganizationsOrganizationIDUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clientID", runtime.ParamLocationPath, clientID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1OrganizationsOrganizationIDUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON404      *externalRef0.NotFoundResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/register/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutApiV1OrganizationsOrganizationIDUsersUserIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON403      *externalRef0.ForbiddenResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r PutApiV1OrganizationsOrganizationIDUsersUserIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiV1OrganizationsOrganizationIDUsersUserIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2RevokeRequestWithFormdataBody calls the generic PostOauth2V2Revoke builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2RevokeRequestWithFormdataBody(server string, body PostOauth2V2RevokeFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2RevokeRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-type GetApiV1SignupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// NewPostOauth2V2RevokeRequestWithBody generates requests for PostOauth2V2Revoke with any type of body
+func NewPostOauth2V2RevokeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1SignupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1SignupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/oauth2/v2/revoke")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetOauth2V2AuthorizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
-
-// Status returns HTTPResponse.Status
-func (r GetOauth2V2AuthorizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOauth2V2AuthorizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostOauth2V2AuthorizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r PostOauth2V2AuthorizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostOauth2V2AuthorizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2TokenRequestWithFormdataBody calls the generic PostOauth2V2Token builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2TokenRequestWithFormdataBody(server string, body PostOauth2V2TokenFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2TokenRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-type GetOauth2V2JwksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *JwksResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+// NewPostOauth2V2TokenRequestWithBody generates requests for PostOauth2V2Token with any type of body
+func NewPostOauth2V2TokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetOauth2V2JwksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOauth2V2JwksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/oauth2/v2/token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type PostOauth2V2LoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
 
-// Status returns HTTPResponse.Status
-func (r PostOauth2V2LoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostOauth2V2LoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostOauth2V2OnboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r PostOauth2V2OnboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostOauth2V2OnboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetOauth2V2UserinfoRequest generates requests for GetOauth2V2Userinfo
+func NewGetOauth2V2UserinfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostOauth2V2TokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TokenResponse
-	JSON400      *externalRef0.BadRequestResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-	JSON500      *externalRef0.InternalServerErrorResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/userinfo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostOauth2V2TokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostOauth2V2TokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetOauth2V2UserinfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserinfoResponse
-	JSON401      *externalRef0.UnauthorizedResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetOauth2V2UserinfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostOauth2V2UserinfoRequestWithFormdataBody calls the generic PostOauth2V2Userinfo builder with application/x-www-form-urlencoded body
+func NewPostOauth2V2UserinfoRequestWithFormdataBody(server string, body PostOauth2V2UserinfoFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostOauth2V2UserinfoRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOauth2V2UserinfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostOauth2V2UserinfoRequestWithBody generates requests for PostOauth2V2Userinfo with any type of body
+func NewPostOauth2V2UserinfoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostOauth2V2UserinfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserinfoResponse
-	JSON401      *externalRef0.UnauthorizedResponse
-}
+	operationPath := fmt.Sprintf("/oauth2/v2/userinfo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostOauth2V2UserinfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostOauth2V2UserinfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetOidcCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *externalRef0.InternalServerErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetOidcCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOidcCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewGetOidcCallbackRequest generates requests for GetOidcCallback
+func NewGetOidcCallbackRequest(server string) (*http.Request, error) {
+	var err error
 
-// GetWellKnownOpenidConfigurationWithResponse request returning *GetWellKnownOpenidConfigurationResponse
-func (c *ClientWithResponses) GetWellKnownOpenidConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownOpenidConfigurationResponse, error) {
-	rsp, err := c.GetWellKnownOpenidConfiguration(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWellKnownOpenidConfigurationResponse(rsp)
-}
 
-// GetApiV1AclWithResponse request returning *GetApiV1AclResponse
-func (c *ClientWithResponses) GetApiV1AclWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AclResponse, error) {
-	rsp, err := c.GetApiV1Acl(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/oidc/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseGetApiV1AclResponse(rsp)
-}
 
-// GetApiV1Oauth2providersWithResponse request returning *GetApiV1Oauth2providersResponse
-func (c *ClientWithResponses) GetApiV1Oauth2providersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1Oauth2providersResponse, error) {
-	rsp, err := c.GetApiV1Oauth2providers(ctx, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1Oauth2providersResponse(rsp)
-}
 
-// GetApiV1OrganizationsWithResponse request returning *GetApiV1OrganizationsResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsWithResponse(ctx context.Context, params *GetApiV1OrganizationsParams, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsResponse, error) {
-	rsp, err := c.GetApiV1Organizations(ctx, params, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1OrganizationsResponse(rsp)
-}
 
-// PostApiV1OrganizationsWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsResponse
-func (c *ClientWithResponses) PostApiV1OrganizationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiV1OrganizationsResponse(rsp)
+	return req, nil
 }
 
-func (c *ClientWithResponses) PostApiV1OrganizationsWithResponse(ctx context.Context, body PostApiV1OrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsResponse, error) {
-	rsp, err := c.PostApiV1Organizations(ctx, body, reqEditors...)
+// NewPostSamlAcsRequestWithFormdataBody calls the generic PostSamlAcs builder with application/x-www-form-urlencoded body
+func NewPostSamlAcsRequestWithFormdataBody(server string, body PostSamlAcsFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1OrganizationsResponse(rsp)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostSamlAcsRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// GetApiV1OrganizationsOrganizationIDWithResponse request returning *GetApiV1OrganizationsOrganizationIDResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationID(ctx, organizationID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiV1OrganizationsOrganizationIDResponse(rsp)
-}
+// NewPostSamlAcsRequestWithBody generates requests for PostSamlAcs with any type of body
+func NewPostSamlAcsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// PutApiV1OrganizationsOrganizationIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDResponse
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDWithBody(ctx, organizationID, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationID(ctx, organizationID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/saml/acs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDResponse(rsp)
-}
 
-// GetApiV1OrganizationsOrganizationIDAclWithResponse request returning *GetApiV1OrganizationsOrganizationIDAclResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDAclWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDAclResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDAcl(ctx, organizationID, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1OrganizationsOrganizationIDAclResponse(rsp)
-}
 
-// GetApiV1OrganizationsOrganizationIDAllocationsWithResponse request returning *GetApiV1OrganizationsOrganizationIDAllocationsResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDAllocationsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDAllocationsResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDAllocations(ctx, organizationID, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1OrganizationsOrganizationIDAllocationsResponse(rsp)
-}
 
-// GetApiV1OrganizationsOrganizationIDGroupsWithResponse request returning *GetApiV1OrganizationsOrganizationIDGroupsResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDGroupsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDGroupsResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDGroups(ctx, organizationID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiV1OrganizationsOrganizationIDGroupsResponse(rsp)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// PostApiV1OrganizationsOrganizationIDGroupsWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDGroupsResponse
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDGroupsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDGroupsResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDGroupsWithBody(ctx, organizationID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiV1OrganizationsOrganizationIDGroupsResponse(rsp)
+	return req, nil
 }
 
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDGroupsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDGroupsResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDGroups(ctx, organizationID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiV1OrganizationsOrganizationIDGroupsResponse(rsp)
-}
+// NewGetSamlMetadataRequest generates requests for GetSamlMetadata
+func NewGetSamlMetadataRequest(server string) (*http.Request, error) {
+	var err error
 
-// DeleteApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse
-func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
-	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDGroupsGroupid(ctx, organizationID, groupid, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp)
-}
 
-// GetApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse request returning *GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDGroupsGroupid(ctx, organizationID, groupid, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/saml/metadata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseGetApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp)
-}
 
-// PutApiV1OrganizationsOrganizationIDGroupsGroupidWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDGroupsGroupidWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDGroupsGroupidWithBody(ctx, organizationID, groupid, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, body PutApiV1OrganizationsOrganizationIDGroupsGroupidJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDGroupsGroupid(ctx, organizationID, groupid, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp)
-}
 
-// GetApiV1OrganizationsOrganizationIDOauth2providersWithResponse request returning *GetApiV1OrganizationsOrganizationIDOauth2providersResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDOauth2providersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDOauth2providersResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDOauth2providers(ctx, organizationID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp)
+	return req, nil
 }
 
-// PostApiV1OrganizationsOrganizationIDOauth2providersWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDOauth2providersResponse
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDOauth2providersWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDOauth2providersWithBody(ctx, organizationID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
-	return ParsePostApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp)
-}
-
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDOauth2providersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDOauth2providers(ctx, organizationID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
-	return ParsePostApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp)
+	return nil
 }
 
-// DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse
-func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error) {
-	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderID(ctx, organizationID, providerID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(rsp)
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
 }
 
-// PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithBody(ctx, organizationID, providerID, contentType, body, reqEditors...)
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(rsp)
+	return &ClientWithResponses{client}, nil
 }
 
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDOauth2providersProviderID(ctx, organizationID, providerID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(rsp)
 }
 
-// GetApiV1OrganizationsOrganizationIDProjectsWithResponse request returning *GetApiV1OrganizationsOrganizationIDProjectsResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDProjectsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDProjects(ctx, organizationID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiV1OrganizationsOrganizationIDProjectsResponse(rsp)
-}
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetWellKnownOpenidConfigurationWithResponse request
+	GetWellKnownOpenidConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownOpenidConfigurationResponse, error)
 
-// PostApiV1OrganizationsOrganizationIDProjectsWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDProjectsResponse
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDProjectsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDProjectsWithBody(ctx, organizationID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiV1OrganizationsOrganizationIDProjectsResponse(rsp)
-}
+	// GetApiV1AclWithResponse request
+	GetApiV1AclWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AclResponse, error)
 
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDProjectsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDProjects(ctx, organizationID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiV1OrganizationsOrganizationIDProjectsResponse(rsp)
-}
+	// PostApiV1Oauth2clientsClientIDRotateWithResponse request
+	PostApiV1Oauth2clientsClientIDRotateWithResponse(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*PostApiV1Oauth2clientsClientIDRotateResponse, error)
 
-// DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse
-func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
-	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDProjectsProjectID(ctx, organizationID, projectID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp)
-}
+	// GetApiV1Oauth2providersWithResponse request
+	GetApiV1Oauth2providersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1Oauth2providersResponse, error)
 
-// GetApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse request returning *GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDProjectsProjectID(ctx, organizationID, projectID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp)
-}
+	// GetApiV1OrganizationsWithResponse request
+	GetApiV1OrganizationsWithResponse(ctx context.Context, params *GetApiV1OrganizationsParams, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsResponse, error)
 
-// PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse
+	// PostApiV1OrganizationsWithBodyWithResponse request with any body
+	PostApiV1OrganizationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsResponse, error)
+
+	PostApiV1OrganizationsWithResponse(ctx context.Context, body PostApiV1OrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDWithResponse request
+	GetApiV1OrganizationsOrganizationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDResponse, error)
+
+	// PutApiV1OrganizationsOrganizationIDWithBodyWithResponse request with any body
+	PutApiV1OrganizationsOrganizationIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDResponse, error)
+
+	PutApiV1OrganizationsOrganizationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDAclWithResponse request
+	GetApiV1OrganizationsOrganizationIDAclWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDAclResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDAllocationsWithResponse request
+	GetApiV1OrganizationsOrganizationIDAllocationsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDAllocationsResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDGroupsWithResponse request
+	GetApiV1OrganizationsOrganizationIDGroupsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDGroupsResponse, error)
+
+	// PostApiV1OrganizationsOrganizationIDGroupsWithBodyWithResponse request with any body
+	PostApiV1OrganizationsOrganizationIDGroupsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDGroupsResponse, error)
+
+	PostApiV1OrganizationsOrganizationIDGroupsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDGroupsResponse, error)
+
+	// DeleteApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse request
+	DeleteApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse request
+	GetApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error)
+
+	// PutApiV1OrganizationsOrganizationIDGroupsGroupidWithBodyWithResponse request with any body
+	PutApiV1OrganizationsOrganizationIDGroupsGroupidWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error)
+
+	PutApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, body PutApiV1OrganizationsOrganizationIDGroupsGroupidJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDOauth2providersWithResponse request
+	GetApiV1OrganizationsOrganizationIDOauth2providersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDOauth2providersResponse, error)
+
+	// PostApiV1OrganizationsOrganizationIDOauth2providersWithBodyWithResponse request with any body
+	PostApiV1OrganizationsOrganizationIDOauth2providersWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersResponse, error)
+
+	PostApiV1OrganizationsOrganizationIDOauth2providersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersResponse, error)
+
+	// DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse request
+	DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error)
+
+	// PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithBodyWithResponse request with any body
+	PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error)
+
+	PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error)
+
+	// PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithBodyWithResponse request with any body
+	PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse, error)
+
+	PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDProjectsWithResponse request
+	GetApiV1OrganizationsOrganizationIDProjectsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsResponse, error)
+
+	// PostApiV1OrganizationsOrganizationIDProjectsWithBodyWithResponse request with any body
+	PostApiV1OrganizationsOrganizationIDProjectsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsResponse, error)
+
+	PostApiV1OrganizationsOrganizationIDProjectsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsResponse, error)
+
+	// DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse request
+	DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse request
+	GetApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error)
+
+	// PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithBodyWithResponse request with any body
+	PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error)
+
+	PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error)
+
+	// PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithBodyWithResponse request with any body
+	PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse, error)
+
+	PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse, error)
+
+	// DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse request
+	DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse request
+	GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error)
+
+	// PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithBodyWithResponse request with any body
+	PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error)
+
+	PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDQuotasWithResponse request
+	GetApiV1OrganizationsOrganizationIDQuotasWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDQuotasResponse, error)
+
+	// PutApiV1OrganizationsOrganizationIDQuotasWithBodyWithResponse request with any body
+	PutApiV1OrganizationsOrganizationIDQuotasWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDQuotasResponse, error)
+
+	PutApiV1OrganizationsOrganizationIDQuotasWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDQuotasJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDQuotasResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDRolesWithResponse request
+	GetApiV1OrganizationsOrganizationIDRolesWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDRolesResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDServiceaccountsWithResponse request
+	GetApiV1OrganizationsOrganizationIDServiceaccountsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDServiceaccountsResponse, error)
+
+	// PostApiV1OrganizationsOrganizationIDServiceaccountsWithBodyWithResponse request with any body
+	PostApiV1OrganizationsOrganizationIDServiceaccountsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsResponse, error)
+
+	PostApiV1OrganizationsOrganizationIDServiceaccountsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDServiceaccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsResponse, error)
+
+	// DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse request
+	DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error)
+
+	// PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithBodyWithResponse request with any body
+	PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error)
+
+	PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, body PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error)
+
+	// PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateWithResponse request
+	PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDUsersWithResponse request
+	GetApiV1OrganizationsOrganizationIDUsersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDUsersResponse, error)
+
+	// PostApiV1OrganizationsOrganizationIDUsersWithBodyWithResponse request with any body
+	PostApiV1OrganizationsOrganizationIDUsersWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDUsersResponse, error)
+
+	PostApiV1OrganizationsOrganizationIDUsersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDUsersResponse, error)
+
+	// DeleteApiV1OrganizationsOrganizationIDUsersUserIDWithResponse request
+	DeleteApiV1OrganizationsOrganizationIDUsersUserIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse, error)
+
+	// PutApiV1OrganizationsOrganizationIDUsersUserIDWithBodyWithResponse request with any body
+	PutApiV1OrganizationsOrganizationIDUsersUserIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDUsersUserIDResponse, error)
+
+	PutApiV1OrganizationsOrganizationIDUsersUserIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, body PutApiV1OrganizationsOrganizationIDUsersUserIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDUsersUserIDResponse, error)
+
+	// DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsWithResponse request
+	DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse, error)
+
+	// GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsWithResponse request
+	GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse, error)
+
+	// DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDWithResponse request
+	DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, sessionID SessionIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse, error)
+
+	// GetApiV1RevocationsWithResponse request
+	GetApiV1RevocationsWithResponse(ctx context.Context, params *GetApiV1RevocationsParams, reqEditors ...RequestEditorFn) (*GetApiV1RevocationsResponse, error)
+
+	// DeleteApiV1SessionsWithResponse request
+	DeleteApiV1SessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiV1SessionsResponse, error)
+
+	// GetApiV1SessionsWithResponse request
+	GetApiV1SessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1SessionsResponse, error)
+
+	// DeleteApiV1SessionsSessionIDWithResponse request
+	DeleteApiV1SessionsSessionIDWithResponse(ctx context.Context, sessionID SessionIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1SessionsSessionIDResponse, error)
+
+	// GetApiV1SignupWithResponse request
+	GetApiV1SignupWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1SignupResponse, error)
+
+	// GetOauth2V2AuthorizationWithResponse request
+	GetOauth2V2AuthorizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2AuthorizationResponse, error)
+
+	// PostOauth2V2AuthorizationWithBodyWithResponse request with any body
+	PostOauth2V2AuthorizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2AuthorizationResponse, error)
+
+	PostOauth2V2AuthorizationWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2AuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2AuthorizationResponse, error)
+
+	// GetOauth2V2DeviceWithResponse request
+	GetOauth2V2DeviceWithResponse(ctx context.Context, params *GetOauth2V2DeviceParams, reqEditors ...RequestEditorFn) (*GetOauth2V2DeviceResponse, error)
+
+	// PostOauth2V2DeviceWithBodyWithResponse request with any body
+	PostOauth2V2DeviceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceResponse, error)
+
+	PostOauth2V2DeviceWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2DeviceFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceResponse, error)
+
+	// PostOauth2V2DeviceApproveWithBodyWithResponse request with any body
+	PostOauth2V2DeviceApproveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceApproveResponse, error)
+
+	PostOauth2V2DeviceApproveWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2DeviceApproveFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceApproveResponse, error)
+
+	// PostOauth2V2DeviceAuthorizationWithBodyWithResponse request with any body
+	PostOauth2V2DeviceAuthorizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceAuthorizationResponse, error)
+
+	PostOauth2V2DeviceAuthorizationWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2DeviceAuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceAuthorizationResponse, error)
+
+	// PostOauth2V2IntrospectWithBodyWithResponse request with any body
+	PostOauth2V2IntrospectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2IntrospectResponse, error)
+
+	PostOauth2V2IntrospectWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2IntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2IntrospectResponse, error)
+
+	// GetOauth2V2JwksWithResponse request
+	GetOauth2V2JwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2JwksResponse, error)
+
+	// PostOauth2V2LoginWithBodyWithResponse request with any body
+	PostOauth2V2LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2LoginResponse, error)
+
+	PostOauth2V2LoginWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2LoginResponse, error)
+
+	// GetOauth2V2LogoutWithResponse request
+	GetOauth2V2LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2LogoutResponse, error)
+
+	// PostOauth2V2LogoutWithBodyWithResponse request with any body
+	PostOauth2V2LogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2LogoutResponse, error)
+
+	PostOauth2V2LogoutWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2LogoutFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2LogoutResponse, error)
+
+	// PostOauth2V2OnboardWithBodyWithResponse request with any body
+	PostOauth2V2OnboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2OnboardResponse, error)
+
+	PostOauth2V2OnboardWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2OnboardFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2OnboardResponse, error)
+
+	// PostOauth2V2ParWithBodyWithResponse request with any body
+	PostOauth2V2ParWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2ParResponse, error)
+
+	PostOauth2V2ParWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2ParFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2ParResponse, error)
+
+	// PostOauth2V2RegisterWithBodyWithResponse request with any body
+	PostOauth2V2RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2RegisterResponse, error)
+
+	PostOauth2V2RegisterWithResponse(ctx context.Context, body PostOauth2V2RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2RegisterResponse, error)
+
+	// DeleteOauth2V2RegisterClientIDWithResponse request
+	DeleteOauth2V2RegisterClientIDWithResponse(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*DeleteOauth2V2RegisterClientIDResponse, error)
+
+	// GetOauth2V2RegisterClientIDWithResponse request
+	GetOauth2V2RegisterClientIDWithResponse(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*GetOauth2V2RegisterClientIDResponse, error)
+
+	// PutOauth2V2RegisterClientIDWithBodyWithResponse request with any body
+	PutOauth2V2RegisterClientIDWithBodyWithResponse(ctx context.Context, clientID ClientIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOauth2V2RegisterClientIDResponse, error)
+
+	PutOauth2V2RegisterClientIDWithResponse(ctx context.Context, clientID ClientIDParameter, body PutOauth2V2RegisterClientIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOauth2V2RegisterClientIDResponse, error)
+
+	// PostOauth2V2RevokeWithBodyWithResponse request with any body
+	PostOauth2V2RevokeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2RevokeResponse, error)
+
+	PostOauth2V2RevokeWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2RevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2RevokeResponse, error)
+
+	// PostOauth2V2TokenWithBodyWithResponse request with any body
+	PostOauth2V2TokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2TokenResponse, error)
+
+	PostOauth2V2TokenWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2TokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2TokenResponse, error)
+
+	// GetOauth2V2UserinfoWithResponse request
+	GetOauth2V2UserinfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2UserinfoResponse, error)
+
+	// PostOauth2V2UserinfoWithBodyWithResponse request with any body
+	PostOauth2V2UserinfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2UserinfoResponse, error)
+
+	PostOauth2V2UserinfoWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2UserinfoFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2UserinfoResponse, error)
+
+	// GetOidcCallbackWithResponse request
+	GetOidcCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOidcCallbackResponse, error)
+
+	// PostSamlAcsWithBodyWithResponse request with any body
+	PostSamlAcsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSamlAcsResponse, error)
+
+	PostSamlAcsWithFormdataBodyWithResponse(ctx context.Context, body PostSamlAcsFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostSamlAcsResponse, error)
+
+	// GetSamlMetadataWithResponse request
+	GetSamlMetadataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSamlMetadataResponse, error)
+}
+
+type GetWellKnownOpenidConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenidConfigurationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWellKnownOpenidConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWellKnownOpenidConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AclResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AclResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AclResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AclResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1Oauth2clientsClientIDRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Oauth2ClientSecretResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1Oauth2clientsClientIDRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1Oauth2clientsClientIDRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1Oauth2providersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SystemOauth2ProvidersResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1Oauth2providersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1Oauth2providersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationsResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1OrganizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *OrganizationRead
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1OrganizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1OrganizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1OrganizationsOrganizationIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1OrganizationsOrganizationIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1OrganizationsOrganizationIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDAclResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AclResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDAclResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDAclResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDAllocationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AllocationsResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDAllocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDAllocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GroupsResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1OrganizationsOrganizationIDGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *GroupResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON409      *externalRef0.ConflictResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1OrganizationsOrganizationIDGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1OrganizationsOrganizationIDGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GroupResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDOauth2providersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Oauth2ProvidersResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDOauth2providersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDOauth2providersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1OrganizationsOrganizationIDOauth2providersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Oauth2ProviderResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON409      *externalRef0.ConflictResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1OrganizationsOrganizationIDOauth2providersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1OrganizationsOrganizationIDOauth2providersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GroupMappingResultsResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectsResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1OrganizationsOrganizationIDProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ProjectResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON409      *externalRef0.ConflictResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1OrganizationsOrganizationIDProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1OrganizationsOrganizationIDProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AllocationResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON409      *externalRef0.ConflictResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AllocationResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AllocationResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDQuotasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QuotasResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDQuotasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDQuotasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1OrganizationsOrganizationIDQuotasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QuotasResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1OrganizationsOrganizationIDQuotasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1OrganizationsOrganizationIDQuotasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDRolesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RolesResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDServiceaccountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceAccountsResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDServiceaccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDServiceaccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1OrganizationsOrganizationIDServiceaccountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ServiceAccountCreateResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1OrganizationsOrganizationIDServiceaccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1OrganizationsOrganizationIDServiceaccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceAccountResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceAccountCreateResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UsersResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1OrganizationsOrganizationIDUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UserResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON409      *externalRef0.ConflictResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1OrganizationsOrganizationIDUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1OrganizationsOrganizationIDUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1OrganizationsOrganizationIDUsersUserIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1OrganizationsOrganizationIDUsersUserIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1OrganizationsOrganizationIDUsersUserIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionsResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1RevocationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevocationsResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1RevocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1RevocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1SessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1SessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1SessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1SessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionsResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1SessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1SessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1SessionsSessionIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON404      *externalRef0.NotFoundResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1SessionsSessionIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1SessionsSessionIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1SignupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1SignupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1SignupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOauth2V2AuthorizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOauth2V2AuthorizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOauth2V2AuthorizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2AuthorizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2AuthorizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2AuthorizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOauth2V2DeviceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOauth2V2DeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOauth2V2DeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2DeviceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2DeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2DeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2DeviceApproveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2DeviceApproveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2DeviceApproveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2DeviceAuthorizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeviceAuthorizationResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2DeviceAuthorizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2DeviceAuthorizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2IntrospectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IntrospectionResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2IntrospectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2IntrospectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOauth2V2JwksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JwksResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOauth2V2JwksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOauth2V2JwksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2LoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOauth2V2LogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOauth2V2LogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOauth2V2LogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2LogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2LogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2LogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2OnboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2OnboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2OnboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2ParResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PushedAuthorizationResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2ParResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2ParResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2RegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ClientInformationResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON403      *externalRef0.ForbiddenResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2RegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2RegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteOauth2V2RegisterClientIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOauth2V2RegisterClientIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOauth2V2RegisterClientIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOauth2V2RegisterClientIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientInformationResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOauth2V2RegisterClientIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOauth2V2RegisterClientIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutOauth2V2RegisterClientIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientInformationResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutOauth2V2RegisterClientIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutOauth2V2RegisterClientIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2RevokeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2RevokeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2RevokeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2TokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenResponse
+	JSON400      *externalRef0.BadRequestResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2TokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2TokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOauth2V2UserinfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserinfoResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOauth2V2UserinfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOauth2V2UserinfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOauth2V2UserinfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserinfoResponse
+	JSON401      *externalRef0.UnauthorizedResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOauth2V2UserinfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOauth2V2UserinfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOidcCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOidcCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOidcCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSamlAcsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSamlAcsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSamlAcsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSamlMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSamlMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSamlMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetWellKnownOpenidConfigurationWithResponse request returning *GetWellKnownOpenidConfigurationResponse
+func (c *ClientWithResponses) GetWellKnownOpenidConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownOpenidConfigurationResponse, error) {
+	rsp, err := c.GetWellKnownOpenidConfiguration(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWellKnownOpenidConfigurationResponse(rsp)
+}
+
+// GetApiV1AclWithResponse request returning *GetApiV1AclResponse
+func (c *ClientWithResponses) GetApiV1AclWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AclResponse, error) {
+	rsp, err := c.GetApiV1Acl(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AclResponse(rsp)
+}
+
+// PostApiV1Oauth2clientsClientIDRotateWithResponse request returning *PostApiV1Oauth2clientsClientIDRotateResponse
+func (c *ClientWithResponses) PostApiV1Oauth2clientsClientIDRotateWithResponse(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*PostApiV1Oauth2clientsClientIDRotateResponse, error) {
+	rsp, err := c.PostApiV1Oauth2clientsClientIDRotate(ctx, clientID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1Oauth2clientsClientIDRotateResponse(rsp)
+}
+
+// GetApiV1Oauth2providersWithResponse request returning *GetApiV1Oauth2providersResponse
+func (c *ClientWithResponses) GetApiV1Oauth2providersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1Oauth2providersResponse, error) {
+	rsp, err := c.GetApiV1Oauth2providers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1Oauth2providersResponse(rsp)
+}
+
+// GetApiV1OrganizationsWithResponse request returning *GetApiV1OrganizationsResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsWithResponse(ctx context.Context, params *GetApiV1OrganizationsParams, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsResponse, error) {
+	rsp, err := c.GetApiV1Organizations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsResponse(rsp)
+}
+
+// PostApiV1OrganizationsWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsResponse
+func (c *ClientWithResponses) PostApiV1OrganizationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1OrganizationsWithResponse(ctx context.Context, body PostApiV1OrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsResponse, error) {
+	rsp, err := c.PostApiV1Organizations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDWithResponse request returning *GetApiV1OrganizationsOrganizationIDResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationID(ctx, organizationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDResponse(rsp)
+}
+
+// PutApiV1OrganizationsOrganizationIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDResponse
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDWithBody(ctx, organizationID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationID(ctx, organizationID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDAclWithResponse request returning *GetApiV1OrganizationsOrganizationIDAclResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDAclWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDAclResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDAcl(ctx, organizationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDAclResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDAllocationsWithResponse request returning *GetApiV1OrganizationsOrganizationIDAllocationsResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDAllocationsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDAllocationsResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDAllocations(ctx, organizationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDAllocationsResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDGroupsWithResponse request returning *GetApiV1OrganizationsOrganizationIDGroupsResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDGroupsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDGroupsResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDGroups(ctx, organizationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDGroupsResponse(rsp)
+}
+
+// PostApiV1OrganizationsOrganizationIDGroupsWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDGroupsResponse
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDGroupsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDGroupsResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDGroupsWithBody(ctx, organizationID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDGroupsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDGroupsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDGroupsResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDGroups(ctx, organizationID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDGroupsResponse(rsp)
+}
+
+// DeleteApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse
+func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
+	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDGroupsGroupid(ctx, organizationID, groupid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse request returning *GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDGroupsGroupid(ctx, organizationID, groupid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp)
+}
+
+// PutApiV1OrganizationsOrganizationIDGroupsGroupidWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDGroupsGroupidWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDGroupsGroupidWithBody(ctx, organizationID, groupid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse(ctx context.Context, organizationID OrganizationIDParameter, groupid GroupidParameter, body PutApiV1OrganizationsOrganizationIDGroupsGroupidJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDGroupsGroupid(ctx, organizationID, groupid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDOauth2providersWithResponse request returning *GetApiV1OrganizationsOrganizationIDOauth2providersResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDOauth2providersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDOauth2providersResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDOauth2providers(ctx, organizationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp)
+}
+
+// PostApiV1OrganizationsOrganizationIDOauth2providersWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDOauth2providersResponse
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDOauth2providersWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDOauth2providersWithBody(ctx, organizationID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDOauth2providersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDOauth2providers(ctx, organizationID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp)
+}
+
+// DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse
+func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error) {
+	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderID(ctx, organizationID, providerID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(rsp)
+}
+
+// PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithBody(ctx, organizationID, providerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDOauth2providersProviderID(ctx, organizationID, providerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(rsp)
+}
+
+// PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithBody(ctx, organizationID, providerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithResponse(ctx context.Context, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter, body PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun(ctx, organizationID, providerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDProjectsWithResponse request returning *GetApiV1OrganizationsOrganizationIDProjectsResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDProjectsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDProjects(ctx, organizationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDProjectsResponse(rsp)
+}
+
+// PostApiV1OrganizationsOrganizationIDProjectsWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDProjectsResponse
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDProjectsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDProjectsWithBody(ctx, organizationID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDProjectsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDProjectsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDProjects(ctx, organizationID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDProjectsResponse(rsp)
+}
+
+// DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse
+func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
+	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDProjectsProjectID(ctx, organizationID, projectID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse request returning *GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDProjectsProjectID(ctx, organizationID, projectID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp)
+}
+
+// PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse
 func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
 	rsp, err := c.PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithBody(ctx, organizationID, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp)
+	return ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDProjectsProjectID(ctx, organizationID, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp)
+}
+
+// PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithBody(ctx, organizationID, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocations(ctx, organizationID, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse(rsp)
+}
+
+// DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse
+func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
+	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID(ctx, organizationID, projectID, allocationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse request returning *GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID(ctx, organizationID, projectID, allocationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp)
+}
+
+// PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithBody(ctx, organizationID, projectID, allocationID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID(ctx, organizationID, projectID, allocationID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDQuotasWithResponse request returning *GetApiV1OrganizationsOrganizationIDQuotasResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDQuotasWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDQuotasResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDQuotas(ctx, organizationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDQuotasResponse(rsp)
+}
+
+// PutApiV1OrganizationsOrganizationIDQuotasWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDQuotasResponse
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDQuotasWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDQuotasResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDQuotasWithBody(ctx, organizationID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDQuotasResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDQuotasWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDQuotasJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDQuotasResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDQuotas(ctx, organizationID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDQuotasResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDRolesWithResponse request returning *GetApiV1OrganizationsOrganizationIDRolesResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDRolesWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDRolesResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDRoles(ctx, organizationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDRolesResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDServiceaccountsWithResponse request returning *GetApiV1OrganizationsOrganizationIDServiceaccountsResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDServiceaccountsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDServiceaccountsResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDServiceaccounts(ctx, organizationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp)
+}
+
+// PostApiV1OrganizationsOrganizationIDServiceaccountsWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDServiceaccountsResponse
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDServiceaccountsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDServiceaccountsWithBody(ctx, organizationID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDServiceaccountsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDServiceaccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDServiceaccounts(ctx, organizationID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp)
+}
+
+// DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse
+func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error) {
+	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID(ctx, organizationID, serviceAccountID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse(rsp)
+}
+
+// PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithBody(ctx, organizationID, serviceAccountID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, body PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID(ctx, organizationID, serviceAccountID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse(rsp)
+}
+
+// PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateWithResponse request returning *PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotate(ctx, organizationID, serviceAccountID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDUsersWithResponse request returning *GetApiV1OrganizationsOrganizationIDUsersResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDUsersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDUsersResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDUsers(ctx, organizationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDUsersResponse(rsp)
+}
+
+// PostApiV1OrganizationsOrganizationIDUsersWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDUsersResponse
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDUsersWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDUsersResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDUsersWithBody(ctx, organizationID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDUsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDUsersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDUsersResponse, error) {
+	rsp, err := c.PostApiV1OrganizationsOrganizationIDUsers(ctx, organizationID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1OrganizationsOrganizationIDUsersResponse(rsp)
+}
+
+// DeleteApiV1OrganizationsOrganizationIDUsersUserIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse
+func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDUsersUserIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse, error) {
+	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDUsersUserID(ctx, organizationID, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp)
+}
+
+// PutApiV1OrganizationsOrganizationIDUsersUserIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDUsersUserIDResponse
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDUsersUserIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDUsersUserIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDUsersUserIDWithBody(ctx, organizationID, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDUsersUserIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, body PutApiV1OrganizationsOrganizationIDUsersUserIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDUsersUserIDResponse, error) {
+	rsp, err := c.PutApiV1OrganizationsOrganizationIDUsersUserID(ctx, organizationID, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp)
+}
+
+// DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse
+func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse, error) {
+	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions(ctx, organizationID, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse(rsp)
+}
+
+// GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsWithResponse request returning *GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse
+func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse, error) {
+	rsp, err := c.GetApiV1OrganizationsOrganizationIDUsersUserIDSessions(ctx, organizationID, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse(rsp)
+}
+
+// DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse
+func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, sessionID SessionIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse, error) {
+	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID(ctx, organizationID, userID, sessionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse(rsp)
+}
+
+// GetApiV1RevocationsWithResponse request returning *GetApiV1RevocationsResponse
+func (c *ClientWithResponses) GetApiV1RevocationsWithResponse(ctx context.Context, params *GetApiV1RevocationsParams, reqEditors ...RequestEditorFn) (*GetApiV1RevocationsResponse, error) {
+	rsp, err := c.GetApiV1Revocations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1RevocationsResponse(rsp)
+}
+
+// DeleteApiV1SessionsWithResponse request returning *DeleteApiV1SessionsResponse
+func (c *ClientWithResponses) DeleteApiV1SessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiV1SessionsResponse, error) {
+	rsp, err := c.DeleteApiV1Sessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1SessionsResponse(rsp)
+}
+
+// GetApiV1SessionsWithResponse request returning *GetApiV1SessionsResponse
+func (c *ClientWithResponses) GetApiV1SessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1SessionsResponse, error) {
+	rsp, err := c.GetApiV1Sessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1SessionsResponse(rsp)
+}
+
+// DeleteApiV1SessionsSessionIDWithResponse request returning *DeleteApiV1SessionsSessionIDResponse
+func (c *ClientWithResponses) DeleteApiV1SessionsSessionIDWithResponse(ctx context.Context, sessionID SessionIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1SessionsSessionIDResponse, error) {
+	rsp, err := c.DeleteApiV1SessionsSessionID(ctx, sessionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1SessionsSessionIDResponse(rsp)
+}
+
+// GetApiV1SignupWithResponse request returning *GetApiV1SignupResponse
+func (c *ClientWithResponses) GetApiV1SignupWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1SignupResponse, error) {
+	rsp, err := c.GetApiV1Signup(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1SignupResponse(rsp)
+}
+
+// GetOauth2V2AuthorizationWithResponse request returning *GetOauth2V2AuthorizationResponse
+func (c *ClientWithResponses) GetOauth2V2AuthorizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2AuthorizationResponse, error) {
+	rsp, err := c.GetOauth2V2Authorization(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOauth2V2AuthorizationResponse(rsp)
+}
+
+// PostOauth2V2AuthorizationWithBodyWithResponse request with arbitrary body returning *PostOauth2V2AuthorizationResponse
+func (c *ClientWithResponses) PostOauth2V2AuthorizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2AuthorizationResponse, error) {
+	rsp, err := c.PostOauth2V2AuthorizationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2AuthorizationResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2AuthorizationWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2AuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2AuthorizationResponse, error) {
+	rsp, err := c.PostOauth2V2AuthorizationWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2AuthorizationResponse(rsp)
+}
+
+// GetOauth2V2DeviceWithResponse request returning *GetOauth2V2DeviceResponse
+func (c *ClientWithResponses) GetOauth2V2DeviceWithResponse(ctx context.Context, params *GetOauth2V2DeviceParams, reqEditors ...RequestEditorFn) (*GetOauth2V2DeviceResponse, error) {
+	rsp, err := c.GetOauth2V2Device(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOauth2V2DeviceResponse(rsp)
+}
+
+// PostOauth2V2DeviceWithBodyWithResponse request with arbitrary body returning *PostOauth2V2DeviceResponse
+func (c *ClientWithResponses) PostOauth2V2DeviceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceResponse, error) {
+	rsp, err := c.PostOauth2V2DeviceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2DeviceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2DeviceWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2DeviceFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceResponse, error) {
+	rsp, err := c.PostOauth2V2DeviceWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2DeviceResponse(rsp)
+}
+
+// PostOauth2V2DeviceApproveWithBodyWithResponse request with arbitrary body returning *PostOauth2V2DeviceApproveResponse
+func (c *ClientWithResponses) PostOauth2V2DeviceApproveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceApproveResponse, error) {
+	rsp, err := c.PostOauth2V2DeviceApproveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2DeviceApproveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2DeviceApproveWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2DeviceApproveFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceApproveResponse, error) {
+	rsp, err := c.PostOauth2V2DeviceApproveWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2DeviceApproveResponse(rsp)
+}
+
+// PostOauth2V2DeviceAuthorizationWithBodyWithResponse request with arbitrary body returning *PostOauth2V2DeviceAuthorizationResponse
+func (c *ClientWithResponses) PostOauth2V2DeviceAuthorizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceAuthorizationResponse, error) {
+	rsp, err := c.PostOauth2V2DeviceAuthorizationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2DeviceAuthorizationResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2DeviceAuthorizationWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2DeviceAuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2DeviceAuthorizationResponse, error) {
+	rsp, err := c.PostOauth2V2DeviceAuthorizationWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2DeviceAuthorizationResponse(rsp)
+}
+
+// PostOauth2V2IntrospectWithBodyWithResponse request with arbitrary body returning *PostOauth2V2IntrospectResponse
+func (c *ClientWithResponses) PostOauth2V2IntrospectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2IntrospectResponse, error) {
+	rsp, err := c.PostOauth2V2IntrospectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2IntrospectResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2IntrospectWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2IntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2IntrospectResponse, error) {
+	rsp, err := c.PostOauth2V2IntrospectWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2IntrospectResponse(rsp)
+}
+
+// GetOauth2V2JwksWithResponse request returning *GetOauth2V2JwksResponse
+func (c *ClientWithResponses) GetOauth2V2JwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2JwksResponse, error) {
+	rsp, err := c.GetOauth2V2Jwks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOauth2V2JwksResponse(rsp)
+}
+
+// PostOauth2V2LoginWithBodyWithResponse request with arbitrary body returning *PostOauth2V2LoginResponse
+func (c *ClientWithResponses) PostOauth2V2LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2LoginResponse, error) {
+	rsp, err := c.PostOauth2V2LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2LoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2LoginWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2LoginResponse, error) {
+	rsp, err := c.PostOauth2V2LoginWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2LoginResponse(rsp)
+}
+
+// GetOauth2V2LogoutWithResponse request returning *GetOauth2V2LogoutResponse
+func (c *ClientWithResponses) GetOauth2V2LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2LogoutResponse, error) {
+	rsp, err := c.GetOauth2V2Logout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOauth2V2LogoutResponse(rsp)
+}
+
+// PostOauth2V2LogoutWithBodyWithResponse request with arbitrary body returning *PostOauth2V2LogoutResponse
+func (c *ClientWithResponses) PostOauth2V2LogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2LogoutResponse, error) {
+	rsp, err := c.PostOauth2V2LogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2LogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2LogoutWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2LogoutFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2LogoutResponse, error) {
+	rsp, err := c.PostOauth2V2LogoutWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2LogoutResponse(rsp)
+}
+
+// PostOauth2V2OnboardWithBodyWithResponse request with arbitrary body returning *PostOauth2V2OnboardResponse
+func (c *ClientWithResponses) PostOauth2V2OnboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2OnboardResponse, error) {
+	rsp, err := c.PostOauth2V2OnboardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2OnboardResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2OnboardWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2OnboardFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2OnboardResponse, error) {
+	rsp, err := c.PostOauth2V2OnboardWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2OnboardResponse(rsp)
+}
+
+// PostOauth2V2ParWithBodyWithResponse request with arbitrary body returning *PostOauth2V2ParResponse
+func (c *ClientWithResponses) PostOauth2V2ParWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2ParResponse, error) {
+	rsp, err := c.PostOauth2V2ParWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2ParResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2ParWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2ParFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2ParResponse, error) {
+	rsp, err := c.PostOauth2V2ParWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2ParResponse(rsp)
+}
+
+// PostOauth2V2RegisterWithBodyWithResponse request with arbitrary body returning *PostOauth2V2RegisterResponse
+func (c *ClientWithResponses) PostOauth2V2RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2RegisterResponse, error) {
+	rsp, err := c.PostOauth2V2RegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2RegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2RegisterWithResponse(ctx context.Context, body PostOauth2V2RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2RegisterResponse, error) {
+	rsp, err := c.PostOauth2V2Register(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2RegisterResponse(rsp)
+}
+
+// DeleteOauth2V2RegisterClientIDWithResponse request returning *DeleteOauth2V2RegisterClientIDResponse
+func (c *ClientWithResponses) DeleteOauth2V2RegisterClientIDWithResponse(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*DeleteOauth2V2RegisterClientIDResponse, error) {
+	rsp, err := c.DeleteOauth2V2RegisterClientID(ctx, clientID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOauth2V2RegisterClientIDResponse(rsp)
+}
+
+// GetOauth2V2RegisterClientIDWithResponse request returning *GetOauth2V2RegisterClientIDResponse
+func (c *ClientWithResponses) GetOauth2V2RegisterClientIDWithResponse(ctx context.Context, clientID ClientIDParameter, reqEditors ...RequestEditorFn) (*GetOauth2V2RegisterClientIDResponse, error) {
+	rsp, err := c.GetOauth2V2RegisterClientID(ctx, clientID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOauth2V2RegisterClientIDResponse(rsp)
+}
+
+// PutOauth2V2RegisterClientIDWithBodyWithResponse request with arbitrary body returning *PutOauth2V2RegisterClientIDResponse
+func (c *ClientWithResponses) PutOauth2V2RegisterClientIDWithBodyWithResponse(ctx context.Context, clientID ClientIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOauth2V2RegisterClientIDResponse, error) {
+	rsp, err := c.PutOauth2V2RegisterClientIDWithBody(ctx, clientID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOauth2V2RegisterClientIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutOauth2V2RegisterClientIDWithResponse(ctx context.Context, clientID ClientIDParameter, body PutOauth2V2RegisterClientIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOauth2V2RegisterClientIDResponse, error) {
+	rsp, err := c.PutOauth2V2RegisterClientID(ctx, clientID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOauth2V2RegisterClientIDResponse(rsp)
+}
+
+// PostOauth2V2RevokeWithBodyWithResponse request with arbitrary body returning *PostOauth2V2RevokeResponse
+func (c *ClientWithResponses) PostOauth2V2RevokeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2RevokeResponse, error) {
+	rsp, err := c.PostOauth2V2RevokeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2RevokeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2RevokeWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2RevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2RevokeResponse, error) {
+	rsp, err := c.PostOauth2V2RevokeWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2RevokeResponse(rsp)
+}
+
+// PostOauth2V2TokenWithBodyWithResponse request with arbitrary body returning *PostOauth2V2TokenResponse
+func (c *ClientWithResponses) PostOauth2V2TokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2TokenResponse, error) {
+	rsp, err := c.PostOauth2V2TokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2TokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2TokenWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2TokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2TokenResponse, error) {
+	rsp, err := c.PostOauth2V2TokenWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2TokenResponse(rsp)
+}
+
+// GetOauth2V2UserinfoWithResponse request returning *GetOauth2V2UserinfoResponse
+func (c *ClientWithResponses) GetOauth2V2UserinfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2UserinfoResponse, error) {
+	rsp, err := c.GetOauth2V2Userinfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOauth2V2UserinfoResponse(rsp)
+}
+
+// PostOauth2V2UserinfoWithBodyWithResponse request with arbitrary body returning *PostOauth2V2UserinfoResponse
+func (c *ClientWithResponses) PostOauth2V2UserinfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2UserinfoResponse, error) {
+	rsp, err := c.PostOauth2V2UserinfoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2UserinfoResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOauth2V2UserinfoWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2UserinfoFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2UserinfoResponse, error) {
+	rsp, err := c.PostOauth2V2UserinfoWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOauth2V2UserinfoResponse(rsp)
+}
+
+// GetOidcCallbackWithResponse request returning *GetOidcCallbackResponse
+func (c *ClientWithResponses) GetOidcCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOidcCallbackResponse, error) {
+	rsp, err := c.GetOidcCallback(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOidcCallbackResponse(rsp)
+}
+
+// PostSamlAcsWithBodyWithResponse request with arbitrary body returning *PostSamlAcsResponse
+func (c *ClientWithResponses) PostSamlAcsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSamlAcsResponse, error) {
+	rsp, err := c.PostSamlAcsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSamlAcsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSamlAcsWithFormdataBodyWithResponse(ctx context.Context, body PostSamlAcsFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostSamlAcsResponse, error) {
+	rsp, err := c.PostSamlAcsWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSamlAcsResponse(rsp)
+}
+
+// GetSamlMetadataWithResponse request returning *GetSamlMetadataResponse
+func (c *ClientWithResponses) GetSamlMetadataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSamlMetadataResponse, error) {
+	rsp, err := c.GetSamlMetadata(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSamlMetadataResponse(rsp)
+}
+
+// ParseGetWellKnownOpenidConfigurationResponse parses an HTTP response from a GetWellKnownOpenidConfigurationWithResponse call
+func ParseGetWellKnownOpenidConfigurationResponse(rsp *http.Response) (*GetWellKnownOpenidConfigurationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownOpenidConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OpenidConfigurationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AclResponse parses an HTTP response from a GetApiV1AclWithResponse call
+func ParseGetApiV1AclResponse(rsp *http.Response) (*GetApiV1AclResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AclResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AclResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1Oauth2clientsClientIDRotateResponse parses an HTTP response from a PostApiV1Oauth2clientsClientIDRotateWithResponse call
+func ParsePostApiV1Oauth2clientsClientIDRotateResponse(rsp *http.Response) (*PostApiV1Oauth2clientsClientIDRotateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1Oauth2clientsClientIDRotateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Oauth2ClientSecretResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1Oauth2providersResponse parses an HTTP response from a GetApiV1Oauth2providersWithResponse call
+func ParseGetApiV1Oauth2providersResponse(rsp *http.Response) (*GetApiV1Oauth2providersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1Oauth2providersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SystemOauth2ProvidersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1OrganizationsResponse parses an HTTP response from a GetApiV1OrganizationsWithResponse call
+func ParseGetApiV1OrganizationsResponse(rsp *http.Response) (*GetApiV1OrganizationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1OrganizationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1OrganizationsResponse parses an HTTP response from a PostApiV1OrganizationsWithResponse call
+func ParsePostApiV1OrganizationsResponse(rsp *http.Response) (*PostApiV1OrganizationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1OrganizationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest OrganizationRead
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDProjectsProjectID(ctx, organizationID, projectID, body, reqEditors...)
+// ParseGetApiV1OrganizationsOrganizationIDResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp)
+
+	response := &GetApiV1OrganizationsOrganizationIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithBody(ctx, organizationID, projectID, contentType, body, reqEditors...)
+// ParsePutApiV1OrganizationsOrganizationIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDWithResponse call
+func ParsePutApiV1OrganizationsOrganizationIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse(rsp)
+
+	response := &PutApiV1OrganizationsOrganizationIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1OrganizationsOrganizationIDAclResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDAclWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDAclResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDAclResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1OrganizationsOrganizationIDAclResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AclResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, body PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocations(ctx, organizationID, projectID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ParseGetApiV1OrganizationsOrganizationIDAllocationsResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDAllocationsWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDAllocationsResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDAllocationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1OrganizationsOrganizationIDAllocationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AllocationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse(rsp)
-}
 
-// DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse
-func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
-	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID(ctx, organizationID, projectID, allocationID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp)
+	return response, nil
 }
 
-// GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse request returning *GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID(ctx, organizationID, projectID, allocationID, reqEditors...)
+// ParseGetApiV1OrganizationsOrganizationIDGroupsResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDGroupsWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDGroupsResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp)
-}
 
-// PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithBody(ctx, organizationID, projectID, allocationID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetApiV1OrganizationsOrganizationIDGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, projectID ProjectIDParameter, allocationID AllocationIDParameter, body PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationID(ctx, organizationID, projectID, allocationID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// GetApiV1OrganizationsOrganizationIDQuotasWithResponse request returning *GetApiV1OrganizationsOrganizationIDQuotasResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDQuotasWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDQuotasResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDQuotas(ctx, organizationID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiV1OrganizationsOrganizationIDQuotasResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// PutApiV1OrganizationsOrganizationIDQuotasWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDQuotasResponse
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDQuotasWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDQuotasResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDQuotasWithBody(ctx, organizationID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutApiV1OrganizationsOrganizationIDQuotasResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDQuotasWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PutApiV1OrganizationsOrganizationIDQuotasJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDQuotasResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDQuotas(ctx, organizationID, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDQuotasResponse(rsp)
+
+	return response, nil
 }
 
-// GetApiV1OrganizationsOrganizationIDRolesWithResponse request returning *GetApiV1OrganizationsOrganizationIDRolesResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDRolesWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDRolesResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDRoles(ctx, organizationID, reqEditors...)
+// ParsePostApiV1OrganizationsOrganizationIDGroupsResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDGroupsWithResponse call
+func ParsePostApiV1OrganizationsOrganizationIDGroupsResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1OrganizationsOrganizationIDRolesResponse(rsp)
-}
 
-// GetApiV1OrganizationsOrganizationIDServiceaccountsWithResponse request returning *GetApiV1OrganizationsOrganizationIDServiceaccountsResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDServiceaccountsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDServiceaccountsResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDServiceaccounts(ctx, organizationID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostApiV1OrganizationsOrganizationIDGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp)
-}
 
-// PostApiV1OrganizationsOrganizationIDServiceaccountsWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDServiceaccountsResponse
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDServiceaccountsWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDServiceaccountsWithBody(ctx, organizationID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest GroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest externalRef0.ConflictResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePostApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDServiceaccountsWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDServiceaccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDServiceaccounts(ctx, organizationID, body, reqEditors...)
+// ParseDeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse call
+func ParseDeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp)
-}
 
-// DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse
-func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error) {
-	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID(ctx, organizationID, serviceAccountID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse(rsp)
-}
 
-// PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithBody(ctx, organizationID, serviceAccountID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, body PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID(ctx, organizationID, serviceAccountID, body, reqEditors...)
+// ParseGetApiV1OrganizationsOrganizationIDGroupsGroupidResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse(rsp)
-}
 
-// PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateWithResponse request returning *PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateWithResponse(ctx context.Context, organizationID OrganizationIDParameter, serviceAccountID ServiceAccountIDParameter, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotate(ctx, organizationID, serviceAccountID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse(rsp)
-}
 
-// GetApiV1OrganizationsOrganizationIDUsersWithResponse request returning *GetApiV1OrganizationsOrganizationIDUsersResponse
-func (c *ClientWithResponses) GetApiV1OrganizationsOrganizationIDUsersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1OrganizationsOrganizationIDUsersResponse, error) {
-	rsp, err := c.GetApiV1OrganizationsOrganizationIDUsers(ctx, organizationID, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetApiV1OrganizationsOrganizationIDUsersResponse(rsp)
-}
 
-// PostApiV1OrganizationsOrganizationIDUsersWithBodyWithResponse request with arbitrary body returning *PostApiV1OrganizationsOrganizationIDUsersResponse
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDUsersWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDUsersResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDUsersWithBody(ctx, organizationID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiV1OrganizationsOrganizationIDUsersResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostApiV1OrganizationsOrganizationIDUsersWithResponse(ctx context.Context, organizationID OrganizationIDParameter, body PostApiV1OrganizationsOrganizationIDUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1OrganizationsOrganizationIDUsersResponse, error) {
-	rsp, err := c.PostApiV1OrganizationsOrganizationIDUsers(ctx, organizationID, body, reqEditors...)
+// ParsePutApiV1OrganizationsOrganizationIDGroupsGroupidResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse call
+func ParsePutApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1OrganizationsOrganizationIDUsersResponse(rsp)
-}
 
-// DeleteApiV1OrganizationsOrganizationIDUsersUserIDWithResponse request returning *DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse
-func (c *ClientWithResponses) DeleteApiV1OrganizationsOrganizationIDUsersUserIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse, error) {
-	rsp, err := c.DeleteApiV1OrganizationsOrganizationIDUsersUserID(ctx, organizationID, userID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp)
-}
 
-// PutApiV1OrganizationsOrganizationIDUsersUserIDWithBodyWithResponse request with arbitrary body returning *PutApiV1OrganizationsOrganizationIDUsersUserIDResponse
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDUsersUserIDWithBodyWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDUsersUserIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDUsersUserIDWithBody(ctx, organizationID, userID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-func (c *ClientWithResponses) PutApiV1OrganizationsOrganizationIDUsersUserIDWithResponse(ctx context.Context, organizationID OrganizationIDParameter, userID UserIDParameter, body PutApiV1OrganizationsOrganizationIDUsersUserIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1OrganizationsOrganizationIDUsersUserIDResponse, error) {
-	rsp, err := c.PutApiV1OrganizationsOrganizationIDUsersUserID(ctx, organizationID, userID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// GetApiV1SignupWithResponse request returning *GetApiV1SignupResponse
-func (c *ClientWithResponses) GetApiV1SignupWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1SignupResponse, error) {
-	rsp, err := c.GetApiV1Signup(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiV1SignupResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// GetOauth2V2AuthorizationWithResponse request returning *GetOauth2V2AuthorizationResponse
-func (c *ClientWithResponses) GetOauth2V2AuthorizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2AuthorizationResponse, error) {
-	rsp, err := c.GetOauth2V2Authorization(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetOauth2V2AuthorizationResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// PostOauth2V2AuthorizationWithBodyWithResponse request with arbitrary body returning *PostOauth2V2AuthorizationResponse
-func (c *ClientWithResponses) PostOauth2V2AuthorizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2AuthorizationResponse, error) {
-	rsp, err := c.PostOauth2V2AuthorizationWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePostOauth2V2AuthorizationResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostOauth2V2AuthorizationWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2AuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2AuthorizationResponse, error) {
-	rsp, err := c.PostOauth2V2AuthorizationWithFormdataBody(ctx, body, reqEditors...)
+// ParseGetApiV1OrganizationsOrganizationIDOauth2providersResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDOauth2providersWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDOauth2providersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostOauth2V2AuthorizationResponse(rsp)
-}
 
-// GetOauth2V2JwksWithResponse request returning *GetOauth2V2JwksResponse
-func (c *ClientWithResponses) GetOauth2V2JwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2JwksResponse, error) {
-	rsp, err := c.GetOauth2V2Jwks(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetApiV1OrganizationsOrganizationIDOauth2providersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetOauth2V2JwksResponse(rsp)
-}
 
-// PostOauth2V2LoginWithBodyWithResponse request with arbitrary body returning *PostOauth2V2LoginResponse
-func (c *ClientWithResponses) PostOauth2V2LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2LoginResponse, error) {
-	rsp, err := c.PostOauth2V2LoginWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Oauth2ProvidersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePostOauth2V2LoginResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostOauth2V2LoginWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2LoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2LoginResponse, error) {
-	rsp, err := c.PostOauth2V2LoginWithFormdataBody(ctx, body, reqEditors...)
+// ParsePostApiV1OrganizationsOrganizationIDOauth2providersResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDOauth2providersWithResponse call
+func ParsePostApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDOauth2providersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostOauth2V2LoginResponse(rsp)
-}
 
-// PostOauth2V2OnboardWithBodyWithResponse request with arbitrary body returning *PostOauth2V2OnboardResponse
-func (c *ClientWithResponses) PostOauth2V2OnboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2OnboardResponse, error) {
-	rsp, err := c.PostOauth2V2OnboardWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostApiV1OrganizationsOrganizationIDOauth2providersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostOauth2V2OnboardResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostOauth2V2OnboardWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2OnboardFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2OnboardResponse, error) {
-	rsp, err := c.PostOauth2V2OnboardWithFormdataBody(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Oauth2ProviderResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest externalRef0.ConflictResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePostOauth2V2OnboardResponse(rsp)
+
+	return response, nil
 }
 
-// PostOauth2V2TokenWithBodyWithResponse request with arbitrary body returning *PostOauth2V2TokenResponse
-func (c *ClientWithResponses) PostOauth2V2TokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2TokenResponse, error) {
-	rsp, err := c.PostOauth2V2TokenWithBody(ctx, contentType, body, reqEditors...)
+// ParseDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse call
+func ParseDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostOauth2V2TokenResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostOauth2V2TokenWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2TokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2TokenResponse, error) {
-	rsp, err := c.PostOauth2V2TokenWithFormdataBody(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostOauth2V2TokenResponse(rsp)
-}
 
-// GetOauth2V2UserinfoWithResponse request returning *GetOauth2V2UserinfoResponse
-func (c *ClientWithResponses) GetOauth2V2UserinfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOauth2V2UserinfoResponse, error) {
-	rsp, err := c.GetOauth2V2Userinfo(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetOauth2V2UserinfoResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// PostOauth2V2UserinfoWithBodyWithResponse request with arbitrary body returning *PostOauth2V2UserinfoResponse
-func (c *ClientWithResponses) PostOauth2V2UserinfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOauth2V2UserinfoResponse, error) {
-	rsp, err := c.PostOauth2V2UserinfoWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostOauth2V2UserinfoResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) PostOauth2V2UserinfoWithFormdataBodyWithResponse(ctx context.Context, body PostOauth2V2UserinfoFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostOauth2V2UserinfoResponse, error) {
-	rsp, err := c.PostOauth2V2UserinfoWithFormdataBody(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePostOauth2V2UserinfoResponse(rsp)
-}
 
-// GetOidcCallbackWithResponse request returning *GetOidcCallbackResponse
-func (c *ClientWithResponses) GetOidcCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOidcCallbackResponse, error) {
-	rsp, err := c.GetOidcCallback(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetOidcCallbackResponse(rsp)
+	return response, nil
 }
 
-// ParseGetWellKnownOpenidConfigurationResponse parses an HTTP response from a GetWellKnownOpenidConfigurationWithResponse call
-func ParseGetWellKnownOpenidConfigurationResponse(rsp *http.Response) (*GetWellKnownOpenidConfigurationResponse, error) {
+// ParsePutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse call
+func ParsePutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWellKnownOpenidConfigurationResponse{
+	response := &PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OpenidConfigurationResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetApiV1AclResponse parses an HTTP response from a GetApiV1AclWithResponse call
-func ParseGetApiV1AclResponse(rsp *http.Response) (*GetApiV1AclResponse, error) {
+// ParsePostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunWithResponse call
+func ParsePostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1AclResponse{
+	response := &PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AclResponse
+		var dest GroupMappingResultsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5184,6 +8478,20 @@ func ParseGetApiV1AclResponse(rsp *http.Response) (*GetApiV1AclResponse, error)
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5196,22 +8504,22 @@ func ParseGetApiV1AclResponse(rsp *http.Response) (*GetApiV1AclResponse, error)
 	return response, nil
 }
 
-// ParseGetApiV1Oauth2providersResponse parses an HTTP response from a GetApiV1Oauth2providersWithResponse call
-func ParseGetApiV1Oauth2providersResponse(rsp *http.Response) (*GetApiV1Oauth2providersResponse, error) {
+// ParseGetApiV1OrganizationsOrganizationIDProjectsResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDProjectsWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDProjectsResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDProjectsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1Oauth2providersResponse{
+	response := &GetApiV1OrganizationsOrganizationIDProjectsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SystemOauth2ProvidersResponse
+		var dest ProjectsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5243,26 +8551,33 @@ func ParseGetApiV1Oauth2providersResponse(rsp *http.Response) (*GetApiV1Oauth2pr
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsResponse parses an HTTP response from a GetApiV1OrganizationsWithResponse call
-func ParseGetApiV1OrganizationsResponse(rsp *http.Response) (*GetApiV1OrganizationsResponse, error) {
+// ParsePostApiV1OrganizationsOrganizationIDProjectsResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDProjectsWithResponse call
+func ParsePostApiV1OrganizationsOrganizationIDProjectsResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDProjectsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsResponse{
+	response := &PostApiV1OrganizationsOrganizationIDProjectsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrganizationsResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ProjectResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
@@ -5278,12 +8593,12 @@ func ParseGetApiV1OrganizationsResponse(rsp *http.Response) (*GetApiV1Organizati
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest externalRef0.NotFoundResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest externalRef0.ConflictResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -5297,27 +8612,20 @@ func ParseGetApiV1OrganizationsResponse(rsp *http.Response) (*GetApiV1Organizati
 	return response, nil
 }
 
-// ParsePostApiV1OrganizationsResponse parses an HTTP response from a PostApiV1OrganizationsWithResponse call
-func ParsePostApiV1OrganizationsResponse(rsp *http.Response) (*PostApiV1OrganizationsResponse, error) {
+// ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse call
+func ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1OrganizationsResponse{
+	response := &DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest OrganizationRead
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest externalRef0.BadRequestResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5339,6 +8647,13 @@ func ParsePostApiV1OrganizationsResponse(rsp *http.Response) (*PostApiV1Organiza
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5351,27 +8666,34 @@ func ParsePostApiV1OrganizationsResponse(rsp *http.Response) (*PostApiV1Organiza
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDResponse, error) {
+// ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDResponse{
+	response := &GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrganizationResponse
+		var dest ProjectResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5386,6 +8708,13 @@ func ParseGetApiV1OrganizationsOrganizationIDResponse(rsp *http.Response) (*GetA
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5398,20 +8727,27 @@ func ParseGetApiV1OrganizationsOrganizationIDResponse(rsp *http.Response) (*GetA
 	return response, nil
 }
 
-// ParsePutApiV1OrganizationsOrganizationIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDWithResponse call
-func ParsePutApiV1OrganizationsOrganizationIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDResponse, error) {
+// ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse call
+func ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutApiV1OrganizationsOrganizationIDResponse{
+	response := &PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5426,6 +8762,13 @@ func ParsePutApiV1OrganizationsOrganizationIDResponse(rsp *http.Response) (*PutA
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5438,26 +8781,33 @@ func ParsePutApiV1OrganizationsOrganizationIDResponse(rsp *http.Response) (*PutA
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDAclResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDAclWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDAclResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDAclResponse, error) {
+// ParsePostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithResponse call
+func ParsePostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDAclResponse{
+	response := &PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AclResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AllocationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
@@ -5466,6 +8816,20 @@ func ParseGetApiV1OrganizationsOrganizationIDAclResponse(rsp *http.Response) (*G
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest externalRef0.ConflictResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5478,27 +8842,20 @@ func ParseGetApiV1OrganizationsOrganizationIDAclResponse(rsp *http.Response) (*G
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDAllocationsResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDAllocationsWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDAllocationsResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDAllocationsResponse, error) {
+// ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse call
+func ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDAllocationsResponse{
+	response := &DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AllocationsResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5532,22 +8889,22 @@ func ParseGetApiV1OrganizationsOrganizationIDAllocationsResponse(rsp *http.Respo
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDGroupsResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDGroupsWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDGroupsResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDGroupsResponse, error) {
+// ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDGroupsResponse{
+	response := &GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GroupsResponse
+		var dest AllocationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5565,7 +8922,14 @@ func ParseGetApiV1OrganizationsOrganizationIDGroupsResponse(rsp *http.Response)
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -5579,26 +8943,33 @@ func ParseGetApiV1OrganizationsOrganizationIDGroupsResponse(rsp *http.Response)
 	return response, nil
 }
 
-// ParsePostApiV1OrganizationsOrganizationIDGroupsResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDGroupsWithResponse call
-func ParsePostApiV1OrganizationsOrganizationIDGroupsResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDGroupsResponse, error) {
+// ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse call
+func ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1OrganizationsOrganizationIDGroupsResponse{
+	response := &PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest GroupResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AllocationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
@@ -5614,12 +8985,12 @@ func ParsePostApiV1OrganizationsOrganizationIDGroupsResponse(rsp *http.Response)
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest externalRef0.ConflictResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -5633,20 +9004,27 @@ func ParsePostApiV1OrganizationsOrganizationIDGroupsResponse(rsp *http.Response)
 	return response, nil
 }
 
-// ParseDeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse call
-func ParseDeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
+// ParseGetApiV1OrganizationsOrganizationIDQuotasResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDQuotasWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDQuotasResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDQuotasResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse{
+	response := &GetApiV1OrganizationsOrganizationIDQuotasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QuotasResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5661,13 +9039,6 @@ func ParseDeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest externalRef0.NotFoundResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5680,27 +9051,34 @@ func ParseDeleteApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDGroupsGroupidResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
+// ParsePutApiV1OrganizationsOrganizationIDQuotasResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDQuotasWithResponse call
+func ParsePutApiV1OrganizationsOrganizationIDQuotasResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDQuotasResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDGroupsGroupidResponse{
+	response := &PutApiV1OrganizationsOrganizationIDQuotasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GroupResponse
+		var dest QuotasResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5727,20 +9105,27 @@ func ParseGetApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.Res
 	return response, nil
 }
 
-// ParsePutApiV1OrganizationsOrganizationIDGroupsGroupidResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDGroupsGroupidWithResponse call
-func ParsePutApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse, error) {
+// ParseGetApiV1OrganizationsOrganizationIDRolesResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDRolesWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDRolesResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDRolesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutApiV1OrganizationsOrganizationIDGroupsGroupidResponse{
+	response := &GetApiV1OrganizationsOrganizationIDRolesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RolesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5755,13 +9140,6 @@ func ParsePutApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.Res
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest externalRef0.NotFoundResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5774,22 +9152,22 @@ func ParsePutApiV1OrganizationsOrganizationIDGroupsGroupidResponse(rsp *http.Res
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDOauth2providersResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDOauth2providersWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDOauth2providersResponse, error) {
+// ParseGetApiV1OrganizationsOrganizationIDServiceaccountsResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDServiceaccountsWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDServiceaccountsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDOauth2providersResponse{
+	response := &GetApiV1OrganizationsOrganizationIDServiceaccountsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Oauth2ProvidersResponse
+		var dest ServiceAccountsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5809,13 +9187,6 @@ func ParseGetApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp *http.R
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest externalRef0.NotFoundResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5828,22 +9199,22 @@ func ParseGetApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp *http.R
 	return response, nil
 }
 
-// ParsePostApiV1OrganizationsOrganizationIDOauth2providersResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDOauth2providersWithResponse call
-func ParsePostApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDOauth2providersResponse, error) {
+// ParsePostApiV1OrganizationsOrganizationIDServiceaccountsResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDServiceaccountsWithResponse call
+func ParsePostApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDServiceaccountsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1OrganizationsOrganizationIDOauth2providersResponse{
+	response := &PostApiV1OrganizationsOrganizationIDServiceaccountsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Oauth2ProviderResponse
+		var dest ServiceAccountCreateResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5863,13 +9234,6 @@ func ParsePostApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp *http.
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest externalRef0.ConflictResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5882,15 +9246,15 @@ func ParsePostApiV1OrganizationsOrganizationIDOauth2providersResponse(rsp *http.
 	return response, nil
 }
 
-// ParseDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse call
-func ParseDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error) {
+// ParseDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse call
+func ParseDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse{
+	response := &DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5929,20 +9293,27 @@ func ParseDeleteApiV1OrganizationsOrganizationIDOauth2providersProviderIDRespons
 	return response, nil
 }
 
-// ParsePutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDWithResponse call
-func ParsePutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse, error) {
+// ParsePutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse call
+func ParsePutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse{
+	response := &PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServiceAccountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5957,13 +9328,6 @@ func ParsePutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(r
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest externalRef0.NotFoundResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5976,22 +9340,22 @@ func ParsePutApiV1OrganizationsOrganizationIDOauth2providersProviderIDResponse(r
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDProjectsResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDProjectsWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDProjectsResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDProjectsResponse, error) {
+// ParsePostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateWithResponse call
+func ParsePostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDProjectsResponse{
+	response := &PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectsResponse
+		var dest ServiceAccountCreateResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6023,33 +9387,26 @@ func ParseGetApiV1OrganizationsOrganizationIDProjectsResponse(rsp *http.Response
 	return response, nil
 }
 
-// ParsePostApiV1OrganizationsOrganizationIDProjectsResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDProjectsWithResponse call
-func ParsePostApiV1OrganizationsOrganizationIDProjectsResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDProjectsResponse, error) {
+// ParseGetApiV1OrganizationsOrganizationIDUsersResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDUsersWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDUsersResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDUsersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1OrganizationsOrganizationIDProjectsResponse{
+	response := &GetApiV1OrganizationsOrganizationIDUsersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest ProjectResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest externalRef0.BadRequestResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsersResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
@@ -6065,13 +9422,6 @@ func ParsePostApiV1OrganizationsOrganizationIDProjectsResponse(rsp *http.Respons
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest externalRef0.ConflictResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6084,26 +9434,26 @@ func ParsePostApiV1OrganizationsOrganizationIDProjectsResponse(rsp *http.Respons
 	return response, nil
 }
 
-// ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse call
-func ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
+// ParsePostApiV1OrganizationsOrganizationIDUsersResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDUsersWithResponse call
+func ParsePostApiV1OrganizationsOrganizationIDUsersResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDUsersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse{
+	response := &PostApiV1OrganizationsOrganizationIDUsersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest externalRef0.BadRequestResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UserResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
@@ -6119,12 +9469,12 @@ func ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *h
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest externalRef0.NotFoundResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest externalRef0.ConflictResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -6138,34 +9488,20 @@ func ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *h
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
+// ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDUsersUserIDWithResponse call
+func ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse{
+	response := &DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest externalRef0.BadRequestResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6199,26 +9535,26 @@ func ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *http
 	return response, nil
 }
 
-// ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDProjectsProjectIDWithResponse call
-func ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse, error) {
+// ParsePutApiV1OrganizationsOrganizationIDUsersUserIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDUsersUserIDWithResponse call
+func ParsePutApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDUsersUserIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse{
+	response := &PutApiV1OrganizationsOrganizationIDUsersUserIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest externalRef0.BadRequestResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
@@ -6234,13 +9570,6 @@ func ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *http
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest externalRef0.NotFoundResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6253,34 +9582,20 @@ func ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDResponse(rsp *http
 	return response, nil
 }
 
-// ParsePostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsWithResponse call
-func ParsePostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse, error) {
+// ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsWithResponse call
+func ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsResponse{
+	response := &DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AllocationResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest externalRef0.BadRequestResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6295,12 +9610,12 @@ func ParsePostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRespon
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest externalRef0.ConflictResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -6314,20 +9629,27 @@ func ParsePostApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsRespon
 	return response, nil
 }
 
-// ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse call
-func ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
+// ParseGetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsWithResponse call
+func ParseGetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse{
+	response := &GetApiV1OrganizationsOrganizationIDUsersUserIDSessionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6361,27 +9683,20 @@ func ParseDeleteApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllo
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
+// ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDWithResponse call
+func ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse{
+	response := &DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AllocationResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6415,22 +9730,22 @@ func ParseGetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocat
 	return response, nil
 }
 
-// ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse call
-func ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
+// ParseGetApiV1RevocationsResponse parses an HTTP response from a GetApiV1RevocationsWithResponse call
+func ParseGetApiV1RevocationsResponse(rsp *http.Response) (*GetApiV1RevocationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse{
+	response := &GetApiV1RevocationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AllocationResponse
+		var dest RevocationsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6457,13 +9772,6 @@ func ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocat
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest externalRef0.NotFoundResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6476,27 +9784,20 @@ func ParsePutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocat
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDQuotasResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDQuotasWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDQuotasResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDQuotasResponse, error) {
+// ParseDeleteApiV1SessionsResponse parses an HTTP response from a DeleteApiV1SessionsWithResponse call
+func ParseDeleteApiV1SessionsResponse(rsp *http.Response) (*DeleteApiV1SessionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDQuotasResponse{
+	response := &DeleteApiV1SessionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest QuotasResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6523,34 +9824,27 @@ func ParseGetApiV1OrganizationsOrganizationIDQuotasResponse(rsp *http.Response)
 	return response, nil
 }
 
-// ParsePutApiV1OrganizationsOrganizationIDQuotasResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDQuotasWithResponse call
-func ParsePutApiV1OrganizationsOrganizationIDQuotasResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDQuotasResponse, error) {
+// ParseGetApiV1SessionsResponse parses an HTTP response from a GetApiV1SessionsWithResponse call
+func ParseGetApiV1SessionsResponse(rsp *http.Response) (*GetApiV1SessionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutApiV1OrganizationsOrganizationIDQuotasResponse{
+	response := &GetApiV1SessionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest QuotasResponse
+		var dest SessionsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest externalRef0.BadRequestResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6577,27 +9871,20 @@ func ParsePutApiV1OrganizationsOrganizationIDQuotasResponse(rsp *http.Response)
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDRolesResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDRolesWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDRolesResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDRolesResponse, error) {
+// ParseDeleteApiV1SessionsSessionIDResponse parses an HTTP response from a DeleteApiV1SessionsSessionIDWithResponse call
+func ParseDeleteApiV1SessionsSessionIDResponse(rsp *http.Response) (*DeleteApiV1SessionsSessionIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDRolesResponse{
+	response := &DeleteApiV1SessionsSessionIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RolesResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6612,6 +9899,13 @@ func ParseGetApiV1OrganizationsOrganizationIDRolesResponse(rsp *http.Response) (
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6624,40 +9918,129 @@ func ParseGetApiV1OrganizationsOrganizationIDRolesResponse(rsp *http.Response) (
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDServiceaccountsResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDServiceaccountsWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDServiceaccountsResponse, error) {
+// ParseGetApiV1SignupResponse parses an HTTP response from a GetApiV1SignupWithResponse call
+func ParseGetApiV1SignupResponse(rsp *http.Response) (*GetApiV1SignupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDServiceaccountsResponse{
+	response := &GetApiV1SignupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOauth2V2AuthorizationResponse parses an HTTP response from a GetOauth2V2AuthorizationWithResponse call
+func ParseGetOauth2V2AuthorizationResponse(rsp *http.Response) (*GetOauth2V2AuthorizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOauth2V2AuthorizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostOauth2V2AuthorizationResponse parses an HTTP response from a PostOauth2V2AuthorizationWithResponse call
+func ParsePostOauth2V2AuthorizationResponse(rsp *http.Response) (*PostOauth2V2AuthorizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOauth2V2AuthorizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOauth2V2DeviceResponse parses an HTTP response from a GetOauth2V2DeviceWithResponse call
+func ParseGetOauth2V2DeviceResponse(rsp *http.Response) (*GetOauth2V2DeviceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOauth2V2DeviceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostOauth2V2DeviceResponse parses an HTTP response from a PostOauth2V2DeviceWithResponse call
+func ParsePostOauth2V2DeviceResponse(rsp *http.Response) (*PostOauth2V2DeviceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOauth2V2DeviceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostOauth2V2DeviceApproveResponse parses an HTTP response from a PostOauth2V2DeviceApproveWithResponse call
+func ParsePostOauth2V2DeviceApproveResponse(rsp *http.Response) (*PostOauth2V2DeviceApproveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOauth2V2DeviceApproveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostOauth2V2DeviceAuthorizationResponse parses an HTTP response from a PostOauth2V2DeviceAuthorizationWithResponse call
+func ParsePostOauth2V2DeviceAuthorizationResponse(rsp *http.Response) (*PostOauth2V2DeviceAuthorizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOauth2V2DeviceAuthorizationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ServiceAccountsResponse
+		var dest DeviceAuthorizationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest externalRef0.UnauthorizedResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest externalRef0.ForbiddenResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -6671,40 +10054,40 @@ func ParseGetApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp *http.R
 	return response, nil
 }
 
-// ParsePostApiV1OrganizationsOrganizationIDServiceaccountsResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDServiceaccountsWithResponse call
-func ParsePostApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDServiceaccountsResponse, error) {
+// ParsePostOauth2V2IntrospectResponse parses an HTTP response from a PostOauth2V2IntrospectWithResponse call
+func ParsePostOauth2V2IntrospectResponse(rsp *http.Response) (*PostOauth2V2IntrospectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1OrganizationsOrganizationIDServiceaccountsResponse{
+	response := &PostOauth2V2IntrospectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ServiceAccountCreateResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IntrospectionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest externalRef0.UnauthorizedResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest externalRef0.ForbiddenResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -6718,40 +10101,26 @@ func ParsePostApiV1OrganizationsOrganizationIDServiceaccountsResponse(rsp *http.
 	return response, nil
 }
 
-// ParseDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse call
-func ParseDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error) {
+// ParseGetOauth2V2JwksResponse parses an HTTP response from a GetOauth2V2JwksWithResponse call
+func ParseGetOauth2V2JwksResponse(rsp *http.Response) (*GetOauth2V2JwksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse{
+	response := &GetOauth2V2JwksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest externalRef0.UnauthorizedResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest externalRef0.ForbiddenResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest externalRef0.NotFoundResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JwksResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -6765,87 +10134,104 @@ func ParseDeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDR
 	return response, nil
 }
 
-// ParsePutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDWithResponse call
-func ParsePutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse, error) {
+// ParsePostOauth2V2LoginResponse parses an HTTP response from a PostOauth2V2LoginWithResponse call
+func ParsePostOauth2V2LoginResponse(rsp *http.Response) (*PostOauth2V2LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOauth2V2LoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOauth2V2LogoutResponse parses an HTTP response from a GetOauth2V2LogoutWithResponse call
+func ParseGetOauth2V2LogoutResponse(rsp *http.Response) (*GetOauth2V2LogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOauth2V2LogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostOauth2V2LogoutResponse parses an HTTP response from a PostOauth2V2LogoutWithResponse call
+func ParsePostOauth2V2LogoutResponse(rsp *http.Response) (*PostOauth2V2LogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOauth2V2LogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostOauth2V2OnboardResponse parses an HTTP response from a PostOauth2V2OnboardWithResponse call
+func ParsePostOauth2V2OnboardResponse(rsp *http.Response) (*PostOauth2V2OnboardResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDResponse{
+	response := &PostOauth2V2OnboardResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ServiceAccountResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest externalRef0.UnauthorizedResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest externalRef0.ForbiddenResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest externalRef0.InternalServerErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParsePostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateWithResponse call
-func ParsePostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse, error) {
+// ParsePostOauth2V2ParResponse parses an HTTP response from a PostOauth2V2ParWithResponse call
+func ParsePostOauth2V2ParResponse(rsp *http.Response) (*PostOauth2V2ParResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotateResponse{
+	response := &PostOauth2V2ParResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ServiceAccountCreateResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PushedAuthorizationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest externalRef0.UnauthorizedResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest externalRef0.ForbiddenResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -6859,26 +10245,33 @@ func ParsePostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRot
 	return response, nil
 }
 
-// ParseGetApiV1OrganizationsOrganizationIDUsersResponse parses an HTTP response from a GetApiV1OrganizationsOrganizationIDUsersWithResponse call
-func ParseGetApiV1OrganizationsOrganizationIDUsersResponse(rsp *http.Response) (*GetApiV1OrganizationsOrganizationIDUsersResponse, error) {
+// ParsePostOauth2V2RegisterResponse parses an HTTP response from a PostOauth2V2RegisterWithResponse call
+func ParsePostOauth2V2RegisterResponse(rsp *http.Response) (*PostOauth2V2RegisterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1OrganizationsOrganizationIDUsersResponse{
+	response := &PostOauth2V2RegisterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UsersResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ClientInformationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
@@ -6906,27 +10299,20 @@ func ParseGetApiV1OrganizationsOrganizationIDUsersResponse(rsp *http.Response) (
 	return response, nil
 }
 
-// ParsePostApiV1OrganizationsOrganizationIDUsersResponse parses an HTTP response from a PostApiV1OrganizationsOrganizationIDUsersWithResponse call
-func ParsePostApiV1OrganizationsOrganizationIDUsersResponse(rsp *http.Response) (*PostApiV1OrganizationsOrganizationIDUsersResponse, error) {
+// ParseDeleteOauth2V2RegisterClientIDResponse parses an HTTP response from a DeleteOauth2V2RegisterClientIDWithResponse call
+func ParseDeleteOauth2V2RegisterClientIDResponse(rsp *http.Response) (*DeleteOauth2V2RegisterClientIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1OrganizationsOrganizationIDUsersResponse{
+	response := &DeleteOauth2V2RegisterClientIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest UserResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6934,20 +10320,6 @@ func ParsePostApiV1OrganizationsOrganizationIDUsersResponse(rsp *http.Response)
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest externalRef0.ForbiddenResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest externalRef0.ConflictResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6960,40 +10332,33 @@ func ParsePostApiV1OrganizationsOrganizationIDUsersResponse(rsp *http.Response)
 	return response, nil
 }
 
-// ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse parses an HTTP response from a DeleteApiV1OrganizationsOrganizationIDUsersUserIDWithResponse call
-func ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp *http.Response) (*DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse, error) {
+// ParseGetOauth2V2RegisterClientIDResponse parses an HTTP response from a GetOauth2V2RegisterClientIDWithResponse call
+func ParseGetOauth2V2RegisterClientIDResponse(rsp *http.Response) (*GetOauth2V2RegisterClientIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse{
+	response := &GetOauth2V2RegisterClientIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest externalRef0.UnauthorizedResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest externalRef0.ForbiddenResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientInformationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest externalRef0.NotFoundResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -7007,40 +10372,40 @@ func ParseDeleteApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp *http.Re
 	return response, nil
 }
 
-// ParsePutApiV1OrganizationsOrganizationIDUsersUserIDResponse parses an HTTP response from a PutApiV1OrganizationsOrganizationIDUsersUserIDWithResponse call
-func ParsePutApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp *http.Response) (*PutApiV1OrganizationsOrganizationIDUsersUserIDResponse, error) {
+// ParsePutOauth2V2RegisterClientIDResponse parses an HTTP response from a PutOauth2V2RegisterClientIDWithResponse call
+func ParsePutOauth2V2RegisterClientIDResponse(rsp *http.Response) (*PutOauth2V2RegisterClientIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutApiV1OrganizationsOrganizationIDUsersUserIDResponse{
+	response := &PutOauth2V2RegisterClientIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserResponse
+		var dest ClientInformationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest externalRef0.UnauthorizedResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest externalRef0.ForbiddenResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -7054,74 +10419,33 @@ func ParsePutApiV1OrganizationsOrganizationIDUsersUserIDResponse(rsp *http.Respo
 	return response, nil
 }
 
-// ParseGetApiV1SignupResponse parses an HTTP response from a GetApiV1SignupWithResponse call
-func ParseGetApiV1SignupResponse(rsp *http.Response) (*GetApiV1SignupResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetApiV1SignupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseGetOauth2V2AuthorizationResponse parses an HTTP response from a GetOauth2V2AuthorizationWithResponse call
-func ParseGetOauth2V2AuthorizationResponse(rsp *http.Response) (*GetOauth2V2AuthorizationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetOauth2V2AuthorizationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParsePostOauth2V2AuthorizationResponse parses an HTTP response from a PostOauth2V2AuthorizationWithResponse call
-func ParsePostOauth2V2AuthorizationResponse(rsp *http.Response) (*PostOauth2V2AuthorizationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &PostOauth2V2AuthorizationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseGetOauth2V2JwksResponse parses an HTTP response from a GetOauth2V2JwksWithResponse call
-func ParseGetOauth2V2JwksResponse(rsp *http.Response) (*GetOauth2V2JwksResponse, error) {
+// ParsePostOauth2V2RevokeResponse parses an HTTP response from a PostOauth2V2RevokeWithResponse call
+func ParsePostOauth2V2RevokeResponse(rsp *http.Response) (*PostOauth2V2RevokeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOauth2V2JwksResponse{
+	response := &PostOauth2V2RevokeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JwksResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.BadRequestResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -7135,38 +10459,6 @@ func ParseGetOauth2V2JwksResponse(rsp *http.Response) (*GetOauth2V2JwksResponse,
 	return response, nil
 }
 
-// ParsePostOauth2V2LoginResponse parses an HTTP response from a PostOauth2V2LoginWithResponse call
-func ParsePostOauth2V2LoginResponse(rsp *http.Response) (*PostOauth2V2LoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &PostOauth2V2LoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParsePostOauth2V2OnboardResponse parses an HTTP response from a PostOauth2V2OnboardWithResponse call
-func ParsePostOauth2V2OnboardResponse(rsp *http.Response) (*PostOauth2V2OnboardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &PostOauth2V2OnboardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
 // ParsePostOauth2V2TokenResponse parses an HTTP response from a PostOauth2V2TokenWithResponse call
 func ParsePostOauth2V2TokenResponse(rsp *http.Response) (*PostOauth2V2TokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -7305,3 +10597,35 @@ func ParseGetOidcCallbackResponse(rsp *http.Response) (*GetOidcCallbackResponse,
 
 	return response, nil
 }
+
+// ParsePostSamlAcsResponse parses an HTTP response from a PostSamlAcsWithResponse call
+func ParsePostSamlAcsResponse(rsp *http.Response) (*PostSamlAcsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSamlAcsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetSamlMetadataResponse parses an HTTP response from a GetSamlMetadataWithResponse call
+func ParseGetSamlMetadataResponse(rsp *http.Response) (*GetSamlMetadataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSamlMetadataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
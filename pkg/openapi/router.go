@@ -21,6 +21,9 @@ type ServerInterface interface {
 	// (GET /api/v1/acl)
 	GetApiV1Acl(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/v1/oauth2clients/{clientID}/rotate)
+	PostApiV1Oauth2clientsClientIDRotate(w http.ResponseWriter, r *http.Request, clientID ClientIDParameter)
+
 	// (GET /api/v1/oauth2providers)
 	GetApiV1Oauth2providers(w http.ResponseWriter, r *http.Request)
 
@@ -69,6 +72,9 @@ type ServerInterface interface {
 	// (PUT /api/v1/organizations/{organizationID}/oauth2providers/{providerID})
 	PutApiV1OrganizationsOrganizationIDOauth2providersProviderID(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter)
 
+	// (POST /api/v1/organizations/{organizationID}/oauth2providers/{providerID}/groupmappings/dryrun)
+	PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter)
+
 	// (GET /api/v1/organizations/{organizationID}/projects)
 	GetApiV1OrganizationsOrganizationIDProjects(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter)
 
@@ -132,6 +138,27 @@ type ServerInterface interface {
 	// (PUT /api/v1/organizations/{organizationID}/users/{userID})
 	PutApiV1OrganizationsOrganizationIDUsersUserID(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter, userID UserIDParameter)
 
+	// (DELETE /api/v1/organizations/{organizationID}/users/{userID}/sessions)
+	DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter, userID UserIDParameter)
+
+	// (GET /api/v1/organizations/{organizationID}/users/{userID}/sessions)
+	GetApiV1OrganizationsOrganizationIDUsersUserIDSessions(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter, userID UserIDParameter)
+
+	// (DELETE /api/v1/organizations/{organizationID}/users/{userID}/sessions/{sessionID})
+	DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter, userID UserIDParameter, sessionID SessionIDParameter)
+
+	// (GET /api/v1/revocations)
+	GetApiV1Revocations(w http.ResponseWriter, r *http.Request, params GetApiV1RevocationsParams)
+
+	// (DELETE /api/v1/sessions)
+	DeleteApiV1Sessions(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/sessions)
+	GetApiV1Sessions(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/sessions/{sessionID})
+	DeleteApiV1SessionsSessionID(w http.ResponseWriter, r *http.Request, sessionID SessionIDParameter)
+
 	// (GET /api/v1/signup)
 	GetApiV1Signup(w http.ResponseWriter, r *http.Request)
 
@@ -141,15 +168,54 @@ type ServerInterface interface {
 	// (POST /oauth2/v2/authorization)
 	PostOauth2V2Authorization(w http.ResponseWriter, r *http.Request)
 
+	// (GET /oauth2/v2/device)
+	GetOauth2V2Device(w http.ResponseWriter, r *http.Request, params GetOauth2V2DeviceParams)
+
+	// (POST /oauth2/v2/device)
+	PostOauth2V2Device(w http.ResponseWriter, r *http.Request)
+
+	// (POST /oauth2/v2/device/approve)
+	PostOauth2V2DeviceApprove(w http.ResponseWriter, r *http.Request)
+
+	// (POST /oauth2/v2/device_authorization)
+	PostOauth2V2DeviceAuthorization(w http.ResponseWriter, r *http.Request)
+
+	// (POST /oauth2/v2/introspect)
+	PostOauth2V2Introspect(w http.ResponseWriter, r *http.Request)
+
 	// (GET /oauth2/v2/jwks)
 	GetOauth2V2Jwks(w http.ResponseWriter, r *http.Request)
 
 	// (POST /oauth2/v2/login)
 	PostOauth2V2Login(w http.ResponseWriter, r *http.Request)
 
+	// (GET /oauth2/v2/logout)
+	GetOauth2V2Logout(w http.ResponseWriter, r *http.Request)
+
+	// (POST /oauth2/v2/logout)
+	PostOauth2V2Logout(w http.ResponseWriter, r *http.Request)
+
 	// (POST /oauth2/v2/onboard)
 	PostOauth2V2Onboard(w http.ResponseWriter, r *http.Request)
 
+	// (POST /oauth2/v2/par)
+	PostOauth2V2Par(w http.ResponseWriter, r *http.Request)
+
+	// (POST /oauth2/v2/register)
+	PostOauth2V2Register(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /oauth2/v2/register/{clientID})
+	DeleteOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request, clientID ClientIDParameter)
+
+	// (GET /oauth2/v2/register/{clientID})
+	GetOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request, clientID ClientIDParameter)
+
+	// (PUT /oauth2/v2/register/{clientID})
+	PutOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request, clientID ClientIDParameter)
+
+	// (POST /oauth2/v2/revoke)
+	PostOauth2V2Revoke(w http.ResponseWriter, r *http.Request)
+
 	// (POST /oauth2/v2/token)
 	PostOauth2V2Token(w http.ResponseWriter, r *http.Request)
 
@@ -161,6 +227,12 @@ type ServerInterface interface {
 
 	// (GET /oidc/callback)
 	GetOidcCallback(w http.ResponseWriter, r *http.Request)
+
+	// (POST /saml/acs)
+	PostSamlAcs(w http.ResponseWriter, r *http.Request)
+
+	// (GET /saml/metadata)
+	GetSamlMetadata(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -177,6 +249,11 @@ func (_ Unimplemented) GetApiV1Acl(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /api/v1/oauth2clients/{clientID}/rotate)
+func (_ Unimplemented) PostApiV1Oauth2clientsClientIDRotate(w http.ResponseWriter, r *http.Request, clientID ClientIDParameter) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/oauth2providers)
 func (_ Unimplemented) GetApiV1Oauth2providers(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -257,6 +334,11 @@ func (_ Unimplemented) PutApiV1OrganizationsOrganizationIDOauth2providersProvide
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /api/v1/organizations/{organizationID}/oauth2providers/{providerID}/groupmappings/dryrun)
+func (_ Unimplemented) PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter, providerID Oauth2ProvderIDParameter) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/organizations/{organizationID}/projects)
 func (_ Unimplemented) GetApiV1OrganizationsOrganizationIDProjects(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -362,6 +444,41 @@ func (_ Unimplemented) PutApiV1OrganizationsOrganizationIDUsersUserID(w http.Res
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (DELETE /api/v1/organizations/{organizationID}/users/{userID}/sessions)
+func (_ Unimplemented) DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter, userID UserIDParameter) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/organizations/{organizationID}/users/{userID}/sessions)
+func (_ Unimplemented) GetApiV1OrganizationsOrganizationIDUsersUserIDSessions(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter, userID UserIDParameter) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/organizations/{organizationID}/users/{userID}/sessions/{sessionID})
+func (_ Unimplemented) DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID(w http.ResponseWriter, r *http.Request, organizationID OrganizationIDParameter, userID UserIDParameter, sessionID SessionIDParameter) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/revocations)
+func (_ Unimplemented) GetApiV1Revocations(w http.ResponseWriter, r *http.Request, params GetApiV1RevocationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/sessions)
+func (_ Unimplemented) DeleteApiV1Sessions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/sessions)
+func (_ Unimplemented) GetApiV1Sessions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/sessions/{sessionID})
+func (_ Unimplemented) DeleteApiV1SessionsSessionID(w http.ResponseWriter, r *http.Request, sessionID SessionIDParameter) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/signup)
 func (_ Unimplemented) GetApiV1Signup(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -377,6 +494,31 @@ func (_ Unimplemented) PostOauth2V2Authorization(w http.ResponseWriter, r *http.
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /oauth2/v2/device)
+func (_ Unimplemented) GetOauth2V2Device(w http.ResponseWriter, r *http.Request, params GetOauth2V2DeviceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /oauth2/v2/device)
+func (_ Unimplemented) PostOauth2V2Device(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /oauth2/v2/device/approve)
+func (_ Unimplemented) PostOauth2V2DeviceApprove(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /oauth2/v2/device_authorization)
+func (_ Unimplemented) PostOauth2V2DeviceAuthorization(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /oauth2/v2/introspect)
+func (_ Unimplemented) PostOauth2V2Introspect(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /oauth2/v2/jwks)
 func (_ Unimplemented) GetOauth2V2Jwks(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -387,11 +529,51 @@ func (_ Unimplemented) PostOauth2V2Login(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /oauth2/v2/logout)
+func (_ Unimplemented) GetOauth2V2Logout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /oauth2/v2/logout)
+func (_ Unimplemented) PostOauth2V2Logout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (POST /oauth2/v2/onboard)
 func (_ Unimplemented) PostOauth2V2Onboard(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /oauth2/v2/par)
+func (_ Unimplemented) PostOauth2V2Par(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /oauth2/v2/register)
+func (_ Unimplemented) PostOauth2V2Register(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /oauth2/v2/register/{clientID})
+func (_ Unimplemented) DeleteOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request, clientID ClientIDParameter) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /oauth2/v2/register/{clientID})
+func (_ Unimplemented) GetOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request, clientID ClientIDParameter) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /oauth2/v2/register/{clientID})
+func (_ Unimplemented) PutOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request, clientID ClientIDParameter) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /oauth2/v2/revoke)
+func (_ Unimplemented) PostOauth2V2Revoke(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (POST /oauth2/v2/token)
 func (_ Unimplemented) PostOauth2V2Token(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -412,6 +594,16 @@ func (_ Unimplemented) GetOidcCallback(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /saml/acs)
+func (_ Unimplemented) PostSamlAcs(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /saml/metadata)
+func (_ Unimplemented) GetSamlMetadata(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -455,6 +647,37 @@ func (siw *ServerInterfaceWrapper) GetApiV1Acl(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
+// PostApiV1Oauth2clientsClientIDRotate operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Oauth2clientsClientIDRotate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "clientID" -------------
+	var clientID ClientIDParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clientID", chi.URLParam(r, "clientID"), &clientID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1Oauth2clientsClientIDRotate(w, r, clientID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetApiV1Oauth2providers operation middleware
 func (siw *ServerInterfaceWrapper) GetApiV1Oauth2providers(w http.ResponseWriter, r *http.Request) {
 
@@ -976,6 +1199,46 @@ func (siw *ServerInterfaceWrapper) PutApiV1OrganizationsOrganizationIDOauth2prov
 	handler.ServeHTTP(w, r)
 }
 
+// PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationIDParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", chi.URLParam(r, "organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "providerID" -------------
+	var providerID Oauth2ProvderIDParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "providerID", chi.URLParam(r, "providerID"), &providerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun(w, r, organizationID, providerID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetApiV1OrganizationsOrganizationIDProjects operation middleware
 func (siw *ServerInterfaceWrapper) GetApiV1OrganizationsOrganizationIDProjects(w http.ResponseWriter, r *http.Request) {
 
@@ -1762,39 +2025,37 @@ func (siw *ServerInterfaceWrapper) PutApiV1OrganizationsOrganizationIDUsersUserI
 	handler.ServeHTTP(w, r)
 }
 
-// GetApiV1Signup operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1Signup(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiV1Signup(w, r)
-	}))
+// DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions(w http.ResponseWriter, r *http.Request) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	var err error
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationIDParameter
 
-// GetOauth2V2Authorization operation middleware
-func (siw *ServerInterfaceWrapper) GetOauth2V2Authorization(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", chi.URLParam(r, "organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetOauth2V2Authorization(w, r)
-	}))
+	// ------------- Path parameter "userID" -------------
+	var userID UserIDParameter
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	ctx := r.Context()
 
-// PostOauth2V2Authorization operation middleware
-func (siw *ServerInterfaceWrapper) PostOauth2V2Authorization(w http.ResponseWriter, r *http.Request) {
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostOauth2V2Authorization(w, r)
+		siw.Handler.DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions(w, r, organizationID, userID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1804,39 +2065,37 @@ func (siw *ServerInterfaceWrapper) PostOauth2V2Authorization(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetOauth2V2Jwks operation middleware
-func (siw *ServerInterfaceWrapper) GetOauth2V2Jwks(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetOauth2V2Jwks(w, r)
-	}))
+// GetApiV1OrganizationsOrganizationIDUsersUserIDSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1OrganizationsOrganizationIDUsersUserIDSessions(w http.ResponseWriter, r *http.Request) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	var err error
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationIDParameter
 
-// PostOauth2V2Login operation middleware
-func (siw *ServerInterfaceWrapper) PostOauth2V2Login(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", chi.URLParam(r, "organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostOauth2V2Login(w, r)
-	}))
+	// ------------- Path parameter "userID" -------------
+	var userID UserIDParameter
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	ctx := r.Context()
 
-// PostOauth2V2Onboard operation middleware
-func (siw *ServerInterfaceWrapper) PostOauth2V2Onboard(w http.ResponseWriter, r *http.Request) {
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostOauth2V2Onboard(w, r)
+		siw.Handler.GetApiV1OrganizationsOrganizationIDUsersUserIDSessions(w, r, organizationID, userID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1846,19 +2105,491 @@ func (siw *ServerInterfaceWrapper) PostOauth2V2Onboard(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostOauth2V2Token operation middleware
-func (siw *ServerInterfaceWrapper) PostOauth2V2Token(w http.ResponseWriter, r *http.Request) {
+// DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostOauth2V2Token(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationIDParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", chi.URLParam(r, "organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Path parameter "userID" -------------
+	var userID UserIDParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sessionID" -------------
+	var sessionID SessionIDParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionID", chi.URLParam(r, "sessionID"), &sessionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID(w, r, organizationID, userID, sessionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1Revocations operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Revocations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1RevocationsParams
+
+	// ------------- Required query parameter "since" -------------
+
+	if paramValue := r.URL.Query().Get("since"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Revocations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiV1Sessions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1Sessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1Sessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1Sessions operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Sessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Sessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiV1SessionsSessionID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1SessionsSessionID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sessionID" -------------
+	var sessionID SessionIDParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionID", chi.URLParam(r, "sessionID"), &sessionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1SessionsSessionID(w, r, sessionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1Signup operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Signup(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Signup(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOauth2V2Authorization operation middleware
+func (siw *ServerInterfaceWrapper) GetOauth2V2Authorization(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOauth2V2Authorization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2Authorization operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2Authorization(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2Authorization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOauth2V2Device operation middleware
+func (siw *ServerInterfaceWrapper) GetOauth2V2Device(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOauth2V2DeviceParams
+
+	// ------------- Optional query parameter "user_code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_code", r.URL.Query(), &params.UserCode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_code", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOauth2V2Device(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2Device operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2Device(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2Device(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2DeviceApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2DeviceApprove(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2DeviceApprove(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2DeviceAuthorization operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2DeviceAuthorization(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2DeviceAuthorization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2Introspect operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2Introspect(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2Introspect(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOauth2V2Jwks operation middleware
+func (siw *ServerInterfaceWrapper) GetOauth2V2Jwks(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOauth2V2Jwks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2Login operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2Login(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2Login(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOauth2V2Logout operation middleware
+func (siw *ServerInterfaceWrapper) GetOauth2V2Logout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOauth2V2Logout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2Logout operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2Logout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2Logout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2Onboard operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2Onboard(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2Onboard(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2Par operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2Par(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2Par(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2Register operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2Register(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2Register(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOauth2V2RegisterClientID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "clientID" -------------
+	var clientID ClientIDParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clientID", chi.URLParam(r, "clientID"), &clientID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOauth2V2RegisterClientID(w, r, clientID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOauth2V2RegisterClientID operation middleware
+func (siw *ServerInterfaceWrapper) GetOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "clientID" -------------
+	var clientID ClientIDParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clientID", chi.URLParam(r, "clientID"), &clientID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOauth2V2RegisterClientID(w, r, clientID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutOauth2V2RegisterClientID operation middleware
+func (siw *ServerInterfaceWrapper) PutOauth2V2RegisterClientID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "clientID" -------------
+	var clientID ClientIDParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clientID", chi.URLParam(r, "clientID"), &clientID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutOauth2V2RegisterClientID(w, r, clientID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2Revoke operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2Revoke(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2Revoke(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostOauth2V2Token operation middleware
+func (siw *ServerInterfaceWrapper) PostOauth2V2Token(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOauth2V2Token(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
 
 // GetOauth2V2Userinfo operation middleware
 func (siw *ServerInterfaceWrapper) GetOauth2V2Userinfo(w http.ResponseWriter, r *http.Request) {
@@ -1908,6 +2639,34 @@ func (siw *ServerInterfaceWrapper) GetOidcCallback(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// PostSamlAcs operation middleware
+func (siw *ServerInterfaceWrapper) PostSamlAcs(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSamlAcs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSamlMetadata operation middleware
+func (siw *ServerInterfaceWrapper) GetSamlMetadata(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSamlMetadata(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -2027,6 +2786,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/acl", wrapper.GetApiV1Acl)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/oauth2clients/{clientID}/rotate", wrapper.PostApiV1Oauth2clientsClientIDRotate)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/oauth2providers", wrapper.GetApiV1Oauth2providers)
 	})
@@ -2075,6 +2837,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/organizations/{organizationID}/oauth2providers/{providerID}", wrapper.PutApiV1OrganizationsOrganizationIDOauth2providersProviderID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/organizations/{organizationID}/oauth2providers/{providerID}/groupmappings/dryrun", wrapper.PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/organizations/{organizationID}/projects", wrapper.GetApiV1OrganizationsOrganizationIDProjects)
 	})
@@ -2138,6 +2903,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/organizations/{organizationID}/users/{userID}", wrapper.PutApiV1OrganizationsOrganizationIDUsersUserID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/organizations/{organizationID}/users/{userID}/sessions", wrapper.DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/organizations/{organizationID}/users/{userID}/sessions", wrapper.GetApiV1OrganizationsOrganizationIDUsersUserIDSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/organizations/{organizationID}/users/{userID}/sessions/{sessionID}", wrapper.DeleteApiV1OrganizationsOrganizationIDUsersUserIDSessionsSessionID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/revocations", wrapper.GetApiV1Revocations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/sessions", wrapper.DeleteApiV1Sessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/sessions", wrapper.GetApiV1Sessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/sessions/{sessionID}", wrapper.DeleteApiV1SessionsSessionID)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/signup", wrapper.GetApiV1Signup)
 	})
@@ -2147,15 +2933,54 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/oauth2/v2/authorization", wrapper.PostOauth2V2Authorization)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/oauth2/v2/device", wrapper.GetOauth2V2Device)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oauth2/v2/device", wrapper.PostOauth2V2Device)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oauth2/v2/device/approve", wrapper.PostOauth2V2DeviceApprove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oauth2/v2/device_authorization", wrapper.PostOauth2V2DeviceAuthorization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oauth2/v2/introspect", wrapper.PostOauth2V2Introspect)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/oauth2/v2/jwks", wrapper.GetOauth2V2Jwks)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/oauth2/v2/login", wrapper.PostOauth2V2Login)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/oauth2/v2/logout", wrapper.GetOauth2V2Logout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oauth2/v2/logout", wrapper.PostOauth2V2Logout)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/oauth2/v2/onboard", wrapper.PostOauth2V2Onboard)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oauth2/v2/par", wrapper.PostOauth2V2Par)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oauth2/v2/register", wrapper.PostOauth2V2Register)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/oauth2/v2/register/{clientID}", wrapper.DeleteOauth2V2RegisterClientID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/oauth2/v2/register/{clientID}", wrapper.GetOauth2V2RegisterClientID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/oauth2/v2/register/{clientID}", wrapper.PutOauth2V2RegisterClientID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oauth2/v2/revoke", wrapper.PostOauth2V2Revoke)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/oauth2/v2/token", wrapper.PostOauth2V2Token)
 	})
@@ -2168,6 +2993,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/oidc/callback", wrapper.GetOidcCallback)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/saml/acs", wrapper.PostSamlAcs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/saml/metadata", wrapper.GetSamlMetadata)
+	})
 
 	return r
 }
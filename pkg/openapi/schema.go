@@ -19,268 +19,389 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+XPiuLfvv+Li3aq5twrSZg30L/cREhJIgIQ15Jt+lGwLENiyY9lsXf2/v5JkGxvE",
-	"mkxP93R+mukgazk6m46OPud7TDUNy8QQOyT29XvMAjYwoANt9i+g66YKHGTiyvWj/wv9QYNEtZFFf4l9",
-	"jRUlGxLTtVUorb+QKtcXsXgM0QYWcMaxeAwDA8a+RnqNxWM2fHORDbXYV8d2YTxG1DE0AB3FWVq0PXFs",
-	"hEexHz/isZFtuhbS9s7FxejNhRJrunsSXk8njm8C1xmnHm1zpkF7P02wxBtLlm3OkAbt3XPxW5xMDtMe",
-	"AYxWR+wQlsJtd08l2uOJ07FscwJV5wCveK32koN3c+LwBNozpMKiqpouPjQLr7EEeOvds9ns9cRJuQTa",
-	"NwZA+n6eJdD+i0iQNpSAptmQkGA+by60l+sJsUaxw6MeIABtsnvVvIOT1vqDN4bEuTI1BDcUSJP/RP+o",
-	"mtiBmP0vsCwd8QZfJoTO7HsMLoBh6ZD+rwEdoAGHDedPDGtwiDDUYnTHLahGhyGxr/9hOs1AjkNnnYzH",
-	"pghrsa8xVXcJU2x0nnRX6c/yj3ikeTpozlpstE7KP77FY4j+DDLwcqhpaiKTT6YSGWUIEoWMkkmklGTy",
-	"MgPUSyjDWNDZ1FWgjaEDiTcLzrABLf/LhsPY19j/+bLWxl/4r+TLenE9GzmQUzq6myUbAgcSCYgU8cXW",
-	"Lv6Ix6hqMm1P0A/vzSIxn88TQ9M2Eq6tQ6yaGu0sslmqjiB2Bow6albJqHklnxiCSyWRKWiZBChANQEA",
-	"gMPcMJtNylnKbCZW6aYqzdaTXbObzSKbq4ZsqDoD10axr7Gx41jk65cvvPsL0x5dqKbxRQW6rgB1yvfH",
-	"MjGBA48j6eSYfJjsn6YFMeLschy9BaRpWJy5BLRvVK5LEv0GYscjl+TJgZDyKturW2p+jpeJ42bObNpO",
-	"JrkytaXkT0dyTInPRALcVO6Z7CNXyB8lwsYy4an4sAyzSVSuqQDHNE2DuQIcJhQlm6ISlk0UYAEmANTy",
-	"2Zyak7OXw9i340XIG24nabwF+vsmrb0gIVV0c4Q+SGi4Mv8am5jwQtHN0Yj8X6AakLI4ZWEHOEzlL6tj",
-	"5VZFDVStdFaVZB1VSAU3s2qpkqtMreduqVq4gMvqSutVUANVFrVJTa63++nG9XReQXOkGGXnpcUaz8Bt",
-	"ZtS8Lej076BXlisTc1Fv36Rqk1q2dl1ZDp8uWkP9fjFvVls1eH9fTj21M8O5VYPVYTr32JjmltXuAGhP",
-	"hMyz6vFyFabaHnmqYEoxLkcYqpAQYC8pu1LVps8ovw6hBm3gQE1qtRqBfyXcqrXDRpt8tMBFez9d8rZ8",
-	"RPEasGICW/sYhmNiNvA9cc1AGBHHBo7JTF3Y/Qsa/Uv5MUrWczgyUKAYzrk/BbAWcbSF+/nmmg4gZ2lT",
-	"/inzcba9mjcXYAc5y9jXFPVqtvyY9e/UjzmaTnzMncxd1HVzTiRnDCUCHQfhkWQOJf6RcPlRh5q7Lu82",
-	"LYfc+6FpS5pJJ0ccdzi8WHu6xjLhNU54jXdZJRkABeRlLZG7hMNE5rJQSCg5rZDIFobpS5hSYQpop1il",
-	"KCHOsNsbixRS2zGn8G9w8GA2r2UKMkzkUsN8IlMA6YRyqckJpaBAJZfMakBRYnHuikX0RflJblYeOt02",
-	"Ffd+upmtTEzU0rUO/fdLLzuh/35qV5L1qXbdblVIxejOwbKSg8uqrd1NeR9L+vf6UkOVXEUvOvV2ZUG/",
-	"h0z/lJEqZ8ed5NWyn+5nm90q6Rllu3HXvVZTXbmdKqdAu5pRWkkHPJcfe5Pu7Mko15spy1HlbElBcgbc",
-	"5DNPncK1cttMNbq1tHatL7X21Y1yPQbKqnyjtseLxk0t2+tYcu+2OgRyHz2UqmwtT71OuttKXqtTh/TT",
-	"zWrjub+qyU3S7pVJS365epkW+mop+QS7hdWL3M+2JxoAcrb+NG1eN6fde0Uu281lstzG47a6qqRqN1kD",
-	"GqNMC1dxC181lU653Lsbz15ky+zdWal+76X21KoWHkpVG/SemLp9uRun1VThvqO/3DwZi3bfWMxaRoGu",
-	"o9qeVufabbWtpJLPHf3qRZ1mH2CvXn7qFpqUhtqdPg/2BMsXF67dNJTFXWqg4PxDTQcX/bkM0m/EuasV",
-	"7/ECzKeVPnbu1FmjNAGLyWrWTVZ1o19LpEptpZREqa5TJPXKvdnQy9Vs7i5Vl/NWrV9oWC8p1Z2W7h6T",
-	"V08Lcl8jaibZneuVl/5sUrZXvcoNvDbLhVTZsErN297Kcefq+KqnXT7ePPWtIayWq6krOALq7Rg+vQ2b",
-	"z8/pbLN+vUy8NNSM1pu6s7LdzVdabjGfuByo8PIOpLItu+m2msBuD2uDq4di0r0uDh4Lxd5kTJa39437",
-	"VHnqguuO/Gw86w+961VOu9ful4Vm1WkOcKejEn3igIpRfZ7U649Fo/qWlHE1Kydv7geVXK1wlW43O/Yb",
-	"0BtXRmZKLhMzozwYqTdJAhqzVFFFN4XH1FVtqubS2Sm4Tpeyd/qy1y5kW1MtVxqU55Y1eerM+p2+vLy8",
-	"eUvVLdwdTp8zbuvRyA871xnFbk1ue/iuVr/JrzK11OBRr2XuWy9FBB+aRq046WcXvfxzf+CWnu0sVhL5",
-	"llEcPCb0SanbeHwsPl8/3yxAatFaKMXqzO6/9aB7m6rMitOSDJScZU70t44xbfZmjeesg5+fwCw7a6Te",
-	"GsVRqd8Ztyq955Wc6OfH6qrZaY2u28snI1tYdi4Xb923ElrOS+PRs95Ip+7n4zG2hw+Lum7XrjLZ54a+",
-	"Glcfk2r6ujS6fOldKo3B02VRzt9OZvbzom1cjjrXdmJCtF5h3G6hevXJHQxWrVr5sdutt9/wKlm7Lleg",
-	"S1DutooK3ZJcHJjuM9HGav0e5yawct0taLi2KKkT5amdfSOlmzcz0VFLt7M7eTDPgNLY0rXaKH93+wg7",
-	"rZcxuGo9JJeYDCpyqVAsXpdhQTOe67l56e7KzVdLy0Q7Uzbhc1Pvtu677m3qtoryZLgqlsvjHLofPz0v",
-	"7ozsfb04QKZ9Ve3eNFrPae0hd9/oPA81cjVsr0ZpUDNvllZKqRbqAKjOrVFeVl9qBZirLVr5zmJUz93f",
-	"wctbzVXl+m15eWW76ZJee0tdrdRxY6Gsrp8GJsr2zZa7eLBGt3p6garDOi7pb+X223Otepl1W1N50Jje",
-	"j2bGHQSFp9smAGSRfS4+tCxgDdRp6WVW709uB+bLOCNnEvftiQVSqDq6qasr2GmnypnJW7Zgl0rFTvml",
-	"O1y66TfnqgirBsx0R2OstGeg0q4qVhledZatUf9edW+fLtzZU22C9A7KV1VteQvTDwpwRp7SH8ygjYYI",
-	"2rGvsZfek1y7rU5ebvvLens8fbnuL2upp3l99bRstPty/bYmv/ReJrVVJ/syaRq16+nqZdKd1q+r0/qk",
-	"O65PiouX6/7qpd2d9ld9uWbUJy9PZiweG9kAO/6xP3JuH3hBgB3BBM+qsUgCd8HXAYWj7XfYtO4LEBSZ",
-	"i89a+yfMuKSamLi6w/wmG+pwBrAjeU2pF8nCCtQLQUPPRhPmxAxd2xlDW9KgA5Au9rBcS/tFQgx8JntD",
-	"DLxJI+Q0f/gpLdT36VPHhx163vQzSrJBFQLtd3j353vg63MqUB00Y7FAV2GU/BqbmGN8oZlwHWY5nkB0",
-	"RWe46fSznRRCeGh+jHsOVHo0HTA9E/saG5pm7KSVhWZyKNzpN7+I8ag/j7/yiL+qN71/n7bfYSljP2HN",
-	"MhG7lvzP98itHQtUWNAOAv5emJKRGGgxXyBjdO46dGDs2zpsrykpmElmEklAOSibLySUQjqdAJqcS6a1",
-	"lHaZH8bWV1lsbOFMEB7agDi2qzquDXfNKDRwPpeDQM4mUrlsNpFJKmoin0xnE1qhoORSUMsoMBc74VQO",
-	"VF14Gpd0RBx6AufsQO2MY5vUUPyIR65jztmjsJpiK0QmbiNGj5ScyiTkbCKdbCczX5PJr7L8EvPWDmEm",
-	"O0xnlEQhl88mMrKWT+QvU5lEKq2mk6lsSgWZXOjqKbjh2bjgpF1pmZwsazmYgIVcNpFRMpkEyMv5RD4z",
-	"VFJDkM5dyqnY+ibyhJsaFoIjyMQIj1oOcFzi38zSP/7j901QVnIgq6UScFhQEplhLpnIa/lMQk6nlXwS",
-	"aOnhZfqj75uaVJpETIYj10sRxiLncNZ/Plnrt2atb6fzFjmgvdYNOYNFL+WELObAhfNl7Bh67Ot3Yd/m",
-	"CGHqRBv8OoF7Md5lAjKxRPlPGtqmwbxybrb9pJOfqjBBMpMa5jLJxOVQyyUyQCkkQP4ym0hnMuolVICm",
-	"ZtOhfJqEA4HxPpY+mj9tU4e+/3lM79+28zLYx0CGBbWQBok0yFwmMhAkEwUVDBOp1GX2MpPKg3xSph/z",
-	"RIQTxjte0Xn7ukPHeQlECDNmiJ4BfKb4ycruky3ewxbfTuKLA/qJt2EnciF7UDV0Y9umfaauGkEMbaRK",
-	"d+3agwRpR5IFRpD1PZlPyXnqaAqXnlWxZ/RAl8imkkyzU65Iaos5MavN7vWV3lJ0s2rOnUKlfmU5Sss0",
-	"es3Hvl2/X6o3xcET/cZZxr7GbkoxthfUyKBRLB5b0Nnf9oqKe3+Fsfz2TCZ5pGm98cskm3hp1zLljJa1",
-	"q/BeUfTGbVdNZHG13mmSR+VymqiNb97swlMRZSf3WLvUp8b0rpMyMNDn5OnxPhaP0TGLRWiV9F4rXzMf",
-	"Hkqrt9pTStHT9/NV+RK2+g9jtWWTaX7ad5ugXs9kDdx1n8hdJv3UqDzcXGWfn8HdeNlqNUfdEjBq85de",
-	"Z160Z8npKd43pW0PKvdw2YKOmFOqrUZdmkNFmsKlRKBzIbXHiEiIsIsbxkRUUDXJchUdqbQZkZwxcCRg",
-	"Q8mGQ2hDrEJNUpasr1dMO2OnO0L7gqEPJRVgSWEmix09WfBt6fXmRZ7mgEgEjTDv0Rkj8oo9EWRctXlT",
-	"/suau5FpjnSYQBrkt5k/R8Hxy6/KNRWUZFLOFdLpfC6XSVimKqv5pDYiQ1ezZVtxrYnsYteeqDMnmYIX",
-	"wLLIBZ8zVVoeMb0LdUSIy2KkfmDSu8rzvzgxQLG5hzvd9620gy0G+KVN2x/IAt/O44EDZmyDD7ivzRPm",
-	"SiYeopFrnxouOHKW22MIo00WxJVrSQ2341qOWl7gOmZCQ0Q1Z9Be0vUEoRoWQyeuZZm2A7VXDPSRaSNn",
-	"bPBfhhA4rg299UZiz7+um68aMKGatnUi22qmAViGrxfxZB2E19zm9ydeu3g4Hf1rTMlBNZVJagmYVjKJ",
-	"DBiqCXAJQCIry0NVyWVlLQ9P0VARWu/WT5v+VPgPv7bX/Svv0rdztumQCgk3vZCkmkkcdnYmEhmbrq5J",
-	"JtapBwQlE8M4FUpoSyzzjFC/hXohLF052pNk8oOXpQOHHtkvQlHZX1hIQ7c2P8coha9H4DArF1QtmVAv",
-	"YS6RSQ3zCQDgZQLIyWQuo6k5WVPPuBDafUT2GoT35pcWzt9id76duD0H5NNvxTbJTz08R35CuYfRoKQG",
-	"h8DVndjXrCA3UPKihJKztCCR4MXoQroPQohxia7LdWBcgo56EYvHNEQsHSzrfMNK60Dn0IZwRww0lPgY",
-	"CYiyoymd48nZjrtY/smFNoI825G35I5I1GIBXXIx4mJhmzr8eTKxcWHr6U4pmud7cZ7w+Jo4wXX3UZJw",
-	"PDMzOok52TstsxbSHDnjXTE5cWbpL3vRJcg8/flaaZiSZVnOXCaShWE+kUnlYKKQVgqJwlAGWWUIZKhp",
-	"MSY+Xqf+RXPbu2f+lXPB4zG4sJC95NuWTcjpRDLTTua+JmW2befm6nLW2sWt0cxjn30EHPrJmx/Om3/P",
-	"fu/2gDZ2mwh2+Ze+Ef237vO38zb6gC8l3m00wq71nmC/hoBujiTHlODC0gHCEpB4rzz0z4dZEgcajc8g",
-	"3R8XpOM77wfpNHHQzktGPcegbCSOfT7W+Hys8flY4/OxxudjjT/hsQZzpCAZIBz7ms7JMjX1QlPQWXUW",
-	"NcTPcmOtXDD7z3WT6h7ttnpX18t3cJrtvdxkh+rkJdeXb1ZNvbx8Wul63eg+Kh3rsZ7W7dakTNrlq0W9",
-	"U5WbzF6Uky+lSq63rGT7bXXR6HUWL63kuN8eJR/azXFtcuP025VlrSWvapOmXl+N0i+9l2l9NULPLWqD",
-	"kmPQm9MJvimpsftgNGcvnStd6ZUtpZSdKCmZ6nod3hVRY3KTarRvkvVVLVNf3ZCKoY+1UiVXa/eztfZT",
-	"pr56StdacwSe6yu6LnDXlNW7Wu5hWbC1XlVXjayu3XZXD0Z31U+NddWoEyXdnT4Y9ZlC14KvrH66mVSN",
-	"Dp2Pqd015+rKnD2ktbS2zGLVKKf6z82xiti8Zv3nl7F2W14+rMZG3ehk65NKun5bW/Z7VaM+uUn327Vs",
-	"41rT66um3uh10vW2xs7FarqL2PyMgqmg7FRJdYseHdx+quBQO1DsL1pmcT5174dXlpU1k8Qyisu31Xja",
-	"al7mxsqknGyU7mEGPbRyV6XHwrL10ofdxPSqpMlOWtVy3YXSyJa7T9XHppOfym/5vK2mktVie9nNT1tq",
-	"HduJ5KRsFKvucyM3AnIqed9uPuHbXP46v3qpFx7mRq3VHKfvHstO4y3zUFKNp5tWCmiwuiTmbaGQNwzH",
-	"bc+tzLBozwFLTRzakIz/KTekrd5dyc0bOf2SanbVm2q3njJTzXQTt6fZZfMmOa0ZBevlzkzWe/VVDSVt",
-	"9cZqAnnRbnaqV632S1vTn7ItvZmD19pzTZ4uO53CjTbNXit35Zp2O27U77R062YMOtfdm26yfAMMee2G",
-	"dAr2k5ydqtNur5msou6qnG2UtfvmZDzvpK9qwKi/9SfVTL13s+p3xk+NGz3zvHq5ek7XV51UUm7cdFd9",
-	"vVlTrsttddLst2TaLrPspiwMuv1U89bqtm61al9Omj1czXaWSbdeCrsh1VUz2c8AubLsT5vD7qqYeelW",
-	"K+qk+txMNR9rt+NF18g+dzpOGdw0291eIak999PNm6wddkO0XtYCqcJSQcmJcltIvpSyM9VQZyp+sgHW",
-	"ZOaiNCqX+ee8Ko+XLdUeXF9e5G5HzkOmpVbtvJ4xF+ZlZwamiftns+44neunhfGCK1O1ep1/ssAAVhvz",
-	"XGvSu0uXWoWJPn1plkZp7bKTvHQSikxmiWSy5xo9vTO7bJbJZUa5AVO70IGpRKurjdxrUHy4u9EKo9Ls",
-	"4fGtm7synh7SLdss90Zd97IGkdyRkWnD3E0C3icGinNp3HZkuf58256NHmvT/u3LdG4/56FazS/B5CGR",
-	"dBKJenI5ajdv0/C6k8HT+k31ppxJOm9XhXGpT8ig2DFKuELkZhlYXTdxOb4fTXLtldbAueL8cWK7YDmf",
-	"6ZXFalK2apUeUEZmp/i4egODVsPWbxPgslVI1tz0eNW8VLJ6+THVzt82M2bTHJNO3W6+OIXK6MUtVm/V",
-	"bu0yY8hOJv0yq7bur5tZGRqXiVXVzmYzb5oOnvNvbmrsLJx+50q/TjyuFvMMmbvGPJFOZ2vVFSDPj7el",
-	"G7t9PczAVev5qqRUSLZyl1GV5uBx5Vy9KdNu+yXVf3SXl2qjWbl/Qqu8rtdeSnNkkxTQLu/uZq7+UB7V",
-	"9Gyrk9NnudUYJZ76bUXW2jM1f63e341v9cny+skp9ZeLm3Li1u2ku8/o+i6Pb++qupHqZpsT0DTa1tN0",
-	"UsSD1FWho+ev8vN5K9lsNEpau2upqtYCybKcQatKFvbbjWQlQxYOUOYFO3Ejp/LLnNZtOEbr0VKHYJLP",
-	"31wVBn3tMQ3zPXukdVbyoPp4Y2rLXqdp4GwFm6XbnNnoz1xz2EWt52rmueFMajeXs/EIZ5ZPw4YOlTZW",
-	"uno3t+rnurqSunrEl93nbrtUnK0qjjGc6f1yWh1lEu40mZwmHtqt1pNsaLqey43wvHX3Nqk/VYwpns6t",
-	"bqltGK4F9cmtrDz1Ok6ymiKZRn2GH/BjOW/rGNuN3lVpNse1dFprpMbLwtyRoWbdJyq1tH7bekRp9JzM",
-	"3LQzplXG6EV5eFHayCrNH19Wsxa8Hes1+PzcXo2yb279qe5ac6eilUd9owpUnJaTsGk2Lxot6614WdHc",
-	"afEycffg1DKlZucp5h0m/XeVVxDY0D7xWaTwRBsFSWIJaS47ew5dnaVl2NBxbUwkgCUv4ZvwtqxPnvXm",
-	"vzZ6xQZYSqbFL1z0pYSwqrsae7HHHin5gQcv/w0NeT4cg4Vig3sZ4FBjB2gXo6lp44Sqm642UE0bDgyA",
-	"8MCajgamBTGw0EA1DcPEA3pgthyohY/b0aXyifIE8zEgkgIhlvzP2FLnSNclBUpDVx8iXad/JUusjm0T",
-	"my7RlxevuG+6El2jZeq6907UB/fCmmSYGDmmLSGHSDwuxq6i6IboMAg4n7AqBfhYKOeFEVioir2MmgEd",
-	"aQNv/dTHpL8MohTyqaOYGt069skJ79WOXxafloAdm+EZDAGie8D7l9hs2ELjkpfh689XMyGRsOmwh1UA",
-	"4VfKfEEL9vZviKCukVPJr5p4qCP1ncT3e9lB9RBA3Bw5Y47aAgzIUlEloNsQaEsJLhBxyM/eDW9e/gr4",
-	"VZ8EsOmMoR2XXOIyMXfGiEgGBJjdwi6lMZjB6DpOpfzQtBWkaeeGz3zSB93soD0DCFJtyNQS0ImkmYyR",
-	"ggUEDGTZaIZ0OILkn5EIqhw1iBFPFY5o4rhHf7CkyksFLBeP5RPDaMNXzNWut0KER9E1cjXsXZ4XHyuB",
-	"oDEyUSnDf61p84rXwEtr6gQ5Qj7CaiRZ6ASKIOxAGwO9xR5K7QvlH8kL/MWVR2kxO3gaxzE9+6TqABk/",
-	"d7+LWHIxXFhQpVaJvzIwVdW1bahFNxpEWjo2wARB7HjfAKxxa8zMOdTovlBN49jLC6ky5D0htqF0u1RA",
-	"YFyydAgIZQjLtB0JORJgdp9F2k/dP2w6ZdPF2vs2DZvOYEi72bFjITMAtbUiDSwCU5s/dwc7GCg6pEw0",
-	"RFgL4X+eSkEX+y/s4Dup6N0mcP2xywxFPUEuCj+Z90VT8HWQ93aaCyb133iUzHMUyU9+HvGPvHE96yL3",
-	"78FnCN/76oA4RZV+5F/9JhPJVDspf80UvibTLyeiOey+2A9ef66hEc66W3O10K2hfyS5iHDwhWl7kdjY",
-	"12Q6mUzlk4VLOR5DwPH/IPM/EHJsZ8RVdqCKnowJcQgSESim66xNd/B+NixEASX/hOfhv6/ofDuFNw7c",
-	"WrMmFyF59OFBRHCNYtAK7iTyFxsWtA1ECH8QzshsQdvxYMZHuqkA/QjIjJsAymPjTcER37boZLRID0EG",
-	"7slfPzBX4UfcR1E3FR/pJzRLMbCliFAh6nCfeu2e0AG2KcY5Xhy28D5kTeKbMO9RoJODy26sG/sA8RwC",
-	"5z8xr/9Qd9/2k4OczDks09WBBjmBNWLrTQG2DZbeJIKFCIsrbIwerIlOAGLXOAqkRkDrKAWPX/6aqKeQ",
-	"YL1GMQ02JWBrPsEhnpFfC55j7ZdeGO7wFAFG2gEWrlzzswc/bDIbA6lFutjm6w3mZJVB1hPbwZkiod6z",
-	"SfaR5Dl2vwQaaWvXomAuQt5dl2wJzVGwT2Eb/EHuuadA+UJ87qEzrfljhczlccAiLdp6czuDqXu9Cfcz",
-	"2sVu3SuosSCgVgTI5UDuu9dhMfjkwTtJHsfhwJHMOYuHIbJR+GFLo/DXE3v7pE1O6nWD2GyIOBehMBX2",
-	"E50Dq/1qDOqP9o/y437NH2XC43THBsSTQG+4zrgGnbEpYJaW/6x2s+yFwT4gEaPHMaMJVG3oDCyT3QdE",
-	"/6gAglTKVDoZeL/QfsX2cE9tjq15AuSMU1+C+hzBd2u9G6rTsSG8qj2YAd2FQiPnh4C884uk6oCQNWSD",
-	"xL9kGOOurgOFnmF49ZytBYUwtUUyyX/2rrKGiOMIbnXivZna7uLOnEuOKXm/s1MZB0GybNOwnKNm6KcD",
-	"DcY7PFLLhjPE7qx48E5jSomf+o7on01oZ+d8uvRX/nSMHTF5kP+Ivg2wGICRQKvUwEICI0jlJyDKUT16",
-	"FWu+i1AR2W9H9cLJv6ObE/Ymim4rVuojRBxoU271AG7D9aUEPUZq6Ry0W6wxe5r8I6i4810QalsLH2t0",
-	"1Oq8o+5mdyUuE+xXCa0DEUf16aIBVX26SLAfAB65jCusQJ0e6HBDrUepF5bujb0S6Xt+AbBH27IGEe0K",
-	"XOamegXB2H994GXNiyjFY0NgIH058I5bIzSD2P8HAg5Pbo9ROaRk8YMh8ZiFfGRL4ipCZayaGiyNga5D",
-	"PIKHjQVtLql+e5GxaKWyOTqyDhAWjsjwnttLEZOtx2GNgmPvmlQCdGhvf0KXcluJaOJp+HGb7/sBoqTK",
-	"ddQoizHGeBW2rWE2bfIar2xnT/Mxw5gG2k9xjsIBlPe78GwFZ3tL6693EWebJAFo2YEnQmxHfJdcAFt2",
-	"yucBcNnxH21Qw+9BNJV4sKadNNrlZ4c5iIcq2HUIj1Jov7Sz/QGcc1iWj/euQ7iC20K8Rg7bHjEMGXYh",
-	"tSCMosNXe/ctSTNV14DY8a6txIjwO/RNpP+YgBRbf4jinO3tMIRxxnA0aV8S/QcPqbNMAizBBb9pl9K2",
-	"JlnAdpbUkGMN2Bp5xT6uALyQSiJ8/KMWH2VTDnn3/bidC23O1taJyCMqabbtVjAX1s8fCvkWG1EwXglO",
-	"5MTtqg56jJ/JEiPE3cKFpavIWadP+DbzXM+MdsrdMt6UATDpaCtDZOMYdjiiwMcTCa8A8UwUQvAKKwQr",
-	"/Z3tZHTJZ6s9QTdHFDHeptf6weURB1jVT6pjkEBjc86lOIQJRG1OpFSxOccsJcp77PiKWWRqaboMJxFg",
-	"77OhaV+84tjOI3aLBRv2TpLHI37u5PyHpaJp8SxW1iBOZROpLAFuPoY2S/mwoM3RPVx+xvdg0GyoAwfN",
-	"oDjO7f/hFB7j57vNCDmfeXzNAIe5TOy607Uya2EO10m7YYbzfXj+1jYWjxlItU1iDh12oHHGOw4oovKM",
-	"R2uH38wH+lu0wl6vaPsZ8pH+kQilcttREpdn3A6Z8GaH7SsvfBn5eHttM2grJoESwogeBz2fONTqKPMY",
-	"rrEpYLdo57RZXHjPGq7FeeS8I8rp1GkLin+KRDUyhh+K2w4kmcIYS+gyjMH5OKZ/Xg8Q1/ccl+k3u4fc",
-	"scCAo3Z4LTzpc/28njU71icRUS3CAEIhE4BfbjO2APpSEKiOhDXgzsQBtnFcYIPoN7NVnBlZ8fBIMJ8O",
-	"xUNrsa8x10ZiywqQQQZBHaBBALi5PYHeGDJHnX/jAxav0ykR1mZIYyneIdorpqlDgEOD7RkihJiwETM7",
-	"VjMFObibrMNKmgWxq4EXuzpuMuK413qOR+tNUbxNGCbyi6KdTC326RrVnOc/ht30I8+/fpROMLvgOoGg",
-	"EUZ4NAD6yLtqOXK2/DsphOi6XgCduX/7cLxB8ros+j0K573PUeOiWmFNpP9GhPA3IP8jVFOT+ZTsDtgL",
-	"hRQuLJNA4iN++yRgkOFss9hGrV+tHCO8nuidJr3eR4H8WoAQ9nRJAlK115a4jhMLsD+ga6MPGXSGgASk",
-	"TrOyazgvFm+Y2umS4H8tsa+P5iT/s5qpCbk/ckFw/qQ4liJjjS1F6gvv7rP2SSvZJcc8werUFXjcfWJ2",
-	"Fr9XEkyCa5JzrB4JsPLhQh0Dqpi9R4eh+2ymuQP5IsdIVXRG7CL7NGtBDlyuv1c5hy72BQT1c34P0RRp",
-	"6pqiPiVH0Anfx3mJwQBHMoH/IoGbt5+Wu46bOzyeLWYQLSakfAUcLPAx9kjsHg1zLBvsstXHWskj/JK9",
-	"Ttp+I3BYYwt9202Ab2FQKXyE+LmxOPs9kbdwHO/sE/ZmJ4cItE0WHytcGNRlQWLewk9v2OwtELyxSZxd",
-	"ab482FJyiWMaHGVcYJ8xy4nj77v8HBWOjqt6H1J3zFdZvEvJABiMWGQlNK1XzK4OIHvQRKIPCOIsA9yl",
-	"59thXLrlvXgVeJjr4SWu0eXarjN+pUpIal4VSxeHDtbtY4Jhm+1/RNHXRdtQufaJv46um3QJcf5WlSWk",
-	"stsRB9qGf0HiEo/gfnDk4hVXhtIQ6PxD5GUZsXMzkBQX6VTlBmPE2St3evilo2DWKspMr9ivKSyZWHyM",
-	"9jtr+dkcBzCoQ4035WGLcocEQhwgvGb/Uny2cJ1x2CxiqvOErC5JRW1sqhuW9BX7r4eBD1QPbEj9AN0l",
-	"aAb1pQQ0zXMw4cLSkYocL1hjQEOBNhkjS5KKWJMMlzivmHImkP6iujqBMP3uLy8OdSFJ11wUt6z5xhQM",
-	"sORpSK+YjuuMIbKjNz5xNppfkEwFTBw8OduOm5I46/0VG8Ai/FGOpxQ4U2wHWiUpIoEbE/biaq+YuOqY",
-	"Sh0yopQBloXwiPsnvugFyRd0G2JxX3F9OyCUuyO124bjdwvTfrQJ2R+ijdRmODo+u1WjY9tRDJcGEOYE",
-	"8lLRv/M9m5+bfu4uhb/fTaIdgeojUkSCdpuTC37YM6udWSCRnfvNhOuDdmyvSK3LKRwpTZEiGkJBilrb",
-	"XaZvzHN5ddOculbEs4jzzeKP4OISt0aBb+BXEvRbv2KWSMu9qC81/ybN6yQsQrSrTf/Bf7oivJ7jr/A2",
-	"HtaJlD0rnbBLdxCEqX/H2gium9elJ7b9LiABg4Gumx7YT+ipxhwsCaWHS8JuD8IOHHF0o6CMhcif837k",
-	"qd2+sxFMUdTZUfc1DO8ZB28b5nTOQc+SjgzkkH0p3/WdtzQM0IUDHvEqPAr088C9uAd3qW1x/7zahqjj",
-	"XRT24yr0SzFNdj89YS9OQm87hFNaV/kQbbwBFshwjV3TE89oXSvk5JV6wBgs9sEECriOSVSgcx25PRYv",
-	"RXIORemXoj7Fz24CMnlDensZD0lOaOFRTory7VokRMoykOFDr954nZSj9eVaNwi0Jftxp+3arzl+NfY7",
-	"sH07ac6W/3cQndN1F9XJLn29PWSU7n7doCO3XZjz6vWxkyZkJ0984OzWhD9hettv+w5xrqS4juSAKcIj",
-	"CWF6WvMqiWhLDAykbrzF+1nm8Z3i8/NUrViuhNrvuA37YFkTcMSemyJ2k7TntcP2RZXvjr250F4y9Q9G",
-	"BsSO0BOLXPMcMwyLVUde+PGnFPy1RAg9mgenpcg/Qj96oIyi1uG/YhOLX8bbpg53aST62z8YTN518hBy",
-	"21FpMsfzlk+VXTd2+/Z4fSvn7y1PVAk9MbJsc4h08Y4ISyQJVobhfLOQym8YHYiulh85w2grJ3zLvzl0",
-	"YA06F7GRoFyR8P36J9X/PqrvRg2IFgTzA/A7LPjfG/4REuGYSbOWoofSoVpwoue7eCTpaAY12lMIVC2c",
-	"u+DfwGshOMgN9CiBuvErQAlvpdgdi7cEz6YQSXOhV2MJ2TByD6YBByYcJLoI2yCuN+ph0u4+pOyoD7f7",
-	"MfovFGETyd8ZgbbNolv78iG2Sm4dmzQiqOAmMIqbqV/77ONW5lnYVt60ssmU2DCu3/LtWyhrtfe5KJaA",
-	"rSDHBvbSa37Uu1FHLJ28lJgnHjYkru7sku/Bji5YLogF3lx4pLx6hUaELwCQAekBhEDVxBoJ5bgwqGwd",
-	"EIe9oxAeTtY1S0Tp/ZXrUgSTQOAFR2pP7Fmm13JPX2HsdCEewya+JGK3uQqULHouwY4HChtGZOFwsodz",
-	"kkNjx6NbF6G/SCS9+mH7k+3NMMuEU49ET4KEoBbe4/3K9YXU9KGAAyTqV3YIeI1tPOE+DKIhPCNFYQdo",
-	"m48e039rb+9cJzvU+K0+cvh14tCRT+ElqeYSh5lbxLIqg5FN+v8WIGRu2tprTJwT4P28B/LLnGNoS35D",
-	"8VrXo5y63v1IFx61/UYsKfQDiX1APRSjWiFId4l8duqYLoG2+A3GBsX9hh9L8S2fMmA3kfI4GZB3a1G3",
-	"3i0VB5Q2ICFgBHl1cOAgRffw6rkCEjyeFfdalBxoE+j16j1KhQsLYI3+nwcfftduP3pNuJZgAOA8K0QB",
-	"hONZ04beO7GIAx9n4TqeQEL79clP52cj6HBbzcoz0M55fkTxsUIkhmtPHWHauUmg3y9PBeJjhR2M7boK",
-	"YdBmDzpprfcDAGYXBxl7g02AEr9PHhuIb+KWO9CwTBvYSF8OXAxmAHGeWX8YjOr/gXHKxqgh7olHULZD",
-	"1Qp46uKA/gp03ZxvTd2AGgJ+J2usfZHDJYCc3uSMrncB53Ga91ZK8WHqvbKrB08DO/Hd3ykl06BC/gNQ",
-	"oN4Fuis8TDCas3r6rLGk09b8kjL8ZJSFTinjRcA9IxnkrxhhDS7YSxxGAurDU+5nwgYcB9p0yP/3HzlR",
-	"KCZeQGL17b//9+v6X4nBxbfvcjyX/BFq8T//+18ia/Lx4QOOt9cYMqzgvzdT9PuG6tkECj74ZG4N5hV1",
-	"8hRIT8vHwVNuDLrNbd9OI/NhHMa/g8JHx4Y2ae5Ndxe5/RyWj6D0eqh3E3nr0L3btPuHaE/AWVxE11lg",
-	"JFiFDYHGsZHnNnJEeIh7NWAE8jH0k5dnGiqsBFx2f8CPZCyvgFk4w7Qhh8BbOOL3mJ778kHcItSJ9OQC",
-	"RuQDh3GAGHaHv+Y8b8cfBaDgOxg3aOchaZjDSFWHtTvg4ik253gDcjz8T369Dzd+5jbr2/vUsv2zVAQl",
-	"AlKb2+Gq71u8zqtecVD4nQGGiB7gdW506OW1HRMOFAO8f7jNETCMQDFtNhFoqPiJGoYplVOLh+zYpL/T",
-	"KO/hBC9p8Wq5G0xHmo/NILkxzBJCPRatN3A8a3kDHM9au1BAXYx47CkA/RXO0zA1dnw4uHIvl/Pwyv0e",
-	"D6wcRNcdShU9I8LO7hsjJD+Crdu8KJjH0ohEnF3Pz524xKvRw9+TaCb+y/ELUr1igJdR/UvbjCHQnbF3",
-	"gONHPepqD5HDU+qBj15Fj2CvOJgBX3cE8+Wsc4ADRgfiwA4YecXbsMYd/2PB/os+X/ldCFlgJj560L2P",
-	"5EI6YHTYmfLeGvE+v72bNIdC6tQzOPq+4MRd2fF4ctfVK5M9qlxDT6R/s7tXuoTTblzZFx9wzxqMvIuw",
-	"H36lGgILOWaBHP3WL8Qi1L3QJn+xF+wmpodyDvsSFDUEeBvd7QD8iDdaPAAi2Xvvu57pHtQ2wKmJiIQi",
-	"lSuCWjMWZIEzhglL6D8iOVTR4OXOu2VNQ/xowUfzOWF723jBGja0cNLsSsgzQKwrDxALahIx6V9V7wlW",
-	"m9efY0XaRi6wAXYgu2V5xQr0K6ayd1+q6tqUEhr0liqZ2O8WYF6dkjg2QN5N5JH2TbgdO2+I/UKRwIFf",
-	"uC39mUrj3KvhtXrY5NRdt8BBgaltGkTux7Bjm7QTDmokZBUF2c5Y28neLM/9L4m1khhB+cY5PC/BtNlW",
-	"O6ZUaTXyOTnJeAZKSwhsyTJt/mKPZyHKsizzWsJwKalj0ySQ8ZVjSjacQaDzX4DNDuhUtk39BBfwDBRI",
-	"cR9rHOiduBpO8FzY621dqtj/WAytEUaU3jdV4to7/YoR1R723s95E/HXaxTrfT0MTRvunIEHeL3v+2a5",
-	"lM1lcpLuI4OLvZx4zECapsPDE+LtmPL/b/I/e6Mmuxfl6voeECykTg924TcS3/V5wN9bHXSaD/7NtNeP",
-	"13THM2I4hLYNtcHuqyz/PpxrPCZM/mW4x5IRFowcwlke4jFz5E0lC4zEE2Wl8oRJyVHYCs/iCvvwjjwD",
-	"IDb/WDQhelRiJix0XtrqeA4VIjQT2wv1mgq7WZkYilVtiCsqxXpRAoSgEabWERlwJX4jvu2K7NXvh7IK",
-	"uMWjLaXHRqu9ryrHwWQUnqBwIBlll0Xem4gUvJU67jRBdiEbsvQn1bWRs2zRxt4tApt4tCipEBPOXieL",
-	"IRsS/05SYUX6PYspQHLTzfk2XlzJy5uI/LFj60cVmfRgHL/MUl8i3wfIKrGv3/28kjP65Jsc3ir2E685",
-	"uJuVO7xLqeI/by8+VvzHiuwxI0sGYi4fAtiRWOXnIVA5zo9LvNg20PVX7PflZZJ5dQhtc4EguZCkIpGQ",
-	"8xdhXbB7X/q1F3owXN1BCQdiwMrDmK72ijVo6eaSR9KRQ71T4tU8BqORDUd8Y3WwhDbrg98Pr3Gy2cz9",
-	"ucRfsYaIBRx1TF0XPYwxTdb5Q15CAPtUAeoUYq5mkEOVZ0xELXo+hzbhJJUvkheyD1kILBT7GktfyBdp",
-	"fic4Ziz15WIOdT3BotFfeMZ4Qt2PbVihHjenBJtaAF5LJzcSwfQ22WWH46PirtFuqVr3L3qWPDtvoxx/",
-	"UMUtzgtVhxCWIol8Qc08Vu7zFjo9qOv3dFUNAV7j+ukEI0JKlnephKDdFwHuY1AO9Qdj7C/AQl9myS/C",
-	"Mpm30GHsUiw9UDVtqohF8YJsD4ar5OGohACufJhgVmeJQTNITIEYpgOjvO2YfgHBV9x53rMXjmtjslWD",
-	"MtDVQQaMj/S0RdqihbrJoqqfRUag6muyxWMZOXn4m/eWwv4Rj2WPmdsHFZ0PWwkWPhfbh/98+/EtzDa8",
-	"lbUHvVenhsBD4KECyDJPl8SBhv+yewvWl5+FArwQj2NeMSKhDINQnSGuQAUIIyz2HOhil0jAQ95+xV5b",
-	"flfL1KxJCFLokdyD5eYx3DmUMD+6h40cjDCbkGcfENWL3kq3wVJ2M2ljg6TnMCwfthGFVv4nWDgjpz90",
-	"nCAN53eTkwMAKiIpiWKpSFIj/G923g/SDbxiF684DMblyVEEnyukpW3TdaDAjoNXTA13AuI16IIUQnCQ",
-	"TFsKQBy8MYJ2Bliy16LzVxyxyD70r0QVOW9BokjDdMVDhGFiZAOGTsWVPeuHafsgMY7D/4Rx/4IK4Jwg",
-	"EYihouOnOb9iL2rnvQJh6E0so5ESG8MNKArgoS2xs6GHwySZQw9x3yPxQYslRsbh5KBbyPD8v/gAZV7V",
-	"iv1WLMIHzC/ygPLIznvPdRN2TrihLPHo/43dbp3hW4Sn8a/VLRk586GDYNMpmy7+Lex8PMbKZ+7SVsE7",
-	"IpYxguF8AwvNdwtZdYsglZOjvoRtaYfnlXQYKhm18Ta/Uoxy/6NJxOzv6a4rU1seUeL/uGrz29hgP6Ix",
-	"CDrHH1tSk/pbJsBP9duV78OECHILiMvU5tDV9SXn34/lLQX4BRI+3YlfxJ348j2SnHn949O/+Gn+xS77",
-	"fwudTSCrIw16I7KXsfda5k8p/flG8yR/LCq7UafMcndb302YNH6JEYrsb5hP9zhei9hSMXH9JogSmI3X",
-	"iDAcfyzyQ8y5e0xY2HQFGWPUC7eZM01X9cnEv5ipOT9uGPfDP17oWITN/DvEFPcI1Ge48RfTqCewdQCV",
-	"RES13MNuVKjphSStMZYYAxkS0RlnBqhNjEcZKLIDdAnDGQPIXKjQS4/1Wnlvojhs9xqgMVTe1Huavif4",
-	"CHR9A7rrdBYO0eEsVl5//xki+HeGCH66bO4sn3zokOOXVZZuOZK/58WHrw4YermF8MjPtvbr8QUlhZ3w",
-	"1UQYat/Eft73HOk6S/TjqO7e1cEaGYAXd+GFNr0+EfGNGNUhQSL5K+bvY9m5xyVQcDZxTAaMztZLtQVL",
-	"IVxD45ETQpRegYMgNhnSNMKqDidrE073sxQJn9vnaeZ3Ps2cFkwMSugfEwfcyWcnnmd4GO2W11PfdZBJ",
-	"Hsmu/2KLV/jQQfxX+f+uoxFXWV++s/8i7UfwaBDulAL2DN57V8gkAfP3Q1Sp75CHa9bjcRJxy2cSO+Zo",
-	"ztpGz+TBy8VPD+7f5MF9elHv8KL8aJwPqMy79uC1TF0XxeKO9pT2y+ufbIH+9f5S/OCnnlk5IWIcYc73",
-	"RIq3ufOssPEBN+s4o/RnBIr/ZAt1vMd1XjaiqNrMRk7i75WS+IrD4gp0QfohvyKVlqbL1AGDKVuari2Z",
-	"cxzJ+2Y3s6+4MXXABaNBN8ky3FlSh5fYHDJ/a1Pum3BpDLCm89nRWdjwFXuXxSF6eVVotrfiUIgzegv2",
-	"Fzkt1XK3ivuILEzzD8m//AyA/p0BEo5rfwynnxcsEXH6ieY8yujvCpxsdvUZQfmDIygb9vzL93UR3L3R",
-	"FB4TOU9kjounbAjN47o47zFubGXLK/iMs3zGWT5d0V/fFf1pp/u1HWRq5YhTfocDd5znJ7jO+zTe3+Qw",
-	"HEgZE/HTOrDxqT3/xBjA7pK6oTfAPl5rSPv6OWQX0qPXQ1wCRFJd24bYYVaZRa9fsRe+vg/QQf1v4zxp",
-	"2itTTpVSJMMtiu7wijev03cec1kij7+uj7uN99d51tnWn85nhPlfeuAk3jW8LyoB4GI4LfIvcoANjzmA",
-	"RvjwrPv6R7/i9S47kjqaoaN89vl25vNc+zfaKHagZWDfx5xniV+DncmjKK867INrASYWM0KvPleQ0O3p",
-	"+o0ekFRAVMAB6ALMFwb1TR1nzTtQ6zp/L8Nf0Ki6S31mz4raPLXV+yM592Tta4NHnzKxc6T5hO2nZtly",
-	"NmXlU/I/vdMTbPKuR2BUsjyJfZePtkca5E/b9snh/6K8hsAkHh3z2CtkR4Q2xEJ2VkrDQU9UENHwvhGm",
-	"NVx8yuqnrP5UP/Q3evr0j6uk/cdnvPfx1Skn40AxbT7HOlFHrefzrjvacDefHsXnafkf1lJfvq//ceAg",
-	"HfgL+2XzzJNqSDqLoRntOMBuXweqgnfwn3fBf8Bd8K//oNh/0f/O58Tni4x8vln6FJrPI+j7/b3DX4Wt",
-	"0Cln1wN+ovuxUvU3uIzyp8v4qQF+KZeR2zxhqIVnO0XTroK7TR0ZyCH7raBnTz/sGv6Jz/UcqeJT+byC",
-	"/7dBfLVO5rMjrESIz060AT6bvUP/izj1U/f/cWhhtqnDY59lsbY8eZOfULaguqBtIEL8k9ErZgVT1vAl",
-	"OsLTIPvKkGYI+E8gD2KihMb2wKIZDpmX+fVuld9kZDhHjtjEPhn8D0A58nIKgaqaLnbIYeAUhlceLbPg",
-	"5U37fRwBFyb65GT2bm1M/awKBryPotfHJ8v/y9MMNxgv9Jybn5BDubPxs+5SREx5oh8U5Uk+/3ddpIg7",
-	"/OT0X9R72VDJX75H9+/A3UMTGuaM8buY12fmFG7x+rl3Exvc3tqY6FGv1Fob07TZAjjSQniO3tw/7yj+",
-	"hPdqJ7obPy1kuymKJyUPbcz8rOP1MRL3t9qbk52qT0vzG1uaL7YpLq7+e8up0FNssqUKBPUvcsBYnuEY",
-	"bgouH/wDzjCf7t0/IXTx2CKBzYTClC6r2nO8FO6q0CuKVg2hRhnPe3ZxzFE7aHfy+ZpVSjqLI9mYnyz4",
-	"Lz9LsyJ3zEv33wgRDwVAIjCE9Hyevlxz36kZ0gTaH3Bo5oWuPzMC/+CMQKbGvnyn/zny5RxYC4WGSEgu",
-	"2NWAX4F7jYAOl+wKIVT38dyTOBOXDjkaG4Y2jR611/PSENlVhfnz7P2nYcUIPI6f5sVzyTvpjC2uonPE",
-	"wXpTgP4GqyP/4VbnN7AIBI2wawmccSJZwHZ8sHRWi3AOddU0oFfRjyl+EysmsNnzZriwoI0gViFHjEZM",
-	"379iHeGpFFQI1nWWMmvaHi71DOhI86CNEO0PJhxkQC8CyupO2QATRKf1ij3YawtiNqRjSkB10AxKhB4m",
-	"dx4OSqZhsYfWvlqX+LIlyzbpMXf3aaHFybPB2WlhprlkQw3ZUHV8YJJO5fhnZnxCm1vKdopv5JdZ6ksE",
-	"qHsvEEujyMCtom/TRzbYd1sZJHGBCM9wYxnpCBDJgjx9xhuIWFBFQ+8Lv8wYZSCkujqwGXgLmxrvwCv4",
-	"SvXXa0w1Nfgak5ylBSWfILxM9ON96ebiFfdNl0GM80F4rsAr3TCMtNeYl3odZqoxmDHApoYFceVaKpkY",
-	"Q3UNv7UM4usc1ljSXIaORSciwYU6BngEhTzBwZq6TKrWG3FW4ma4h7DOOMRbClCnPoP5wqMxwWK/dJoP",
-	"xzPd2DH0DZY7UmkJvvyxs67xJ2PtZyx6OtzNWacmFkcZ6z25xf9CFo3q08l8KohDVVuNujSHijSFS3aw",
-	"h1izTMTvL/aXfMISsG2wpFbTchUdqbSPdbHFGbQpm1V77a0qC684KLMA7TjLToQLQJl7DcVm2pIAbITs",
-	"1VRVusRzdp/S5p/zZTY2ilU7FvDc0IFr0CRpjBwS4LWsqeRvX1wCkoaAbo6ob2LZkEDssI15xSPohMGX",
-	"uL8DNM2GhMSDzDhE1qIdZvJ1fQ4/kD5DgHtBXh8RLeLX3IxUeDaAo46psgBYe8X03D1isIOuEy7NwYZT",
-	"TZvJmRCAUKyCWxBrJFgh+SsoL6JF17q5oqDux16l9cC25wxlxfZ1l5I60svyLATUBJiFnSZ1wTa5yXNa",
-	"BQ7U0HdUNRMS/JfDqwTFpTnkeD3mcMgZjpetNi2/oBC/FqK794o3K/bHOScMgYp05HjREeBIQ92c/1M7",
-	"1vBIcA7GJP/0p+8aU3bHOL1b1vYIr2StLdjX7GARysTwe9t2UF5xxENZR49eYxH7OfCcEeaDX0iVoV+7",
-	"PfASOJLU2j3inkrU05A2HA3GlEAnrPJIUKVDkqifRKdgAULmpq35A3NfiKol+o2H7epVIgSWpSP/ReR8",
-	"DG3KeXxezBoqtjmnouE9eNzw5SgzS3PT1TU6FWRYNj2ZqUCP2LJXzEOCrmMa3CkwDYMuU0cYeii53vHS",
-	"MU0d4VFcGptzOGM054dKbDqv2Ib0S4ipOAAiISqplkkgu6BlNAJ6IBrFxwonJjYdjmrrRe0d26Ub8IrT",
-	"tsacyuWx4O++HLUZU54hRWz/3uWeeT38WzP//1F/g6pchIfmblcPUY+Aij7j/nDJK6CYriPKN9vrOp7R",
-	"306fr+PP/tyAHP345+/5aTd/4oyGj6JmWMYj5DwjSsqp+c4Y6T+0JVwukKZ+8Y9vey0ws4uV61Jw3Obm",
-	"1P92pxS0o7i/foakZ9A3zvjUN2OqfF0hbylx72LThm4dmS4kqeJICBMHAk3yvWxmKYAUWIHQgT4CD49I",
-	"cJjg6Ook/JXAkXjFTsQ0+xZJsFZqn3w3wzPIeMPci4UeaWrJ35sTfbHwGcY37z6s/fZiLv4Jtfzjx/8P",
-	"AAD//yBSh9bZaAEA",
+	"H4sIAAAAAAAC/+y9eXPiuLsw+lVcvKdqzqkLafaErrp1LiEbJGRhyfajb0q2BSjYsmPJENLV3/0tbcYG",
+	"GwxJ9/T05K+ZDrKWR4+effmeMRzbdTDElGS+fs+4wAM2pNDj/wKW5RiAIgc3j67VL+wHExLDQy77JfM1",
+	"U9c8SBzfM6C2+EJrHu1lshnEBriAjjPZDAY2zHyNzJrJZjz44iMPmpmv1PNhNkOMMbQBW4XOXTaeUA/h",
+	"UebHj2zGsBDEdP1msOYAn46LmhicvA812ZZ7GHmO7yJzLTx8jF58qPGhyRuQM225vjjdtedMTeilBIXr",
+	"OVNkQi95L2rE1uBwvBHA6C0FlmAtPDZ5K9EZt9yO6znP0NiAIpoctRYcYpotlyfQmyID1g3D8TchqiYH",
+	"a0CMTt7N8qxbb4qQzW9Yjlq3CznNtssjbMA1S19ha655kPoe1uCUUSINUM3xNDCk0NPoGBGNIhvuaVqP",
+	"/T8ZO75lajrU6BjyXwZYfA5NTZ/zv7oenCLHJxrbKCQ0ONKLD7156Exsb2vPM3Q8G9DM14wJKMyx1TLZ",
+	"mEP6BHoNx4RrQWxCcd8+HTueegfsS81wTJi0RzbgiQ3IrIczG3dsA2StJ00Een8RDbKBGjBNDxKStDIf",
+	"lGLVDZjFT5iIVmKCrXDqhxgMCT10TASXeFVH/MT+aDiYQsz/F7iuhcSAL8+E7ex7Br4C27Ug+18bUmAC",
+	"ypdTG8MmHCIMzQxDYhca0WVI5ut/OPu0EaVs14VsZoKwyTmLTzgPZftkj5f9nP+RjQwvBcP5iKXRhfyP",
+	"b9kMYj+DMtwfmqaRKx8UirmyPgS5Wlkv54p6obBfBsY+zMNMMNnE16GHIYVE7kK8wQCW/+XBYeZr5v98",
+	"WTD+L+JX8mVxuDsPUSggHb3NhgcBhUQDcTx/b+UWf2QzEWzffDevudlslmOvLud7FsQM8c2lyxKc+4lD",
+	"x6joZeNAP8gNwb6eK9fMcg7UoJEDAMBhdVipFPIVhmwOe+hfM3qne+O1vU6nzvdqIg8a9Mn3UOZrZkyp",
+	"S75++SKm33O80Z7h2F8MYFk6MCbiflwHE/gkMTJ4lQ7/p+NCjAS6pIN3DGiuXIFcMbC/ah41OPGAmEpw",
+	"henbCuTFOTpwhAj1dn8aEtpKanIwcSy4BD32GBbwE0P2gGFDBsUvQgpZAPJbagiJtdvqccYBRUg45hwD",
+	"GxlK6PNCh14PI47Pp0wSSw+cdHvn4l3iQzp0zLmmtqNRRxM70YCQGtds9lrIJh9F5ux5Tko7YTrHN9E8",
+	"4vdqmias1uAwp+uVIqNClVwN1mAOQPOgUjWq+cr+MPMtPZmRyyWCRh5Q3Zu2UEpioSK4at1lIiywPobC",
+	"ADkbY4Lz1lg/NdAVap3c5DvNi/5tr4lm6PGu8tx8dtBNr1m4nJhHvW6rtrfHdmhCAxFxFDEPTE8SYg+z",
+	"hiYc8fEae3IC75NRXU79c8kxrByY5Voe5qrF4UGuXAOlnL5v5nN6TYd6tVAxga6vUEwhjWwNpC2pZ10Q",
+	"ijgZbDPYPgZSC1Hua+buqHWYa7fOetueOzVSTKGHhmkYBX/sbeC6CI+OvHnH341RILPnTCCOvplu/61Z",
+	"uESt2h6ct97Muyb7I3q4b81h/9DWiwf+Q7FG+YMqtabm/eFb89nVm/bJRL+zfGPeMoc3e0bRwrp9kjfv",
+	"W1ugyeqx4oBVZyqPRtnOGRmGU2D5jBDzrzVbfE40MAIIJ8APYeo5xE1FlNOgCV0FY4T0PJQ6FUZ6upbZ",
+	"TyJF6cG0svvNj0hAa/HhJgSznBH6IGIjSMXXzLMD93TLGY3I/8flDMOxGV2hgMIo5JoCAZukiTsVo9Gs",
+	"Nifu/W0jgpDN1/ZzO3/ZeyhdHU1mDKS6fUIfu3zwFJyWR53TmsX+Du5O8s1n5/Wyd1xsP7cr7aPmfHiz",
+	"1x1a56+zTqvbhufnJ8WbXnk4c9uwNSxVr68m1Xnr9gmYN4TMKkb6iwlDbc2dNLHQUdklYGhAQoA3Z6jM",
+	"JHRrykSKITShByg0tW73KrAGJV2V438QHiPziWPK0xhhGr2Vk/5b/7XNbsWm7iO7ledDdPn8iB7t41IT",
+	"5zPZjOsQ+iS285QkqUtJ08do4ng4Z1iOb3KRU3wXwoiqbtaGB2ZtK/AvILFJLO9c5xBGFHEgiy/XvoiF",
+	"FY/dxEeLntHZt5dBVwyH8WfAugM882NwhZNbpWQA00ZYCPAOV4zDNsFg0B/67KNg3eXhB6oEhjNhfQHY",
+	"jFhfY+/T9ckYmvVPff2j9HXBLAVYEyROadMEhgFdSrjtkgAbhnQeDZABZn+PTgCx6ToI0yy/W/a7VH1t",
+	"n9CwlQBqM0THGkR0DL0BPuv1rjUdEGQsmxIcLzTNE4GGB0O6VyzGvPgOBWQnYVF8ym1oq1azFx9giug8",
+	"87X4IxsMWNjJFr8zO1nq+xNrJpLDumU5M3kHkFKER5oz1MRHscf34NSZwN9I5suKaTi6K6brwaEHyVhw",
+	"4vS4HjlbWomQfZRC3yDAtjryab4DeukOEl5szTnqWrfevtAUxdCGnmMzRohMyFFtPSuMemiEkfTdBppN",
+	"/qKh42mmw9CUUH843FvY1O15Tg7OycFJtp08ADo4yJu56j4c5sr7tVpOr5q1XKU2LO3DogGLwNzGthMF",
+	"xA7Wr6VDxkKbI9vfZruQ+vvuD5UJvbczMG9W4bzlmWcTMcec/f1ybqJmtWnV6WWv+cq+h1x2OUFGvjLu",
+	"Fw7nD6WHSue2Re7sE+/q7PbIKN7me8WTIui1ynq3QMH9yfXd8+30xj657BRdauQrDR3ly+D4oHzTrx3p",
+	"p53i1W27ZB5Zc7N3eKwfjYH+dnJs9MavV8ftyl3fzd+dtoYg/4AuGi1+lpu7fum2WzgyJpQ8lDqtq/uH",
+	"t3a+Q3p3J6Sbfzx8nNQejEbhBt7W3h7zD5XeswlAvnJ5M+kcdSa353r+xOvMCyc9PO4Zb81i+7hiQ3tU",
+	"7uIW7uLDjt4/Obk7G08f865zd+YWH+4e2zfdVu2i0fLA3Q0X1R7PxiWjWDvvW4/HN/Zr78F+nXbtGjtH",
+	"qzdpzczTVk8vFu771uGjMalcwLvLk5vbWofB0DyzZsGd4Pzenu91bP31rPik44OLtgX2HmZ5UHoh9Kxd",
+	"P8evYDZpPmB6ZkyvGs/g9flteltoWfZDO1ds9PRGARVvaZ1cNs+dK+ukVameFS/zB277oXblPhYNf9I4",
+	"uy4c3ryS8zYxyoXbmdV8fJg+n3hvd81jeOSc1IonttvonN69UX9mjA/vzP3r45sHdwhbJ63iIRwB43QM",
+	"b16Gnfv7UqVzeTTPPV4ZZfNu4k9PvNuDZtevH+T2nwy4fwaKla7X8bsd4PWG7afDi3rBP6o/Xdfqd89j",
+	"Mj89vzovnkx8cNTP39v31sXd0VvVPDfP57VOi3aecL9vEOuZgqbdun++vLyu262XQh63KvnC8flTs9qu",
+	"HZZ6nb73AqyrQ7s8Ifu5qX3yNDKOCwRcTYt1Ax3XrouH7YlRLVUm4KjUqJxZ87terdKdmNXG08nMdZ9v",
+	"+tOH/kN+vn/8Urx08e1wcl/2u9f2wbB/VNa97vPpHT5rXx4fvJXbxadrq10+7z7WEbzo2O3680Pl9e7g",
+	"/uHJb9x7FaznDrp2/ek6Zz03bq+ur+v3R/fHr6D42n3V662p9/ByB/3TYnNanzTyQK+6zrP10rcnnbvp",
+	"1X2F4vsbMK1Mr4ovV/VR46E/7jbv7t/yuYeDsfHW6XdHR735jV2pzfv7ry+3Lw00nzXGo3vrqlQ8n43H",
+	"2BtevF5aXvuwXLm/st7GreuCUTpqjPYf7/b1q6eb/Xr+4PR56t2/9uz9Uf/Iyz0T86427nXRZevGf3p6",
+	"67ZPrm9vL3sv+K3QPjppQp+g6mkL1W4b+fqT498Tc2xcnuPqM2we3dZM3H5tGM/6Ta/yQhrHL06ubzRO",
+	"p2f5p1kZNMauZbZHB2en17DffRyDw+5FYY7JUzPfqNXrRyewZtr3l9VZ4+zQP2g15rle+cSB9x3rtnt+",
+	"658WT1vogAzf6icn4yo6H9/cv57ZlfPL+hNyvMPW7fFV975kXlTPr/r3Q5McDntvoxJoO8dzt6i3apcA",
+	"GPTUPpm3Hts1WG2/dg/6r6PL6vkZ3D81fSN/eXoyP/T8UsNqvxQP34zx1av+dnTz5KDKg9P1Xy/c0alV",
+	"ekWt4SVuWC8nvZf7dmu/4ncn+aeryfloap9BULs57QBAXiv39YuuC9wnY9J4nF4+PJ8+OY/jcr6cO+89",
+	"u6CIWqPjS+MN9nvFk/LzS6XmNRr1/snj7XDul17oYR22bFi+HY2x3puCZq+luyfwsD/vjh7ODf/0Zs+f",
+	"3rSfkdVHBy3DnJ/C0oUO6CgjiP6TMKhCL/M183h3k2+ftp4fTx/ml73x5PHoYd4u3swu327mV72H/OVp",
+	"O/949/jcfutXHp87dvto8vb4fDu5PGpNLp9vx5fP9dfHo4e3x97t5OHtId+2L58fb5wMM8YCTJXCEpH5",
+	"VRBAghokuRrXgZY9bqn5d5i1ppf6+PAs90L4FuUStActOAWYanIo01K4pYRJIYFZmnAhZuh7TDnRTEgB",
+	"suJlbd81fxNHndjJWkedGHIVUrg/es9hZX77rePNxgAx9NPXuAQVFuazu3S/uwS+sHEBg6Ipt2L4Oock",
+	"s4WP8Z7pwIUlPD2A2Il2ENPZZ4kQQnjofJAf1mBmrSelHA8dJ7PVyUI72WTBVcP3MiK+SOiBIrbICDTI",
+	"7e47/Mr4T9JoI+we4TBQbuR0oReEFklnPwcxMDPqQXL3sgUpzHxbBAiZehGWC+VcATAMqhzUcnqtVMoB",
+	"M18tlMyiuX8wzCxiI/nasTtBeOgBQj3foL4Hk3YUWvigWoUgX8kVq5VKrlzQjdxBoVTJmbWaXi1Cs6zD",
+	"amYL+wwwrHjV3EKEMluMQAfGZ6jnMEbB4otCgV+73FGYTPETIgf3EIdHMV8s5/KVXKnQK5S/Fgpf8/nH",
+	"jDw7hOXKsFTWc7XqQSVXzpsHuYP9YjlXLBmlQrFSNEC5msmuxpItRcyyqcxyNZ83qzAHa9VKrqyXyzlw",
+	"kD/IHZSHenEIStX9fDGzCG3dIiaM2ywIcjDCoy4F1CeZr4s//u2RbTCvV0HFLObgsKbnysNqIXdgHpRz",
+	"+VJJPygAszTcL310ZFuHvaZ4N3A4kC2CWGQXzPrPJ2r9o1Hr2/a4RTZQr8VAgWBRd0IsilH4Sr+MqW1l",
+	"vn6PnZv5iJkQbQuPr5BipL+X2WEZ/gljJpPKBdteZFIsPEi7Ec+w+SwPD4wDUDZzRb2q58p6CeZqwCzk",
+	"qkNQ1gugrJtlZqEOPnlChPjQfAI087WwX6pUD2rVfD6bGG8YcUxkvmZui+Ox2Tic6PPDil7qj8zTsavb",
+	"ZATvaoVWqWU1TztTcFfB17Plj5/gq4s8SPjS+XdFMmYz4VDDpyV55Z3G/MjU8gRRnU9Zp1d2OC1+EV9D",
+	"70vKixGuAyUYPLF5nmxIx46Z+boEP+4/ymwZxRlCtrh30uNKo9gyNKUb6i+iocVnHHNjg792wV0x0dO7",
+	"zbn8qhRGIZz5yrEYYQo9HkFYySbEfWUz4QitpJtdjW1YXLE4QsxET+wWuJi404z/G2z4/905TC1yQ2ui",
+	"1JhhIMiBEPYAEB+lxy0jIRzYkWQuE0tFF/kGoqQyy52iDhM4h8jjfx9gx6eGY0NG0dm4uK3yTYbDzzqQ",
+	"GUZ2lSOkupj5mtk3KnoB1Eq50rBk5MpmEeZqeqmWK5gVmDcOzAqoDjPZjA2oMYamYLYWQHZYzdh6NhYR",
+	"F0RlkAzjjDa0dehJpe/bLgF5EiJJlCAE5EggHscPOIXeXP7dg0PoQWyEc3+Uo05dwi9VDEChXBxWy4Xc",
+	"/tCs5spAr+XAwX4lVyqXjX2oA9OolELJkDkKgf0+0S21HOY5FlR2ljSzf1tNaOMfgzysGbUSyJVAeT9X",
+	"hqCQqxlgmCsW9yv75eIBOCjk2ccitWeL9X5sF9uZKMtL3ECYI0TU1qWQ4hcL9Z9o8R602I7EbJDDxRhO",
+	"SWLRg/GOY89zvB0ZzAhi6CFDO+u1LzTIJtJcMIJ87kjM7G50SRoAZZbcDg5s+OpmvhZKhULxoFDbZ7IK",
+	"oOoPefEHQlIJDkmR/Nw4mRiku4hRYSY9aG7pnWiGQbgpLg7oLBKTXbO0HfEp+FU8zyZktxuYwLlUZL0p",
+	"syHnKsUCVybZJRTM1xlxWp3bo0Orq1tOy5nRWvPy0KV617HvOtcP3uX53DiuP92wb1gQU+a4keHPgmek",
+	"Mqi+MkQ6vavr/vkhxvmXe/J8gEzzbvz4XMk99trlk7JZ8VrwXNetq9NbI1fBrct+h1zr+5Nce3z84tVu",
+	"6qjyfI7NfWtiT876RRsDa0Zurs8z2Qxbs16HbsO66x60nYuLxttL+6aoW6Xz2dvJPuw+XIyNrkcmB5MH",
+	"vwMuL8sVG9/6N+SsXLq5al4cH1bu78HZeN7tdka3DWC3Z493/VndmxYm2xj8GGzvoH4O511I4x9tq3t1",
+	"qc2grk3gXCOQ7omAORYzx/7J3jOjmabm+rqFDDaMhXABqgEPLkkHbK4BZpNxLCBsLhj6UDMAZnnFPhHW",
+	"bi5Vz+Vs4httBohG0CjINUZkgCU15FilooZ3ohyMTChhkwk7gWg6BkTTIcRM3x9Bk4lHfDUhuDc4Dehy",
+	"3Ww3jFb50mIOcsy0mbngaJVcfj+XL/QKxa/5vORogQ5eurJv8MXxdFY2ToYufnHfTi906xydVY6M0WGl",
+	"43re/eTBOdY7/fSvfPVQCQa7aKEHsakQWBbR1b+t+DdynJEFc4rC/iKGH1S++JopFAr5aq1UOqhWyznX",
+	"MfLGQcEckaFvenlP993nvI9979mY0kIR7gHXJXtizwwtJTAlWefmHC/EN2QIl/piS8fU8h0mmm1XQtVX",
+	"EOC3FvX+hSjwbTcc2CDWLeGBsLEKsaTBSOrI97a1FqXc5eoasV5GF+LmkWaExwlWw20ePnVyJiKGw5Vb",
+	"ZxhEeBNuIiG+6zoeheYAA2vkeIiObfHLEALmrpPnjcQc/L5qr2HDnOF47pZoazo2QFhOsCcnCJ+5J6RK",
+	"OS4brmvzNaNXoVEsF8wcLOnlXBkMjRzYByBXyeeHhl6t5M0DuA2FisA6mT4t6xfhP/zeWujvfEvfdrmm",
+	"TSQkPHRP09oOoVwAC6rOOKxYDYFQczDMskfJkmu4XYwJj0wU5AUxojNpjjBEuBagTDXZC3njf+NHGorW",
+	"+TVMKRwWA4eVfM0wCzljH1ZzZabQAgD3cyBfKFTLplHNm8YOgUDJJiM5IHw3v/Xj/Efczrctr2fD+1Sj",
+	"xCXFpavt8piivpugtpB0yPge/oogHX7loWHkKxczvobGfNVnRrl1njvuNvKzA2AYhVoB5h4KF71GMb3S",
+	"E3OaeFjIlbV+p8kFB5/IDK/kHDEOLpWrtQuEQslaUd+9CYfAtyh3cq0kU2nSma4xUw/R4N5oTzsPPO0s",
+	"XNR2fQqzGqQGr5uBiGuB+aXA78YiHmDoQZgQKhDKFIvEDXBzCtvj1ulhSRTixocegiI9TIwUcluUwQOL",
+	"Ff8TEF9kQ+0IdlENjYN9XbBfNkMD4lKJEpfCY4ZFUCT+XnpU9rgzQMZsyLP9vG9VvO4sP7/Vn6bWXf1h",
+	"cv70cDibcMcPAzHNfB0Ci8A161Yet8s0WxM/0Vn8rPFybTK8mL8CaIrScBzcjgV/HcVeCiOVnF2LZi7v",
+	"7UbalZyQkx63NHQ6PanlcIqnLdKgxkdwopLkQWFJdaoqUwqQs+EKxP/Pa4L5i+ffFffyQQpYULVSfSqW",
+	"jk21+20j/2JS8X49ux4W8/l8vryfK9SYY6BYhblaSa/lasM8qOhDkIemKapyyUlV5G1MQZffLrFeBl8s",
+	"zJWlXKHcK1S/FoS5ctfkRYFaSQ8lmoqp0CcGQz9x88Nx8+fcd7JqsHTbJOaWf+sQ0T/1nr/tdtEblIyE",
+	"2+bFb3e95pC5dR8U9KJRMnNlWBnmqqwKxoFRM3N5WBgWQUkvGxUzk41BhkoMMhi+5/EtCGcwx42yfgDK",
+	"RgXmDvR9kCub+WGuBsowlx9W9aJZMgqgBpWtNmb2ymJ2CxBajxSJWL8bxbADqzZzFfEioc1rZmiuFffy",
+	"e8W9QmabmxNw33RlYpS4KjTCvvseB76JgOWMeDWwV9cCCDNfI59VuPPFMnNCoX316Wj41zkaxM0r8dSM",
+	"dzzIRMrdAjw+KIj4s9DAZ6GBz0IDn4UGPgsN/HMKDYSN0SWRSWDGsgJRQFGo3WPzpOY83F86jPaYp62z",
+	"S+vkDE4qd4/HlaHx/Fh9yB+/dayT+c2bZV3at9d6372+LFle9/mE9E4OXy/7rXyH84uTAqvGeDdvVh56",
+	"xuvVXf/1sVsYP/RGhYteZ9x+PqYPvea83c2/tZ871uXbqPR49zi5fBuh+y7jQYUxuJuxDb7oxbF/YXem",
+	"j/1DS787cfVG5Vkv5hmtt+BZHV09HxeveseFy7d2+fLtmDRta2w2mtV276HS7t2UL99uSu3uDIH7yzd2",
+	"LnDWyRtn7erFvOaZdy3LsCuWeXr7dmHfvj0Ux5ZhXxK9dDu5sC+nOjsLPnQfSp2CYffZfhzzrDMz3pzp",
+	"RcksmfMKNuyT4sN9Z2wgvq/pw/3j2Dw9mV+8je1Lu1+5fG6WLk/b84e7ln35fFx66LUrV0emdfnWsa7u",
+	"+qXLnslNGEbpFvH92TVHR5WJXrytSziI2rY4v1d/eO069dnEPx8eum7FKRDXrs9f3saTbme/OtafTwpX",
+	"jXNYRhfd6mHjujbvPj7A29zksGHmackwq7ev+lXl5Pamdd2hB5P8y8GBZxQLrXpvfnsw6RqX2MsVnk/s",
+	"esu/v6qOQL5YOO91bvBp9eDo4O3xsnYxs9vdzrh0dn1Cr17KFw3DvjnuFoEJW3PinNZqB7ZN/d7MLQ/r",
+	"3gxkskt1w365GNIzzg7zneN86bHYuTWOW7eXRafYKXVwb1KZd44Lk7Zdcx/PnMLl3eVbGxU849jtgPxr",
+	"r9NvHXZ7jz3Tuql0rU4VHpn37fxk3u/Xjs1J5Ug/O2mbp+OryzOz1D0eg/7R7fFt4eQY2PmFGNKveTf5",
+	"ysSY3N51Ci10+3ZSuToxzzvP41m/dNgG9uXLw3OrfHl3/PbQH99cHVvl+7fHw/vS5Vu/WMhfHd++PVid",
+	"tn500jOeOw/dPBtXnt8WXQxuH4qdU/e2e2q2HvIF5w63Kv15wb9shMWQ1lun8FAG+eb8YdIZ3r7Vy4+3",
+	"rabx3LrvFDvX7dPx661due/36Qk47vRu72oF8/6h1DmueGExxLyruKBYm+uo8Kyf1gqPjcrUsI2pgW88",
+	"gM08F1GumvsH9wdGfjzvGt7T0f5e9XREL8pdo+UdWGXn1dnvT8Ekd37vXFLaP7p5tR9xc2K0jg5uXPAE",
+	"W1ezavf57qzU6NaercljpzEqmfv9wj7N6XkyzRUKd759Z/Wn+50Tsl/Wj8HEq/VhMde9NUf+EahfnB2b",
+	"tVFjenH9cls9tG8uSl3PObkb3fr7bYjy/TxyPFg9zsHz3JNO9+3Tfj5/eX/am46u25OH08fJzLs/gEbr",
+	"YA6eL3IFmstdFuajXue0BI/6ZTy5PG4dn5QL9OWwNm48EPJU79sN3CT5zglwb/3c/vh89FztvZlXuFqf",
+	"XT97PpjPplbz9e35xG0374A+cvr167cX8NS98qzTHNjv1gptvzR+6+zrFevkutg7OO2UnY4zJv1Lr/NI",
+	"a83Ro19vnRq37f2ynafl0uO01T0/6lTy0N7PvbW8SqX8Ylrg/uDFL47pK33oH1pHueu311mZzHx7liuV",
+	"Ku3WGyD316eNY693NCzDt+79YUNvkkrzrGzonafrN3r4ok9ue4/Fh2t/vm9cdZrnN+jtwLLaj40Z8kgR",
+	"mPtnZ1PfujgZta1Kt1+1ptW3McrdPPT0vNmbGgdHxvnZ+NR6nh/d0MbD/PX4JHfq90u39+jo7ACfnrUs",
+	"u3hb6TyDjt1zbybPdfxUPKz1rYPDg9msW+hcXTXM3q1rGGYXFE7yZfTWrMCH3lWhWSavFOizmpc7zhcP",
+	"5lXz9ora3WvXGILng4Pjw9rTg3ldggd33sjsv+WfWtfHjjm/63dsXGlip3Fada4epr4zvEXd+1b5/oo+",
+	"t4/3p+MRLs9vhlcW1HtYv7Vuq28P1VtLLx5e4/3b+9teoz59a1J7OLUeTkrGqJzzJ4XCJHfR63Zv8rZp",
+	"WdXqCM+6Zy/PlzdNe4InM/e20bNt34XW82lev7nr00KrSMpXl1N8ga9PDjwLY+/q7rAxneF2qWReFcfz",
+	"2ozmoeme55rtknXavUYldF8oH/fKjnuC0aN+8aj3kNuYXT++TbvwdGy14f19721UefEvby59d0ab5sno",
+	"wW4BA5fyBdhxOntXXfelvt80/Ul9P3d2QdvlRqd/sxwxfwiBB70tg+ZjNdpo/U8e2exz3XPoWzy0TLRV",
+	"YmFmyrNMxFg+pwifVpUyBtgGc81xhRfUmmsIG5Zv8mozvMBGUElRVg8fisBqnkDAFw98elyBjiQbPBmO",
+	"B59YtNCTOxk9OS7EwOVZjrbMunUpNMPqdlxyKZ9+EUutPuNHnSHLYrHfQ98aIstifyVzbIw9Bzs+seZ7",
+	"A/zg+Bo7o+tYlnRCqhY42NRsByPqeBqiRBMmTO4flomYyjewxal0oGoA7+g9ZqYqXtVjCixkPsnzZ7Li",
+	"l6cohBR0dFbyRX6yRa2V9McS24p19IZ2MASI3YGYX+O74QfNqjK1ar+mA4mGHcqLggCEWXikFYzgdWuG",
+	"CFom2Rb8LE7TQsY7ga9mSYB6qI1SEMPB6/8ym50GLA8Cc67BV0Qo+dW3IfelTiAcwhrADh2zzFif+PyZ",
+	"8/5sNgSYh0bMtTGYwug5toX80PF0ZJq7ms8U6INpEmAvcn49yMkSsIhmOhyRggMECOR6aIosOILk73kR",
+	"jDiaECORcxKhxFkJfzBnxMsAPlGJKTA6cIAF2ZUnVNklwRkFGZYRLfXrZvDQOJjYK8N/LWAzwIuC4wvo",
+	"BHGOynEfCXjcAiI8eR4Dq8uLfKwz5afEBVEtREI6Hh0kxaGO5E8ib/qX3ncdaz6Gry40GFfiwzTH4D4e",
+	"M3rRIDKSegATngMjvgHYFNyYs3Nmoca8YRv15ntacyhmQvxC2XUZgMCs5loQEIYQruNRDVENcL7PLe3b",
+	"3h926InjY/N9l4Yd+jRk0yTcWDQOKCCkAUfgZPPX3mAfA92CDImGCJuhLnnbQtDHKpQPvhOK0psg6EcS",
+	"G4pKguIp/GLcj9uCokHh3E0uvwkrmRQUyS9O8fpb6jPt5HP/ObUFwy567iI20FTCrJLLF3KFYq+Q/1qu",
+	"fS2UHresRJgcgxFULlqU9dvJt+abaTObPzZNOjEfeut6hulTnjnrDgqarCRA++SXe6w/n85WT+fbNrix",
+	"wWvNh+yF3qMqbRnXaiC+4KIQEkXWmQs9G6moCw5mF3pUNuMdWY4OrBTlHo+DMpRLeVEpvu2yzZiRGYIs",
+	"gq2/vuCiwo+gtbOjqyq1oV3GN2WIA1QIOrKiUSCesAVWISYwPt5sIT/kQ1Z6T0eLdG489tVisGqjLMq3",
+	"/icj5w9N9209OMjWmMPOjSi0yRaokVlcCvA8MJebCA4S22l+afXgTGwDEPt2qgKrMbCOQjD98RdA3QYE",
+	"izPGw2D5BXz9nqTEc/CbQULI+tcLwxNu84CRuQGFm0dC9xDKJucxkHGkvdie6mHkRFx0DRZLwMy4R73m",
+	"kryU4El7XzEUaeXWooVIY3E3GBLeY8w9hXnwB4nnkoCKgyjsYTtdtGBesMt0RTG7bPTydQZbl7PF3md0",
+	"imTaG9OJPAZaoR83Z6SICevBJxdSk0yH4YBqzozbw1iZkkh79BWKIlKa1s7Jhmw16xKw+RJZ8YTCUFgP",
+	"dFEU/HdDULXa34qP6yl/FAnT0Y6l8sQxdMOn47Ysxrm8dleVBlju6Caqd5II04vU8XQd7g+IK+65/Nfn",
+	"GeX3i6aAwqcJnMu/UIuo0qRs9XiuuaZv3sppAGKlKINe96vZjJHeeQv/ExespNdKlHMdYJYjKcoASSun",
+	"jy3GlkE0i5K3+lrbp2+AEVGdK5jZYYW4GN4TL5MYy4SViUrqV8y0SMiiNpEmvmSzYt+ymAVJBXSvgDJU",
+	"7iuOZoifpattiESN/pVJZKLl6hRnzozZruTvXGsUBYZdz7FdmmqHS01fv8ckWotaQ9ZcGBfNoP9yqvn5",
+	"hhInF9tlv4p8U64CCydEirlt8PoERjFUrw1eNTAKan7yVVLNKPtYfo/rOMB/SzWLAH/CNFvcTbRzzPcN",
+	"FXhlkWMNmKYHCYlFpUiu9Pe1ycrCmSx9GOu7YqY7S6S350aOzgfzwhM/gqp132OMkIvd8EGp9iKNAMvT",
+	"iRJW3BsMo3WMU8zpoyfGFKw4knIB8Mjn+OgGjGbDhEsMb0FH4lidrBmbzGj4gAhjYYa1bEaV/+P/Vf2S",
+	"TGlMy2aGwEbW/ElqmiM0hVj9AwGaERa1bEacW9mBshkXqYYUzIz2LZEshitMr76VSLEwFDaYEU3amRhq",
+	"dk4a2n6lVuCsRP6juErx2cswxgBjaKkm1bFv4G4MPe4QIBCbGvsqJz9TbaJlLbrMztS+ebT+43Ch9Zjt",
+	"4XD3WBGHoWhAlpVQJdBwsBlOvoauY4xDayJM4UiEpESKt6/Sg7FvA/bMgcn9JGxYUIdTfLruKKrs3BpY",
+	"iCGqrS4iomBLQHocLE/nSe8pYiXGgUWcAZ55iFIGDUcDoToFckpF+kPeebLVtiNF5xOvQS4mx64Bf1Z7",
+	"g54jHe8YTqEXfyGsyiVbD5gmEqE51yE8FqQiCZp/kZXyi1nN9qlw/MNXVocBTWXoAluIPYG9TAxBUT+u",
+	"eR9DSI2xRtctziW1WEgn9YqPoZ39TjN8cdyjKvCD3/yQQk++zIj4vrLksoS+YdmO/JkRHLaFbSdPbDKw",
+	"+sj4D0ERSxtgxik2oGpiq4HE+0o7L+c5T4LdP0X72Ul2T2JX4R3hlvtas2In6ySHMBnVHceCAGd+rG9t",
+	"sLnlt9S7klnompe+7uqSob6MTWtPEM/A2VztxGbCUWaotN8ETvj3Mr8PZiWf9PD3pIdZDVCNhaBQzcFQ",
+	"vHYdLnTuRZ/7yMMfYKViBPSBfS6Yta3BV2BQa77tzv9YmhW9lFjK4ZiwMQaWBfEIbrY4seGaocbHWZy6",
+	"xQpz6fI86ljZXbYLcZlTFlibzEOya4jBtDnRA92OMcfIyWLNl44LXnyoopCFpWSu4uFiG4+oFiXxxhRo",
+	"cFfyeoRgn2tiW9AMrRRRocTP3AOF5zGwWrrM4JShTcRdaVw/lhiCGnv0wLq2yhoOqsWDVdBHuurECeqm",
+	"ukAThiHuE+4JWgRXi/tZlDCLAX04Vy7OlGU5S7cabTXDLIM8spjzjYWoHS9ILxr6pFlKloucAcTIGJ1B",
+	"iPnRyMZ1Qr2CYvUcBTd+EMg2RRjpZH8KdwFiRDUWaKs9hxJYkFpDHmXkcH4gcHQJg4UuzYvloTRrhtoT",
+	"xZ1x+RyL2Ojg9lR2gbl5+aVXE0bQMLRjILNu4xHsC2FHyge4iczJmgKxbzL8JqKPb429oLHWVpBkD9OI",
+	"Cxg2Qxd4gNH7oAaCiARZtFH6aBOUavGUihtEECaeJWx4VgvEksZvYSyIovn68yxWiDsPb17Vm7twHTfl",
+	"g4IIkYVpLaYJuIReKH59JWczm1C7kq+SY6t8XXoMm4bzeXPwlQnxo/gIiUUM1ff1DVi05lFUCY7v4ZOA",
+	"sMsyW7i11JE37/jxcSHK08AQFzK3C7PMRhpMEQ2MAMIk5nUhs5ekcntgtpgbEClAx/Wk2hzoIBaJx6Hl",
+	"/lnxyLy2eVaob/lS0JbqCxY35drLCNqNbVNk5TR0GB4qoXqKbRSjZpwfsSh4TXyjbHPBwZal6SUYq5MG",
+	"ay6OkA7sKbBbgVzeRXpfcMwlJ+F7QgiJ3MFsDIV+/Esc8+HgvfeHj/AT7OypX3ydBJxVkLhhtNx4SQL3",
+	"VVRI0EBrm49iWmht83nQRCv9RzH8is0Qt5XFmRLhmxQfEsY+EWLHw/hFdJ35WweJfADWbaYMW1KCpICQ",
+	"RSOxlKKk1HfDzbPeK0lqHRVXgYY848YnjOSd9XrXGo8iYRdv9y66S4Ep20Q6JPl8GlF/z8/cSYKhvbec",
+	"mEKdEHjjpZYgjzsxgIH9nbuWHKEFs6AWxt7Yl1nhzRpEKoQNMszNFbQH2V4Op4nyRgRZuFgSL8D2QhsN",
+	"QySrDTJDaA4yXPCQTamh0MEJ+5EA+ZukQAOs6rhxXX2QIVMjOoJowKCcr7JOXWNgDUOx7QuRecidzoS/",
+	"16kRK6gueo+tHihsaN3TulDlg1pwygT01t15VzMdw7chpoHSoQ19jwsqJqQAWeuE28j8cfbhlT9EO6Wt",
+	"nTDUJY0b9fnNoIj5BmANvooUS63kmZoLPDpncQrYBJ5JBlhVeYd7WsPBTAiJQiDV4aMURTTN+56O9IUu",
+	"Z4X2xYGHB+RsIoQXbFAQkhIKnYhuVMQwJGqJfxHR5DAcFpMmgEdWxoybllWZNBBd5M0qDXDXwBM2Kf9J",
+	"E0M5ebDQSmpwjEFhPa0Q632LvwHe9279FfCApc51DmFEkTAo8O8SlPa08Q/ZRYQfEqpAoIxxeopEQmgk",
+	"gO/dgWwhbXI1pE2Sb7HDbOCMVebmkHVNlC5Nh0UJXp01XiQ1bNmXkw08LKGIr8DMtvCf7YqBV8LIboQj",
+	"oFxAeHY6iymT8GEnUigQbFVaLjdzsRUkjOkcGHdv8X0DVzWC2J6IsaEbaqycjIhyItjhdmHoabq0NEes",
+	"lUwgzvEWCnFwXRfrIqr+rh5gw+MVU35LBFycah4XC+nyzPAA+3kwmAhiFcEzCSpWQlQZO5EL6FjhhJhO",
+	"dh5Qi2Q1LGNo2a/Cdr+wSupzlrKBHMa6eNjnIONBYNnS377HGxoMMrEyWQqzh+gAxZYEpike8mwRN8V2",
+	"K8wHJCvjj23u5eFxxXwgdjBrHB+yUvxFFgYn/nG8rV50Xo+1kXNw80cMX3xgLTbDKzcsWUcQXd4xb6DK",
+	"FJPNeCPuTe1mAbHtECmGG5xGDW/C+inNJ3zrZIxcJr8LY2wU4bjXQwTi8kBw7vm15gPM0opYkaFI1wqV",
+	"4hMq0ptSC1vzNGLUspiulXEU6KoeKRn8j7bVRI+8s/ocM80a2h02qMbJDEkPeilA3lBFhXhbt7EzE8Js",
+	"qK8be0wRRHJmOIxHA8wzc+aOLwgElp8NHW9vgJPjWrppYxl/7eZGyy/2HQZdVaQ77oiiIhgfkGXiLjJ4",
+	"DM1MyS0u9EQ/HV/kI8i2mB60AMuzjs8ZVH/YZtciInzZCC92nl0g02aM3awaBwXQwsirNNagYL6NDM8h",
+	"zpDyCGk69vWM6LcTq8VG95CcsxVLcP5h5rmfQmjWGuxWK7vvxDSSbHgO1h3gmRuVJjFss+bKH2+0tMvq",
+	"2abQ0x0CNaGCWZLfhkalEvvFUgmxeXhpcjYsG/dcw7Qr7b4j9G7bbUcWTM65j6yhsodWJhONtNblF7MB",
+	"jJpJv67U+dZ6Pdk3yUsmHDDAqARtTNTRWnQs4MPSavtxUIsgQOwji+mJ/PV7io7Iq1gddX/DxFoM/OLE",
+	"g1WDRNasMjaQGOPvpsgVIWo+cYc4pNB7CgytyY5K8Q3rByv0a5X+h7A5RSYPFo0P+ZOLrVligVtkKRcn",
+	"LWUKypoto47hmPApiOSToYYpNxMfBRg1SqfbXUz0YcxeZdjCR2JGfKgNf7hp8MR0HfeJ9YhBePQErJHM",
+	"Bk15l+I7LdTYe3G9TOQ7unau2et1hlvmDUBsPkkL0wb4cIEsCh2gBFEVm8eb4ijDleOnAgyHIHd5bI3Y",
+	"/FMSBHWvBgSm9KKpwJs4L5qy8f20q1NKa3rZQU5ZVzOu9/7tiPlBZd+wA2vRZ3HzvcbvIBymvPV9x2ev",
+	"B/ef7LpMBdZwVHQMQNfpKoJNNfkQ7b8RIaKk7P8kJhgk59fGXgZ8dR32xFxft5AR4BTzmIjT89tZFMFN",
+	"cz/rgtfTIcy6mPUI+DdtJZLlkmppc46BjQxFf8ITbLmyOO9W7Ft+FDBwabZm5TC11l1PE0JOPAcPpUN/",
+	"yKJTBDSwFMq7tNw7MhWAZUkQk5SpCiwh1ERCihng4FtWapQyi7fcEE++SNqyTNu2HXN7lqC+1vjXqd++",
+	"+qztmDA+4yOUS777pvjX4kmvCH+KiiV73rY6SRJDWzRT3o0rTJ0JDLiCMGbzkNIQE9v86Fa28DPZwmK5",
+	"j+YJIsZ52+1KwG5Z8IwPj9tENA1oG9mWBF5HFbCr6viHoMkl94DHpLrf5MSkj77aHSW+9bcat/2fJv1J",
+	"BsaIubdaEmij+K5Kfm66f2Qai9tXtz6CS2UOeF1QgCMy318kMElslUURWEgTdLAVxI07TEhYiqdcWxGT",
+	"RKl4W2F1k36ZoFYlCTppZbG07DyGMMWYDtYwtTVMOO3rTtLr0mpUKcwNa20v60W7zXJYrMkqZOVKdNyF",
+	"B/1ir533Hh9d2OO3s+F8eZJNAFoFi+mw0yVEQfGoKjFCRfQvzxbQqLFDaFJBVOE1uVqqJhsTtUEglbXw",
+	"Vb0skQl9iuiZr0fvWvEk+ZuofmBGNzjAV8xVJ33X6gxxszGJmfuuVVozIhrkteOJsrBoznCA5bcUApsI",
+	"DUTWBGRzez4d8311DuuNPU1rR9eNLugT6S0fYKZUIEoiAM9qxBFyQ8jzvuYAUr41xtCYQDM7wHxuIoIL",
+	"55ojlBrHp5B7HkX1+fj4C+74aviEOjYLSN/yrgz5ITO0qDsSUybdUW8Z2AE/zKoMUc0ZZrVTMYsI2o4F",
+	"/gAH0I87WeDJ60EMMN36aJR/Fj5YW82oHWPqgfeeUExCoOF7zC25+0nD6/fSuGCXx4dCJJM8980jhY+L",
+	"MEmHHSXL/yhKyvIwVwo9W0W6+kSilvLF7Q1wc6gNgSU+RLIOHxGRS7qPLCY1BWtkeUCh54tVREmEKJEb",
+	"YBlWaGgOjvfaqMm6hrMZNtHBy3R6BXKbCHW8W/qI/0tX6OHTcVgKx4wXx5JgTaubY8dYEtwHWPX/ATQU",
+	"LBUUxLDmMnCKUR4W7IpYtOtKoI9WZ220fFZtkWEo0P5iMkQOYfbdX9LtuadpR4JFrCgPS1sIaKygeHQM",
+	"kRcN3ZVBkAgHbVDYoQVFWfXWkyyfnXVWcYkI7pLMSiDFygeqCpV6iUsbFuchA0x8Y8xeHbKjkFFxUeGn",
+	"F+SEsmtgkqpgqN82PMrkwIBVgeafFhXw0aLN+oiA8MD04QDLomWMridLLyfl9Mmf/9GRYqq69K63FP4+",
+	"GUSJma3pEq7YuISEUbJuV4n5cJGb+4c9rg+6sbVPSo1J/ZrCLyX+IUW5bRLrG4tqt5bjTHw3IllkxWWJ",
+	"NhY8ej8sG4wghh4ygtEy5ljIi18CGU1OEn5CbKpl+WE5MjUSFMY3sBSBEUvshclgY42XBON+Yo2XWqFY",
+	"XUXQ1HVXwuVfEdmi2MrasrK9pZmpw90lKn5819yW8JqR6h5xiP3iOxQk0WqWe2hBjY9ZhZ7KrYpNagEa",
+	"sB0fy3KT3CQfFLefgTkvH+MTGA81Ew5BYlEA+aMMHJfCXbDFuMlShWMxcQ7goBr8bAzoYmbNQjaiZF0R",
+	"6svEICxe0Y3DQFag0cPFOajDrnaR+bcy/9CDCRMnQViZzdmX8TBJLtbPfglXw4/d0osPuIQYf/E2eEW2",
+	"bydtL+mh8NaG5g4nla0EubmYEzDgU4cYwIJm/Fo+2Wkd6YiImzO+UUEAJrmkvMts6OWEDh7FpCjeLp7E",
+	"2je8qU8IH5iePwXzxnEn/mOirLCecvxu6Lfh+hJhzo//M4Au4JoEdZJEr1eXjMJd/D31tcdWW5BzJMKE",
+	"JOLEB+5uAfgttrfaDWUT5mq6TzUKJsw1hjDTjkVWdxDXEe1e8qvY4zufz68jtfHvKpb6pbuwD35rqwus",
+	"C6poO+baolerMR1K/H3xoTfn5B+MbIhprOQbiYhIswybIbKMrH6limYpD5Z0UmmRf4R+VAUkY0aH/4od",
+	"DBM2rlybx1MYXwxiMWRP045RELTD51ad5WTDQdnohAVwmDwFEMg3qTo/Ix69o83GyBhLYxfzVjBPwBiQ",
+	"sYZkABrTEFafZdDWcGWX0f1wW+zqjmJfFM99Ta6Fvjh90AU5ff4s39IZIOOETH7sCpMkyy6sln3P0iBm",
+	"F2hq3bN6rlipCqA4w2ArE2gu2pRsKKmBEsLil+58ffZAMHablxnFqbURQrFVeYMfQwXnF1FM7GAx+mBw",
+	"li32RhRNpbttg2PXIsMaTAHiqRHck2UAYwxNifkkyHVniWTAY1UjNdYHm1DgcfmUBGl28gPxvabDoSOi",
+	"2TDreC7UDh3GR7bx2eKxje+XHWHI5kBEU2UkuRk5yz1APPmew2NP0zqQt1+V7Qsm2JkNsIjf8OJAMxdH",
+	"kQ+fSh1sEZQnjOxbPB6UpJQln4SZUJys1MTHKFDHMXyl4SKRaXYQ95wUsmQVuiW9rwncqiJSQvzYTymH",
+	"9PeXQfop5Y8cLxomKApPsJv4GVWQNB2yQwWsDgq+uFIVyfF4GYBQRcxBJsWBU1dH8hwLJmkU7Le/MSgk",
+	"yVKbdIzNWWzpOZCCSlxAJbAt1W868XF26+0LjrG566tuT9NZwDG/bvFdcvZjB1pg3t22IE5Q82TJOxei",
+	"WCsIzLYY7pu9upaQKhYiBTuTOsFm8SEyfzZ8sLgLTKjdu5B8F6GoSuIV2Xmhfk2u5wyRFS+nRqsDNkTX",
+	"3Bh8wXCmqnQphe8f6KOKnlY4PsJdu7f4VnyzyW0STB57t5EZE+3Nn1D/iVBP7j4bgbqqLpVk1/i5TshY",
+	"IKTZNB8Z19CS8dLEesfcw2MhVuUGGJEaiKHkBxV2Llw+AGv162Yk+Di5qP98bYsscQSl1WqmqDvEP4Q7",
+	"iphy1c2gTTbdLkGWe3qXOMlv6+eNe387uHuj06yVKpbAtUWmxCpJjBM1RHR23A5kET85QggAYKko2LZl",
+	"ZSIfSywV0yPCV4hFdYUivfV2kGCngKj4gfSqnKwVtq6stmiUF14p7Ltlq9rAhMvHCGm+SfX5fIxe/MWs",
+	"CdXDeSrBOhAIlXwGPahZgFBVYi+wNi0q6KUDCZukHpH2NlwAz3fmS4eExLDsGFvZJd1m1leGXJl4sZ/V",
+	"rcRCV9CD5nVCUOW1ioULHdQZjXi1gqT2UnGt+YPnscC4eALB72oDZdiy8778IJYMLOdSrxOTV5J5wiLz",
+	"cbdSKMbLx4sS2+tOxUet7XiANeDpiHrAm8vhqVofJOjnV1EjB/EtmsTmn9ao+LJbUjq2nRATEhiOQu0s",
+	"F/ldvFQif12sLFV87x0zsdshFpULwi2k4ymM+bSwO6yv06QEC/ZRdqmBqKyfGm2EsdS3I8bSH27LsQ7M",
+	"ETPKBuNJQvvu5arUwhLHUoo9SCCmi+KkgY+K+5JS9KYIrZ3NLLUy3BAvw4fVDep4SQUgPTqPre0cdigw",
+	"kr+QKvmcJBvbmqpWinVhJJmxqMNFAn85tTsBENE2xEun5EVvEtsQX3uOw8/lOiSQEUJZesKnozs+Nlce",
+	"XfQ4z5MEa3P3rM78F627c42OfVt3PYSD/rWLUiK8VvTiGSIiV00orfZaof+H93Jbt+bqevd7lXxNM9i2",
+	"uW4EUy/5IwnAzXA2XwxJiGB/eKyW1OSyWi3G0Ua6ifOEsPoHexEUTeH6BibBwUU5WE1j7jORLcNrSojI",
+	"eUc4eqUVN17oAn6SJ9o3EcQGJNEFEaYQm0Ju2i4LNV0V5hBBB2RRBjm7VPtdSnOMqsbLxHiYCuqRVyb4",
+	"zxoJTmxM0qjtu1ojQDdOvjj1DvMTsrm/wF9yfm9jCk7iVYUHMf/wksqqQ6bXk+DWln/fdHe7dhHj7BOa",
+	"YtH0qLKGnkeglkzWl9npOqxLaIaw0pRREIFE7rCVbyrZIaUeeTwAxg6hIn5SMVB5k3QMlxloHG1I65UK",
+	"8trjLp3VSmEy9UJqUtXQpceGq0+IkkWhU8b6GFdCmP+d9VnI8mQgLrOENZ5ty5Qvb3iNGLics8/lukW9",
+	"9EFCmzTxWS74THRMe57RnA6BB71BZoB5QLjwybH9D5b6Rj/PqPJbuR6aAgqfJnAu/xxfK2Gbw6f1XgZq",
+	"7YCHtAwyS/Lt5vViI34iwem88MRHr/kk+gBCL/GcbJSmRn3k8mvbntYjTU+V3SL0ybarLZLvU3YT1LS2",
+	"RN/AWyrPKbANEDJzPDOhRLr6OS5QQyWKzjD0NDUwHrKLVbY97/pmBw1VJSrUP+ADr3aD9lZf8n0v6tKE",
+	"Ptt+TRnqsqXSGnzH81aDfhcB8Vqq/pFqL5J9Pm3RBilQjCOVXJL05e12kAYWIZVRdg94DwB8Ar34WrFL",
+	"6K8Gfiz6r7iBgrcfJ2Rs4SyAnhenjp/K9Cb+s2ZDQsAIZnmgD6CI9drnhxKCyqpkkjBrXaPQI1DOKs7G",
+	"BHIg3PvS6MwjVcQQwSCO2f8LpSjoA8AGynrWEZ9blscdi8xjNq8CP9ufhyAVdjXm75INoBl21q+bRCpg",
+	"dAzY5A4JtZ1nostVXZ1UGQMR5uqbqsnCEhWwSjuC5pPgtwsbiah+wEcFJUieIuVZMtlgTiFEC18K9OQl",
+	"ZTMU2q7jAQ9Z8ycfB9FuoQ+DVdUfOKYsrRrCnmwGO/RpyBRwHryKhxYyKG+KyUSLJ/YrC9SerWzdhiYC",
+	"apKh4+nINGF8Xhrf/vrSzrcyk0himqzprCshic+w2SSj4LS64DtfycTXoYchheQC6NC6jW/JUZfJbee+",
+	"DvlgzWKjNZ5tFa5wz4XZIY+YkrSDUYxI1bgBRtiEr8IGzyUFQAHDfv7YAKXQY0v+///J52r13CPIvX37",
+	"7//9uvhX7mnv2/d8tlr4ERrxP//7X3Gs/eM9/kw5sayrYebrf3526ZvvS6QnvMNmGhU4KLjhRQ2iC0V4",
+	"M+ItLbqKbd+2A7NKuf21EE4dzvF9tW8q224SuOXPHwLpxVLvBvKKnzyZtSu/dyh7lYXgs1iG4BQeBKYo",
+	"lzjzEIUx8RRrKWAvDJHQT7JAiYh449QD+DwRQpj2uILPOZzteFAzHEzha7yZQ4kvH4QtsTSRWTjAiHzg",
+	"MhTEd64VVed3u3FeB4YgByM8SgqT6alUcDlOhg6y7paRFCElDviYRWjjTDYT/ir8T1lVbulnwbO+vY8s",
+	"e7+KRDAgIKOzGmHyfQXXLZjsVY9EkQdYP+NWeQtuFdvgxt7lB/OcGISJIUzLQ2IoVHZLCsOJyt6W2JBw",
+	"ST+TKa/BBBmtcjhPbqepzcZOUBUjjBI7RMwko9bWYTMbQlqCyZM64jsmVx82nlwWAdl8cjXjhpOD6LlD",
+	"NUZ2CIoTkR1hkKdAa17yKEBpRCLCrpRzn5k2Dl8RoaIgmengv6gqHj3ArIJchP6yMWMILDqWCpxQ9Zio",
+	"PURU1GICqn8tU8EGONiBOHek3dVOegAFow0xGxSMBE9m2+WC/6ockNAxR+GVmmK3boDS9EHBaLMwJYsn",
+	"ijm/vRs0m8JfmGSQOqBny1tJKJybFC3NfuPENVTJ/R8WLs2OsF2QNP/iA0Kjg5WTAPvhUdChpkZpDghD",
+	"BsME2gs98hcvtO9gppSL9lSaT3whYuPV/s4bo0H4atmgYdLaUO3FTtekqQABTe6bi9R9E+7FbMaF3HDG",
+	"FvWJy7138ZLk4uJXr8w0kVAtxGrh/h/Ra+MhkwnhDWzT7HfFgPhUsn8fNDXi2DzCRiQx92S0KXaoNvIB",
+	"d/xy794A6zBIJmTXwDJ+GSRMKI+qOVhNCzB3pmBCPYBk8HBK/hZ7HYlB3fwsQnj4InjpryQau0ZzL8jD",
+	"MqYmBW6r2txbR9OswkFHHh2biejNViJ/aXyUxgEqLo6KVALH41dNHa3ZvTqo5gscZ6A2h8DTXMcLqufq",
+	"UMvn83nZ73uuGWPHIZDjlcg+hKIn7lxmofO37VhbiIA79IGPn0O5Jc31oUGR2Vj2uaZDiJW30oyPAhoC",
+	"m1mEk1vZya0S30uUK0YQJwYgy8/FkPiv0RTizRsYOh5M3IHlGMBa/33npFGplquaBfDIB6MkKSebsZFp",
+	"WnDzhsQ4Tvz/m/zPWqtJ8qF8y1rTrA8Zk41TqEGxM7jIoL4XM0G/c6GiOOU8cmj8NLzErQfNp2RXlood",
+	"FRSPPyYVOCpRMqGIg0odTLNHMVRzwQj+tIgeqfI8gXj2j+M2xFQlzsJC+tLKxDOoE0RTHVQOjZ3mzcEw",
+	"ntSGsKJZv6xrgMjwGUah3uKLC6cMTFX0fVP0UV1TIzWefZuYu5QicFwEMm0IHE/iyGszBIKib+m0CZLU",
+	"gVW02efFr7tssDia2Hg0QSS2d6W3yO9CHiTKJynCfSTHjOk4aTmz1b6WDRk4Evlj37MyXzNjSl3y9csX",
+	"lQuyF5Eg9hxvJNvNfpkWv0S+D1pFZL5+V/FnO8wpLjl8VfynzI8fvA9cEir3xZRaU67BTeYyj4VXweSB",
+	"+1zkQwBTHoTmDYEhogZ8Im3bwLIGWM0l49iErd31nFcECQuiJRqif4Xi2NjX0vRg+xZFOVlLnR9vgE3o",
+	"Ws5cWNIRZdKpKHuONTAaeXAkLpYVGvT4HMI/XPJMGafOd672kh1gExEXUGPMRBdecnrRzyuItZcBAfxT",
+	"HRgTiAWZQZQRz0wctJh+Dj2Rx5bJ7xX28qq1KnBR5mumtJffKwmf4Jij1Je9GbSsHLdGfxFJ3jljfQ/W",
+	"JpO4BST41oJe22xzo/jiLEDmb0Q/4GRdOXrmIpPGih5exTMSbvYZ4FAnoEjSjaMeF3MrZU4hvYOWdc5O",
+	"dRXTV3ZRA4oDoZjPJ5GEYNyXmP60Qcb9D47YX4CLvkwLX4BhxcVIUI4u9cYFI9OOgaIJYbynjizEH2rE",
+	"pLqai1ocrKa3xgmI7VAYxW3qcH+O5zDkv19zF5E2jvITbUGrgwgc1eVnBbR1F90W6oa1ExiBYS3Als2U",
+	"84XN32yhAIWjKsLrVPL5D12Hkx4MLNEnmcechLBhwSW4+TyeP/zn249vYbQRo2SHvC/fVX7cjy+eE6/7",
+	"s2JqMyJbOrAHGWpAboSrvAjvompqQxJN+oshX9Tq1+pP3G7rOnE2uw7fH1lJif2LBFVmtGsPTpHjq78Q",
+	"hsIA4ZADg46h6g/4F9EYfbCAy/AfOaao0MR/C/odKjs4e0GOTzXTmWGKmF0GDCn0BliULQsUOtfzMTRX",
+	"EfraIQKjr8Lwb8jzi7PtRjD4fGKiLj/134H45XzpQ9cJgneii5Q/dBHs0BPHx/+EF5zNvOawk9Mdcy6i",
+	"8VafdNAOIuUTJnNCoa2qfMsnFUwizRtBnq9kAgOMSChoSDQWg0FAYVy3Ce5OCsQrnwTtmwdYjhXhF1xy",
+	"cghBOrOyyRcs3DIzqKkiZyux9op/xLIhZv0n6qQrOyPJfOdqCaS7vEyxrJhJdXkhf+zj/Cewvg3NNGIZ",
+	"XfibPU0Ld+8S4Z9BBJEpmkwNcLh/lXxH4T+FBS/RiWpVNAcDzGTxHMSLAvxaqJq/5niLpktyjWAcM0Py",
+	"AMkBjgjZqre8xmQzMYJo0RHOUBsiDHMjD/BERCG/DbAS4IJYV9EKJpIgypydi6IDkXYzdaqyfAdYGuIl",
+	"f6VjFaTMgI3hUlsCgEMx4rInD29Axo8iQbxRCI3vkiLAATyxAfOLalolk4LWC6YRPNha7mFzHzOUiAo+",
+	"O3D/8DY+Gf+fx/h/JInDkloF1Xx4EBiGs6W+WErT49UCguhs0QEkzEv7IlSsT6DHx1PPh6vYv5Bil9Bf",
+	"0q5DLp98zzByIYu7AJdXDGUDvzwToekLC9g2zYlkAfWoWZHLQSuvpvhTNiAMdT+W+09kriLNB2W4EPE5",
+	"2WRW+LnA34/FLR2Y0mD6KU78JuLEl+/hfzaPfnzKF79Mvkji/6eQLjc1SsnQryJ3mXkvZ/58pb+eaW4l",
+	"j0Xf7pI1yk/mvkvYJf2SIWfdEvv00+FahJfGA1cNQQzAfL2rCMLx3zM/4jF3DQsLsy51EC6Fq0pDe59I",
+	"/Luxmt1dAVll/pHeoLh+vf8EN8GaB/XpQfjNKOoWaB20cYmx1rQjYlRoqKhdpP7Fo7g0YnHMDDrKcBzl",
+	"DXIpi7OEU94s8dWAMuJdjpJpjvyNLP4umjmLQk2yLska4yPzNUbbCm2PwiE47ITKi+8/TQR/pongl79N",
+	"0ZN9ByVHdnPf0075f5UUH3YdDLBsMa0SKPgn4eJoNL4EqaM5WKVysOqNPHZXtNORroNFnRdZxEnWA+Nz",
+	"IqKYGKMhQW7IABN+QVzv8QmM0U2ow5tk8/MyasH2HmrbRbYwUQrwLGyTIUqzyqJ3oSYC7jsRErG3T23m",
+	"n6zNbGdM5Dee1g6YiGdb6jN8G5B/n6zIFFKi6x/M8WofuogqtPFnqUaCZH35zv+LzB9BHnBypIuogWXB",
+	"4CVgkRLIiHrCezjiM6Z7EadiJ5k0qjkfG9XJg2TkTwnuT5LgPqWod0hRyhqnmr2KqWV1aceyoPkOSWn9",
+	"e/03c6A/Xl7KbvxUspUtLMYR5HyPpXgVO3cyG28Qs9IxpX+HofjfzKHSS1y7RSNGmFt8TOI/KyRxgMPP",
+	"FViruyDCRarNHZ+TA155cO74HotHiqRycM/sAF9NKNjjMLgt8KQVHtQhcxVC7C/Uk0WycG0MsGmJ3bFd",
+	"eHCApbM4BC/RnTXmKjaZOKNesL9IzFl3YsAfEYXp/EviLz8NoD/TQCK6S6bB9N2MJXGYviU7jyL6uwwn",
+	"y1N9WlD+xRaUJX7+5bv63yDIKd6aImwiuz2ZdPaUpUdzHewrlW2luSIVfNpZPu0sn6Lo7y+K/jLtfsEH",
+	"OVlJoeX3RS2e3eQEn76P4v0kgWFDyFgcPi0MG5/U89MGEJUZhM1M2qbJF9Obez5OtBLwwZoaLc1mFBLK",
+	"zM1w6Hicxu1p2ml0oOhkIBKGyBi5PGlI+tYjBftVg0bNsACySaSjIsAxFMiMPOHfhBbFKi3HrIii4gRq",
+	"03+twBSMAMKEh0orYGSDfGde3pMVTRDVL5XbgZP+AHQEjTCgvgdVBbVwbah3K0MLKncaxpwjgTg7ED5+",
+	"iLaY5sibd/xN8bIpjPxyug7vZ7pkZfhM/vgksj+ByMqy82Rt7RQ5KCziqkDdPe1azsBblcr2yNZcuQgH",
+	"WPoIz4Oq6urbrMhMYRVyDMQ+iYYRR6tiDfByzFKiLZFHS6pzfVzIkzrnTgZEtZ1PN94fatUjMtZJPZWg",
+	"UHU49pwVC1mLhmkYWwQPdwqKkjMk86tiaoT+5FGfxsNfxqO+fJf/l8poSJTEyt9jXPJK2NBhqgcqmNBA",
+	"YQUJhagsEqGBZgBiAFG4V71g0SKFWSdMabVkYS+Gg6lIUzQsn1DoSS7qifwB+Ueyq/lSUYNrBZnMLq95",
+	"i+tnbNmly2/l8+V/Sqdb8OSkTFv2suSLfZeMtuY15D952yeG/0HBYwFLTG1YXvvIUtiP4x/ZTnFjGyXR",
+	"GLOx/CY2dmzv861+vtVfKof+g/JL/3aStF59xmszXLfRjAPCtJzzuiWNWuznXYEw4Wk+JYpPbflvplJf",
+	"vi/+sUGRDuSF9W9zR0019DrroR0lKLCrjj0jptjIZ8DNvyDg5vev2qDKpryzZsPuTya/O1v6fDSfKuj7",
+	"5b3NX4W50Da66wY50f/YV/UTRMb8p8j4SQF+K5FR8LxYU4sIKY3Gtga+TQvZiJL1XFDy0w9zw9+Ive7y",
+	"qsRWPl3wf1odxe7WeHbtb4NnW/IAhWbvoP9xmPpJ+/91JRk9x4Jpc1/5WBEhLzSUlXqI0LMRIUozGmDe",
+	"aG5RI8pCeBJEX9naFAGVZ76x8FRobdXxhiyqQ7yb5Hc4GHZ5R3xjnwj+LyglJ2MKgWE4PqZkc3Uq3hQi",
+	"8oLkFJqaI0VNxrhPtkbv7tLWd0F0uZG6nOMT5f/wMMMlxAvVzBAacih2NruTLyUOKbeUg6I4Kfb/LkdK",
+	"/ISfmP6bSi9LJPnL9+j9bfA9dKDtTDm+x+P61JnAFVzf1TexhO3dpY2mSgXuLm3T4wcQ5WzCe5R7//RR",
+	"/BuSgrcUN36ZyXb5KW4VPLS0853U6zQv7qfym62Fqk9O8w/mNJsa0/5D3+n6HrfLW/+LbGCWOwiGyw/3",
+	"HU1oP8W7371F7PpXyO1JKa1VQ2hCL0i7SKNqB+O21q95O7qdMJKv+YmCf7guzW5ZSOkqR4jIUisagaFy",
+	"+rvRywX2bRshTaD3AUozm+YzIvBfHRHIydiX7+w/KTPnwOJRmIiE3gV3DYjHYVmLNhOyb32oue6umjh/",
+	"Ln2SugAXGxpVtRf7MhFJaFn1qXv/6wpyxUgcv0yKFy9vKx07vlVZCsV6+QH9BK6T/5dznT+NI3whUPiF",
+	"19tjpcXVsthzCpU0UF9/AMnvqo2kM7iKwdEI8E/r6r+HwgPTRhgR6gHqeLyul6D5HDsVWq5UY5T1vPYG",
+	"slE56yWhQ/VnNtxmVarC35CsRhxZnlBNCwkFuoXImFULCKSLjTWsuaA0RawG46aS2KE3BgyKpnDNU0ur",
+	"B6d6Z5vsNOLjz6DRz7Dxj5WK3s3AvnyX/7fRuSiYmYPhz2dmXbWlbbjaJ1P7ZGr/XKb299GQzZ8EFCKB",
+	"8rC3lpjQXdeGEJoiCN4Qhe7k25RdrPY0rat6WMuASGMMxY/aFFjI5FMPMGspJRpdIaw5nmyaBafIoEtT",
+	"8msK7UqjYAI1OBxCgw4wMDyHyFpHFqA8541dHMIagYaDTaJ5gI5F9WSR1Ca2ZKEhpMjeJISEFyYIGwIP",
+	"pdLI9iiniBdHOouvt0YLvlpUad4hAnSxgc9w6j9d691Rj2WF1iPMP6shbFg+L+/FB4iqlpqD4Vq54FN9",
+	"/Sd6m2K5sAyODzNfJArZBxIia0wo/6EhGULADM1jiAdYWtAtZyRyiZ2gJn+Wm6ktwIirjymyNETZ95Yz",
+	"GjHW4tOswgJW9FSDry7yIEmhKwZ4nFpb/NQD/xCK9w7FZ4X6pSJxn0rNp1LzseR0e/lwgyBP0Aj7bowM",
+	"TzQXeFR1ZbYBsrQZtAzHhrKqPyfdDtYd4HEZAL660ENQCL+iAigdwwFmKVPKV8qeUVSWl9J+cFgMcxTZ",
+	"ShdgTIB6ABMk9AHZX9eFmC9JnYCKU0CThfSGY7sWFIuIjYhjM92MPbU1tF+AZ+n5lmKrrWgeNJEHDaqK",
+	"c/eb6UutiQ0toxSnYgKRvkyLXyIdgdcWI79ieFfUovVZeaeGZB4ZJDKDCM4Kh3FkIkA0F4oUUrkQcaGB",
+	"hvKLvQHmNWAZAiHDt4CnIbU1MQHTjJDHltIGGcMx4SDDuvlATQFE8P/r88bx3gA/OD5X1cUiIl9uwC4M",
+	"I3OQkeVHwkg1BlPeGebKhbh5pDUcjLkaGOjkKsZcNqAwfd6Gh21Eg6/GGOBRvLom+iXcFuuRi9ipeEF4",
+	"hjDN2oRbOjAmCsHU4zH5w+K/9DsX6ZFuTG1rCeVSEs2YL3/8SIok+kSs9YjFIqSSMWvb4hpRxHpPfY0/",
+	"EEWj9NRklqQYAZC1XBG/LTVhF91WJPr2O03F15hVj3LWDVxm74MakBOsSQvGwigoKjBxM5i8yYVTS2CO",
+	"iYhrgfmik46cWuOltnkrNMcVDaIsZm+EOddxfYtDHQ01EJoKEd46zELQVNWbXnzozddSuyMBpm1FDx4l",
+	"4Zjvtk6J0/5cElXnBbuXAc/FjzHEQk6AJlkESFnOCGFtaDmzPU27wgZUqinCA7y4IwZwiRIi8soAWDMc",
+	"y2LvRKAMN4iupQkB/LcmBgp0P4MK/C7P94uEb9wTMxzPjLiITWggrmQNHU8DsY98L5MWSSLzMcmU/WCw",
+	"vWIqXrXHHzm7+iEVhg6EswM8GyNjrGFgy/r7wuahnj/jGCIFQaFWgEEhxDIAHuBVTMqyF29CjKKjEfvd",
+	"CnLsZoDIQWkwry4BvDMCigmA9S5EXCUDvwsGPqWXy0OSTSyDEc3UpBjUOWloB9XiQTJGdikQTSDXTMYw",
+	"HWHXpxw1qSf6LIgPSFZjRS7o2CEwaEQGtBnUNd1zZgR6vAulxvRfbpBDGGrUcSzCWBHgMaUUamOHUC6b",
+	"SeLJiB6RdTI4axSiwBLzzGoQU+hFaW6WdT8z5UvRZmMkG1jKAzKHkICikLQgNl0HYbogzOp5OCZMhdvv",
+	"lbfM1Wk+AM3r76K67/bJ/FrDydKjQph6DnGhQdOouAIPFt+E1Aj2fvar1WLy+1G1WQJJlcnwwuQRyUbm",
+	"CW+QAhNQoAGdvRJEmQOEQmwyEzR7ZD6BrJpLUPiL8MOqFn7CgqjZPqHR5rEQcccjIAzzVftbZQV3vKCh",
+	"rd276Mq/awb0qHhLTApsYr73rLSCm/xNR92j3PfsQdfxmFDIS3dKy0nY7+kBRDj3wRpkd7T+ATUX97TD",
+	"u1nc2LueS+Ti/1zn5d/6IJ9nkxgvf6t7dck5xQTOeQ6QosUbKyGx1+V5gL8019ctZLA5iKiHRB3BKOZa",
+	"666nsBcR4vNaSYxXISKfVpbzNvgKGFFYBFwwyW61LxFZq+K02BF3wT8Gm9/mojjXjJFbhxE2O0ZSEIlC",
+	"SV1flokTCFjOiPFx14MEYh7D4AzwCNKw9CvMwsA0PUhINiiihcK9XUO2AHVDA6xybln9LH6hco6IscWF",
+	"HheixSqyc7UNqDEWNMocYBNacMTbQPt0IffI5QzH4+aI2IbQCfIUxCF9gfxF1Ddm9KzLJ1JJxOsJ5gW/",
+	"nh1oJb/XJDKZ0hgtDWnQXGxawYMJY3ux2OT4G3hwxA6mda5zTYyoyCW64J9rhb18IkE4xlHtTLYyZ7bw",
+	"nBN4PYQaDj0bYZVeFfwk+goPsGDTYy4JgoBeDB0vK4/LT67PuaakPm4eSd68FNYog7oczhgFxxUM1MeA",
+	"BxRBk3FdZllhaKQJQC0gzgxDzC4TgFwYHZeb1C80v6zGrQxx70fc3gCrgXxTM0Qgc5U7eIg8WyC9yzxG",
+	"iAQq51pyJ+5mJ4InDvt+c2AS5H476/Unkv5KJF2ilwpLtyeYHEnfIVj+sXgeJfLSgRtD5QPDselAgv+i",
+	"GnxFhGa1GRT9G53hUEgVwu4lLNBMpBNlQhiLHmCWOh6OXM0Kdj8EBrIQlS8FUGFI/ZvY8pUEwQ54JqH3",
+	"y1mzC7w0urHrsxjlJatQ4GQIacm1QrG62e4ZO4+2sPgLIyjAEf3WlORD2HWC8nvB54wOhYuiuoCQUH/r",
+	"yJJKRB1g4btAHgvnDTwh2gRCV0b5LjbFHl4ofUEDIxVfNsCu51DeAJujMd8+BbYLmddOkt3wPtkWeSi4",
+	"Kt7q4E3IdQ28XRBL3FxKq1IKFTR2vk9l+SfoYIqBpnmf5hwDGxkL+/9IpDQs27AqtcI6G5ZYUFXqiFiQ",
+	"YoxPUqNTdidOL7nQbg1w2OjFjU/LhjAuEyyKJLNnysk9jC5LmBmXbUiHwIOe+Dh4UAJ0mmz6G3GBEGh4",
+	"UFQvBBF4RPe2WkY5mgEiD7/2ZSq47fI8xfyd0P7e9TrFdE1mRbQ/G1r802JSEwjAl+/yWtP34U5CWzFm",
+	"GXEbcvpVNa4cH9Ig35hw/v36FmC/+Fay7yK/oboYUUpc3BvgOhN/1Q0RFXQkc37CNDziQ1ihh5vspAuU",
+	"+IsE6RVcnxr5XnKl+PR4kn83afpDMWe7QBf1zlPULulALrAu3+36O73219/pT2Re+U/m9fvwFebQS+8S",
+	"XST1RShYPl9bJ0suV4x2PM2DQw+ScUSIkyRzxZsptDwWU8n1JLFn5cXhQtsMelCZvqijISai8mV5DLmU",
+	"Sdn4AeaBm0s2NRG9LWxgPOeTpahFvUSiuw2gatgA65DHrSPhJ2U5SYs400iAaVbz8QSziHvHU8Pl7AMM",
+	"PKhhR4RRIBnWk8pRKqC6y1MV8Nuq83cviI1gfF5MYApHMuNAlgeBOQ9A8flGP/SNcsCneaIrEcEpIqcX",
+	"rjr+NXXUGxXXrWZbDaIe4AiSLwy7g0zEvvIkA6Z53NAesx0vMphFJPMAR0O4hc14yQu0FAzNjYXAIg7T",
+	"1ZTJeE/TRLzQIOMCQmaOZ6qFRbw2IuIbFq0qghZ4xB4LXVWJ1rMx9BhBEPuKBC3J0NboZrmRUZs5vmWy",
+	"rSDb9YDBfrQijmRpWGceRVvYr8LBT5LwiRQY6jgWwqOsNnZmcAq9IPEFO3SAPUZ1bB4gwu4EMQuq6xDI",
+	"syA5jIAVmCzr100BTOxQQTvFLjTq+ewCBrjkmTzwfR7j0Bxgbq49unau2R+dYdR1K8ExyLABg4w2hsCE",
+	"XjZiASyXa1lhxZdYJcx1IdIv3Au64+PAPCfWmsD5egLYY5/vQv/4uu+STuQMnxLJT6B2jBcjPHSSNZho",
+	"WBZayIgyiCqmM8VajWiH+RLVo77a/a6l+9jHv/7OtytT5JCfCc3wG4+Ac4d6igKa76ym+DddiXgXyDS+",
+	"qCSXzUHAzaNG2BRqwiBBJvEVMH557uvQw5BCokqoKJFiKRPKdCDnQ4pJaKyyjfA7LXPxlYgp5jimGsKE",
+	"QmBqKshG5LdoAR8KpT0FjEg6YoKwXCDL9Sy+ihFlmGc8LBwo7hJzVsaUlKAjRQK8JHDEP3pkGg11N1t6",
+	"6cJ+XSVgqKDM1cPs/U1kmQDb+gKMmEC9br19oRX38hogBHpyo5j4NtekAgfpBt+fxqcJMFa5+lY9liKm",
+	"C2pnvd517vqq29N0xNN19wb4alEaR87DLhRREtobr5Kk3OfZULKN4WCKsA8Jj5VFVIpyEgn4gwo/oVVS",
+	"1QW2VTd2a9MFbEtBfFdv7w54VH6Xl5+jhApbXoMXEgkWV6i+SWWhTPw6cMwoi7gWxG4iIxCzBV7Fxuit",
+	"vGJ2gW11nl14BAlN8EHRFD9+/N8BAHfD9J1CFQIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
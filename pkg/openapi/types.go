@@ -24,7 +24,9 @@ const (
 // Defines values for AuthMethod.
 const (
 	ClientSecretBasic AuthMethod = "client_secret_basic"
+	ClientSecretJwt   AuthMethod = "client_secret_jwt"
 	ClientSecretPost  AuthMethod = "client_secret_post"
+	PrivateKeyJwt     AuthMethod = "private_key_jwt"
 	TlsClientAuth     AuthMethod = "tls_client_auth"
 )
 
@@ -50,11 +52,26 @@ const (
 	S256  CodeChallengeMethod = "S256"
 )
 
+// Defines values for DeviceApprovalRequestOptionsDecision.
+const (
+	Approve DeviceApprovalRequestOptionsDecision = "approve"
+	Deny    DeviceApprovalRequestOptionsDecision = "deny"
+)
+
 // Defines values for GrantType.
 const (
-	AuthorizationCode GrantType = "authorization_code"
-	ClientCredentials GrantType = "client_credentials"
-	RefreshToken      GrantType = "refresh_token"
+	AuthorizationCode                        GrantType = "authorization_code"
+	ClientCredentials                        GrantType = "client_credentials"
+	RefreshToken                             GrantType = "refresh_token"
+	UrnIetfParamsOauthGrantTypeDeviceCode    GrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	UrnIetfParamsOauthGrantTypeTokenExchange GrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+)
+
+// Defines values for IntrospectionTokenType.
+const (
+	Fed IntrospectionTokenType = "fed"
+	Sa  IntrospectionTokenType = "sa"
+	Svc IntrospectionTokenType = "svc"
 )
 
 // Defines values for Oauth2ProviderType.
@@ -62,6 +79,7 @@ const (
 	Github    Oauth2ProviderType = "github"
 	Google    Oauth2ProviderType = "google"
 	Microsoft Oauth2ProviderType = "microsoft"
+	Saml      Oauth2ProviderType = "saml"
 )
 
 // Defines values for OrganizationType.
@@ -190,7 +208,9 @@ type Allocations = []AllocationRead
 // AuthMethod Supported authentication methods.
 type AuthMethod string
 
-// AuthorizationRequestOptions aith2/OIDC authorization endpoint request.
+// AuthorizationRequestOptions aith2/OIDC authorization endpoint request.  The response type and redirect
+// URI are required unless a request URI from a pushed authorization request
+// is specified.
 type AuthorizationRequestOptions struct {
 	// AcrValues Requested content class reference values.
 	AcrValues *string `json:"acr_values"`
@@ -217,10 +237,13 @@ type AuthorizationRequestOptions struct {
 	Prompt *string `json:"prompt"`
 
 	// RedirectUri The registered callback address.
-	RedirectUri string `json:"redirect_uri"`
+	RedirectUri *string `json:"redirect_uri,omitempty"`
+
+	// RequestUri A request URI returned by a pushed authorization request.
+	RequestUri *string `json:"request_uri"`
 
 	// ResponseType Supported response types.
-	ResponseType ResponseType `json:"response_type"`
+	ResponseType *ResponseType `json:"response_type,omitempty"`
 
 	// Scope Authorization scope.
 	Scope *string `json:"scope"`
@@ -235,15 +258,159 @@ type AuthorizationRequestOptions struct {
 // Claim Supported claims.
 type Claim string
 
+// ClientInformation Oauth2 client information as defined by RFC 7591 and RFC 7592.
+type ClientInformation struct {
+	// BackchannelLogoutUri Where to send back-channel logout tokens.
+	BackchannelLogoutUri *string `json:"backchannel_logout_uri,omitempty"`
+
+	// ClientId The client ID.
+	ClientId string `json:"client_id"`
+
+	// ClientIdIssuedAt When the client was registered, in seconds since the epoch.
+	ClientIdIssuedAt *int `json:"client_id_issued_at,omitempty"`
+
+	// ClientName A human readable name for the client.
+	ClientName *string `json:"client_name,omitempty"`
+
+	// ClientSecret The client secret.  This is only returned on registration, it's also
+	// written to a Kubernetes secret of the same name as the client.
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// ClientSecretExpiresAt When the secret expires in seconds since the epoch, zero means never.
+	ClientSecretExpiresAt int `json:"client_secret_expires_at"`
+
+	// Jwks The client's JSON web key set, mutually exclusive with jwks_uri.
+	Jwks *map[string]interface{} `json:"jwks,omitempty"`
+
+	// JwksUri Where to fetch the client's JSON web key set from.
+	JwksUri *string `json:"jwks_uri,omitempty"`
+
+	// PostLogoutRedirectUris URIs the client may return to after logout.
+	PostLogoutRedirectUris *[]string `json:"post_logout_redirect_uris,omitempty"`
+
+	// RedirectUris Redirection URIs.
+	RedirectUris []string `json:"redirect_uris"`
+
+	// RegistrationAccessToken A token used to manage the client.
+	RegistrationAccessToken string `json:"registration_access_token"`
+
+	// RegistrationClientUri Where to manage the client.
+	RegistrationClientUri string `json:"registration_client_uri"`
+
+	// RequirePushedAuthorizationRequests Whether the client must use pushed authorization requests.
+	RequirePushedAuthorizationRequests *bool `json:"require_pushed_authorization_requests,omitempty"`
+
+	// TokenEndpointAuthMethod Supported authentication methods.
+	TokenEndpointAuthMethod AuthMethod `json:"token_endpoint_auth_method"`
+}
+
+// ClientMetadata Oauth2 client metadata as defined by RFC 7591.
+type ClientMetadata struct {
+	// BackchannelLogoutUri Where to send back-channel logout tokens.
+	BackchannelLogoutUri *string `json:"backchannel_logout_uri,omitempty"`
+
+	// ClientName A human readable name for the client.
+	ClientName *string `json:"client_name,omitempty"`
+
+	// Jwks The client's JSON web key set, mutually exclusive with jwks_uri.
+	Jwks *map[string]interface{} `json:"jwks,omitempty"`
+
+	// JwksUri Where to fetch the client's JSON web key set from.
+	JwksUri *string `json:"jwks_uri,omitempty"`
+
+	// PostLogoutRedirectUris URIs the client may return to after logout.
+	PostLogoutRedirectUris *[]string `json:"post_logout_redirect_uris,omitempty"`
+
+	// RedirectUris Redirection URIs, at least one must be specified, and the authorization
+	// request must use one of them exactly.
+	RedirectUris []string `json:"redirect_uris"`
+
+	// RequirePushedAuthorizationRequests Whether the client must use pushed authorization requests.
+	RequirePushedAuthorizationRequests *bool `json:"require_pushed_authorization_requests,omitempty"`
+
+	// TokenEndpointAuthMethod Supported authentication methods.
+	TokenEndpointAuthMethod *AuthMethod `json:"token_endpoint_auth_method,omitempty"`
+}
+
 // CodeChallengeMethod Supported code challenge methods.
 type CodeChallengeMethod string
 
+// DeviceApprovalRequestOptions Device consent form.
+type DeviceApprovalRequestOptions struct {
+	// Approval An opaque token identifying the device authorization and user.
+	Approval string `json:"approval"`
+
+	// Decision Whether the user approved the device.
+	Decision DeviceApprovalRequestOptionsDecision `json:"decision"`
+}
+
+// DeviceApprovalRequestOptionsDecision Whether the user approved the device.
+type DeviceApprovalRequestOptionsDecision string
+
+// DeviceAuthorization A device authorization response as defined by RFC 8628.
+type DeviceAuthorization struct {
+	// DeviceCode The device code the device uses to poll the token endpoint.
+	DeviceCode string `json:"device_code"`
+
+	// ExpiresIn How long the device and user codes are valid for in seconds.
+	ExpiresIn int `json:"expires_in"`
+
+	// Interval How long the device should wait between polls in seconds.
+	Interval int `json:"interval"`
+
+	// UserCode The code the user enters at the verification URI.
+	UserCode string `json:"user_code"`
+
+	// VerificationUri Where the user should go to approve the device.
+	VerificationUri string `json:"verification_uri"`
+
+	// VerificationUriComplete The verification URI with the user code included.
+	VerificationUriComplete string `json:"verification_uri_complete"`
+}
+
+// DeviceAuthorizationRequestOptions oauth2 device authorization endpoint.
+type DeviceAuthorizationRequestOptions struct {
+	// ClientId Client ID.
+	ClientId string `json:"client_id"`
+
+	// Scope A space separated list of scopes to request.
+	Scope *string `json:"scope"`
+}
+
+// DeviceRequestOptions Device verification form.
+type DeviceRequestOptions struct {
+	// UserCode The user code displayed on the device.
+	UserCode string `json:"user_code"`
+}
+
 // GrantType Supported grant type.
 type GrantType string
 
 // GroupIDs A list of group IDs.
 type GroupIDs = []string
 
+// GroupMappingDryRun An ID token to evaluate group mappings against.
+type GroupMappingDryRun struct {
+	// IdToken A raw ID token as returned by the provider.
+	IdToken string `json:"idToken"`
+}
+
+// GroupMappingResult The outcome of group mapping for a group.
+type GroupMappingResult struct {
+	// GroupID The group ID.
+	GroupID string `json:"groupID"`
+
+	// Matched Group mappings grant group membership based on ID token claims at login.  These only
+	// apply to organization scoped providers.
+	Matched Oauth2ProviderGroupMappings `json:"matched"`
+
+	// Member Whether the user would be a member of the group.
+	Member bool `json:"member"`
+}
+
+// GroupMappingResults A list of group mapping outcomes.
+type GroupMappingResults = []GroupMappingResult
+
 // GroupRead A group when read.
 type GroupRead struct {
 	Metadata externalRef0.OrganizationScopedResourceReadMetadata `json:"metadata"`
@@ -254,6 +421,9 @@ type GroupRead struct {
 
 // GroupSpec A group.
 type GroupSpec struct {
+	// ProviderGroups A list of strings.
+	ProviderGroups *StringList `json:"providerGroups,omitempty"`
+
 	// RoleIDs A list of strings.
 	RoleIDs StringList `json:"roleIDs"`
 
@@ -276,6 +446,25 @@ type GroupWrite struct {
 // Groups A list of groups.
 type Groups = []GroupRead
 
+// IntrospectRequestOptions oauth2 token introspection endpoint.
+type IntrospectRequestOptions struct {
+	// ClientId Client ID. Required if not using HTTP basic or mTLS authentication.
+	ClientId *string `json:"client_id"`
+
+	// ClientSecret Client secret. Required if not using HTTP basic or mTLS authentication.
+	ClientSecret *string `json:"client_secret"`
+
+	// Token The access token to introspect.
+	Token string `json:"token"`
+
+	// TokenTypeHint A hint as to the type of token, only "access_token" is supported.
+	TokenTypeHint *string `json:"token_type_hint"`
+}
+
+// IntrospectionTokenType The type of access token, "fed" for federated users, "sa" for service
+// accounts and "svc" for services acting on behalf of users.
+type IntrospectionTokenType string
+
 // JsonWebKey JSON web key. See the relevant JWKS documentation for further details.
 type JsonWebKey = map[string]interface{}
 
@@ -297,6 +486,48 @@ type LoginRequestOptions struct {
 	State string `json:"state"`
 }
 
+// LogoutRequestOptions OIDC RP-initiated logout form.
+type LogoutRequestOptions struct {
+	// ClientId The client ID, required if the ID token hint is not specified.
+	ClientId *string `json:"client_id"`
+
+	// IdTokenHint An ID token previously issued to the client, used to identify the user session.
+	IdTokenHint *string `json:"id_token_hint"`
+
+	// PostLogoutRedirectUri Where to redirect to after logout, must be registered with the client.
+	PostLogoutRedirectUri *string `json:"post_logout_redirect_uri"`
+
+	// State Opaque client state passed back to the post logout redirect URI.
+	State *string `json:"state"`
+}
+
+// Oauth2ClientSecret An oauth2 client secret.
+type Oauth2ClientSecret struct {
+	// PreviousSecretsExpiry When previous secrets will no longer be valid.
+	PreviousSecretsExpiry *time.Time `json:"previousSecretsExpiry,omitempty"`
+
+	// Secret The current client secret.
+	Secret string `json:"secret"`
+}
+
+// Oauth2ProviderGroupMapping Maps an ID token claim value to a group.
+type Oauth2ProviderGroupMapping struct {
+	// Claim The path to the claim in the ID token, nested claims are separated by periods
+	// e.g. "realm_access.roles".
+	Claim string `json:"claim"`
+
+	// GroupID The group users are added to when the claim matches, and removed from when none
+	// of the group's mappings match.
+	GroupID string `json:"groupID"`
+
+	// Value The value must equal the claim, or be a member of it when the claim is a list.
+	Value string `json:"value"`
+}
+
+// Oauth2ProviderGroupMappings Group mappings grant group membership based on ID token claims at login.  These only
+// apply to organization scoped providers.
+type Oauth2ProviderGroupMappings = []Oauth2ProviderGroupMapping
+
 // Oauth2ProviderRead An OAuth2 provider when read.
 type Oauth2ProviderRead struct {
 	Metadata externalRef0.OrganizationScopedResourceReadMetadata `json:"metadata"`
@@ -315,6 +546,10 @@ type Oauth2ProviderSpec struct {
 	// that you are an admin for.
 	ClientSecret *string `json:"clientSecret,omitempty"`
 
+	// GroupMappings Group mappings grant group membership based on ID token claims at login.  These only
+	// apply to organization scoped providers.
+	GroupMappings *Oauth2ProviderGroupMappings `json:"groupMappings,omitempty"`
+
 	// Issuer The OIDC issuer, typically where to perform auto discovery relative to.
 	Issuer string `json:"issuer"`
 
@@ -372,30 +607,61 @@ type OpenidConfiguration struct {
 	// CodeChallengeMethodsSupported A list of code challenge methods supported.
 	CodeChallengeMethodsSupported []CodeChallengeMethod `json:"code_challenge_methods_supported"`
 
+	// DeviceAuthorizationEndpoint The oauth2 endpoint that initiates a device authorization grant.
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+
+	// DpopSigningAlgValuesSupported A list of signing algorithms supported for DPoP proofs.
+	DpopSigningAlgValuesSupported *[]string `json:"dpop_signing_alg_values_supported,omitempty"`
+
+	// EndSessionEndpoint The OIDC endpoint that a client uses to log the user out.
+	EndSessionEndpoint string `json:"end_session_endpoint"`
+
 	// GrantTypesSupported A list of supported grants for the token endpoint.
 	GrantTypesSupported []GrantType `json:"grant_types_supported"`
 
 	// IdTokenSigningAlgValuesSupported A list of signing algorithms supported for ID tokens.
 	IdTokenSigningAlgValuesSupported []SigningAlgorithm `json:"id_token_signing_alg_values_supported"`
 
+	// IntrospectionEndpoint The oauth2 endpoint that returns access token metadata.
+	IntrospectionEndpoint string `json:"introspection_endpoint"`
+
+	// IntrospectionEndpointAuthMethodsSupported A list of supported authentication methods for the introspection endpoint.
+	IntrospectionEndpointAuthMethodsSupported []AuthMethod `json:"introspection_endpoint_auth_methods_supported"`
+
 	// Issuer The OpenID Issuer (iss field).
 	Issuer string `json:"issuer"`
 
 	// JwksUri The oauth2 endpoint that exposes public signing keys for token validation.
 	JwksUri string `json:"jwks_uri"`
 
+	// PushedAuthorizationRequestEndpoint The oauth2 pushed authorization request endpoint.
+	PushedAuthorizationRequestEndpoint string `json:"pushed_authorization_request_endpoint"`
+
+	// RegistrationEndpoint The oauth2 dynamic client registration endpoint.
+	RegistrationEndpoint string `json:"registration_endpoint"`
+
 	// RequestParameterSupported Whether requests can be passed as a JWT object.
 	RequestParameterSupported bool `json:"request_parameter_supported"`
 
 	// RequestUriParameterSupported Whether requests can be passed via a URI.
 	RequestUriParameterSupported bool `json:"request_uri_parameter_supported"`
 
+	// RequirePushedAuthorizationRequests Whether all clients must use pushed authorization requests, individual
+	// clients may still require them.
+	RequirePushedAuthorizationRequests bool `json:"require_pushed_authorization_requests"`
+
 	// ResponseModesSupported A list of supported response modes.
 	ResponseModesSupported []ResponseMode `json:"response_modes_supported"`
 
 	// ResponseTypesSupported A list of supported response types that can be requested for the authorization endpoint.
 	ResponseTypesSupported []ResponseType `json:"response_types_supported"`
 
+	// RevocationEndpoint The oauth2 endpoint that revokes access and refresh tokens.
+	RevocationEndpoint string `json:"revocation_endpoint"`
+
+	// RevocationEndpointAuthMethodsSupported A list of supported authentication methods for the revocation endpoint.
+	RevocationEndpointAuthMethodsSupported []AuthMethod `json:"revocation_endpoint_auth_methods_supported"`
+
 	// ScopesSupported A list of supported oauth2 scopes.
 	ScopesSupported []Scope `json:"scopes_supported"`
 
@@ -405,6 +671,9 @@ type OpenidConfiguration struct {
 	// TokenEndpointAuthMethodsSupported A list of supported authentication methods for the token endpoint.
 	TokenEndpointAuthMethodsSupported []AuthMethod `json:"token_endpoint_auth_methods_supported"`
 
+	// TokenEndpointAuthSigningAlgValuesSupported A list of signing algorithms supported for client assertions.
+	TokenEndpointAuthSigningAlgValuesSupported *[]string `json:"token_endpoint_auth_signing_alg_values_supported,omitempty"`
+
 	// UserinfoEndpoint The oidc endpoint used to get information about an access token's user.
 	UserinfoEndpoint string `json:"userinfo_endpoint"`
 }
@@ -423,11 +692,23 @@ type OrganizationSpec struct {
 	// Domain The email domain of the organization.
 	Domain *string `json:"domain,omitempty"`
 
+	// GithubOrganization When set this identifies the GitHub organization for the GitHub managed organization.
+	// Only members of the GitHub organization may login, and this enables the use of
+	// GitHub teams as a source of truth for RBAC.  Members of the organization must login
+	// via its email domain, so that membership of the GitHub organization can be checked,
+	// logins by any other route are denied.
+	GithubOrganization *string `json:"githubOrganization,omitempty"`
+
 	// GoogleCustomerID When set this identifies the customer ID for the google managed organization.
 	// This enables the access to, and use of, Google groups as a source of truth
 	// for RBAC.
 	GoogleCustomerID *string `json:"googleCustomerID,omitempty"`
 
+	// MicrosoftTenantID When set this identifies the tenant ID for the Microsoft Entra managed organization.
+	// This enables the access to, and use of, Entra security groups as a source of truth
+	// for RBAC.
+	MicrosoftTenantID *string `json:"microsoftTenantID,omitempty"`
+
 	// OrganizationType Describes the authntication menthod of the organization.  Adhoc authentication
 	// means that users are exclusively added via explicit group membership  And must
 	// use a 'sign-in via' option.  Domain authentication means that users may login
@@ -496,6 +777,15 @@ type Projects = []ProjectRead
 // e.g. Google/Microsoft, when organization, us an organization scoped provider.
 type ProviderScope string
 
+// PushedAuthorization A pushed authorization response as defined by RFC 9126.
+type PushedAuthorization struct {
+	// ExpiresIn How long the request URI is valid for in seconds.
+	ExpiresIn int `json:"expires_in"`
+
+	// RequestUri The request URI to pass to the authorization endpoint.
+	RequestUri string `json:"request_uri"`
+}
+
 // QuotaRead A single quota.
 type QuotaRead struct {
 	// Committed Tha amount of that resource always in use.
@@ -574,6 +864,55 @@ type ResponseMode string
 // ResponseType Supported response types.
 type ResponseType string
 
+// RevocationEvent A revocation.  Either all tokens for a subject are revoked, or a single
+// token is, in which case only its hash is published.
+type RevocationEvent struct {
+	// Subject All tokens for the subject are revoked.
+	Subject *string `json:"subject,omitempty"`
+
+	// Time When the revocation occurred.
+	Time time.Time `json:"time"`
+
+	// TokenHash The unpadded base64url encoded SHA-256 hash of the revoked token.
+	TokenHash *string `json:"tokenHash,omitempty"`
+}
+
+// RevocationEvents A list of revocations.
+type RevocationEvents = []RevocationEvent
+
+// Revocations Revocations since the requested time.
+type Revocations struct {
+	// Events A list of revocations.
+	Events RevocationEvents `json:"events"`
+
+	// Reset Revocations since the requested time are no longer available, so cached
+	// tokens must be discarded.  If start is set, only tokens cached before then
+	// need to be.
+	Reset bool `json:"reset"`
+
+	// Start The time the feed is complete from, set with reset.  Replicas only know
+	// about revocations since they started, or that they still remember.
+	Start *time.Time `json:"start,omitempty"`
+
+	// Time The time the feed is complete up to, pass this to the next request.
+	Time time.Time `json:"time"`
+}
+
+// RevokeRequestOptions oauth2 token revocation endpoint.
+type RevokeRequestOptions struct {
+	// ClientId Client ID. Required if not using HTTP basic authentication.
+	ClientId *string `json:"client_id"`
+
+	// ClientSecret Client secret. Required if not using HTTP basic authentication.
+	ClientSecret *string `json:"client_secret"`
+
+	// Token The access or refresh token to revoke.
+	Token string `json:"token"`
+
+	// TokenTypeHint A hint as to the type of token being revoked, either "access_token" or "refresh_token".
+	TokenTypeHint *string `json:"token_type_hint"`
+}
+
 // RoleRead A role.
 type RoleRead struct {
 	// Metadata Resource metadata valid for all reads.
@@ -583,6 +922,15 @@ type RoleRead struct {
 // Roles A list of roles.
 type Roles = []RoleRead
 
+// SamlResponseOptions SAML HTTP-POST binding response options.
+type SamlResponseOptions struct {
+	// RelayState The state string supplied with the authentication request.
+	RelayState string `json:"RelayState"`
+
+	// SAMLResponse The base64 encoded SAML response.
+	SAMLResponse string `json:"SAMLResponse"`
+}
+
 // Scope Supported scopes.
 type Scope string
 
@@ -635,6 +983,36 @@ type ServiceAccountWrite struct {
 // ServiceAccounts A list of service accounts.
 type ServiceAccounts = []ServiceAccountRead
 
+// Session A user's session with an oauth2 client.
+type Session struct {
+	// ClientID The oauth2 client the session is with.
+	ClientID string `json:"clientID"`
+
+	// CreationTime When the session was created.
+	CreationTime *time.Time `json:"creationTime,omitempty"`
+
+	// Current Whether this is the session the request was made with.
+	Current bool `json:"current"`
+
+	// Id The unique session ID.
+	Id string `json:"id"`
+
+	// IssueTime When tokens were last issued for the session.
+	IssueTime *time.Time `json:"issueTime,omitempty"`
+
+	// LastAuthenticationTime When the user last authenticated with the identity provider.
+	LastAuthenticationTime *time.Time `json:"lastAuthenticationTime,omitempty"`
+
+	// Provider The identity provider the user authenticated with.
+	Provider *string `json:"provider,omitempty"`
+
+	// SourceIP The IP address the user logged in from.
+	SourceIP *string `json:"sourceIP,omitempty"`
+}
+
+// Sessions A list of sessions.
+type Sessions = []Session
+
 // SigningAlgorithm Supported signing algorithms.
 type SigningAlgorithm string
 
@@ -652,6 +1030,9 @@ type Token struct {
 	// IdToken An OIDC ID token.
 	IdToken *string `json:"id_token,omitempty"`
 
+	// IssuedTokenType The type of token issued, only returned by the token-exchange grant type.
+	IssuedTokenType *string `json:"issued_token_type,omitempty"`
+
 	// RefreshToken The opaque refresh token.
 	RefreshToken *string `json:"refresh_token,omitempty"`
 
@@ -659,8 +1040,74 @@ type Token struct {
 	TokenType string `json:"token_type"`
 }
 
+// TokenActor The party acting on behalf of the subject for exchanged tokens, as defined by RFC 8693.
+type TokenActor struct {
+	// Sub The actor's subject.
+	Sub string `json:"sub"`
+}
+
+// TokenConfirmation Proof of possession information for a bound access token.
+type TokenConfirmation struct {
+	// Jkt The SHA256 JWK thumbprint of the DPoP proof key the token is bound to.
+	Jkt *string `json:"jkt,omitempty"`
+
+	// X5tS256 The SHA256 thumbprint of the X.509 certificate the token is bound to.
+	X5tS256 *string `json:"x5t#S256,omitempty"`
+}
+
+// TokenIntrospection Access token introspection data as defined by RFC 7662.
+type TokenIntrospection struct {
+	// Act The party acting on behalf of the subject for exchanged tokens, as defined by RFC 8693.
+	Act *TokenActor `json:"act,omitempty"`
+
+	// Active Whether the token is valid.  All other fields are omitted if not.
+	Active bool `json:"active"`
+
+	// Aud The audiences the token is intended for.
+	Aud *[]string `json:"aud,omitempty"`
+
+	// ClientId The client the token was issued to, for federated tokens only.
+	ClientId *string `json:"client_id,omitempty"`
+
+	// Cnf Proof of possession information for a bound access token.
+	Cnf *TokenConfirmation `json:"cnf,omitempty"`
+
+	// Exp When the token expires, in seconds since the epoch.
+	Exp *int `json:"exp,omitempty"`
+
+	// Iat When the token was issued, in seconds since the epoch.
+	Iat *int `json:"iat,omitempty"`
+
+	// Iss The access token's issuer.
+	Iss *string `json:"iss,omitempty"`
+
+	// OrganizationId The organization a service account belongs to, for service account tokens only.
+	OrganizationId *string `json:"organization_id,omitempty"`
+
+	// Scope A space separated list of scopes granted, for federated tokens only.
+	Scope *string `json:"scope,omitempty"`
+
+	// Sub The access token's subject.
+	Sub *string `json:"sub,omitempty"`
+
+	// TokenType The type of access token, "fed" for federated users, "sa" for service
+	// accounts and "svc" for services acting on behalf of users.
+	TokenType *IntrospectionTokenType `json:"token_type,omitempty"`
+}
+
 // TokenRequestOptions oauth2 token endpoint.
 type TokenRequestOptions struct {
+	// Audience The host name of the service the exchanged token is intended for.
+	Audience *string `json:"audience"`
+
+	// ClientAssertion A JWT signed by the client, either with its secret, or a key in its JWKS,
+	// used to authenticate the client.
+	ClientAssertion *string `json:"client_assertion"`
+
+	// ClientAssertionType The client assertion type, must be "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	// when using the "client_secret_jwt" or "private_key_jwt" authentication methods.
+	ClientAssertionType *string `json:"client_assertion_type"`
+
 	// ClientId Client ID. Required with the "code" grant type.
 	ClientId *string `json:"client_id"`
 
@@ -670,6 +1117,9 @@ type TokenRequestOptions struct {
 	// CodeVerifier Client code verifier. Required with the "code" grant type.
 	CodeVerifier *string `json:"code_verifier"`
 
+	// DeviceCode A device code for the device_code grant type.
+	DeviceCode *string `json:"device_code"`
+
 	// GrantType Supported grant type.  Must be either "code" or "password".
 	GrantType string `json:"grant_type"`
 
@@ -682,6 +1132,15 @@ type TokenRequestOptions struct {
 	// RefreshToken A refresh token for the refresh_token grant type.
 	RefreshToken *string `json:"refresh_token"`
 
+	// RequestedTokenType The type of token requested, if specified must be an access token.
+	RequestedTokenType *string `json:"requested_token_type"`
+
+	// SubjectToken The access token to exchange for the token-exchange grant type.
+	SubjectToken *string `json:"subject_token"`
+
+	// SubjectTokenType The type of the subject token, must be an access token.
+	SubjectTokenType *string `json:"subject_token_type"`
+
 	// Username Resource owner username. Required with the "password" grant type.
 	Username *string `json:"username"`
 }
@@ -794,6 +1253,9 @@ type Users = []UserRead
 // AllocationIDParameter defines model for allocationIDParameter.
 type AllocationIDParameter = string
 
+// ClientIDParameter defines model for clientIDParameter.
+type ClientIDParameter = string
+
 // GroupidParameter defines model for groupidParameter.
 type GroupidParameter = string
 
@@ -809,6 +1271,15 @@ type ProjectIDParameter = string
 // ServiceAccountIDParameter defines model for serviceAccountIDParameter.
 type ServiceAccountIDParameter = string
 
+// SessionIDParameter defines model for sessionIDParameter.
+type SessionIDParameter = string
+
+// SinceParameter defines model for sinceParameter.
+type SinceParameter = time.Time
+
+// UserCodeParameter defines model for userCodeParameter.
+type UserCodeParameter = string
+
 // UserEmailParameter defines model for userEmailParameter.
 type UserEmailParameter = string
 
@@ -824,16 +1295,31 @@ type AllocationResponse = AllocationRead
 // AllocationsResponse A list of allocations.
 type AllocationsResponse = Allocations
 
+// ClientInformationResponse Oauth2 client information as defined by RFC 7591 and RFC 7592.
+type ClientInformationResponse = ClientInformation
+
+// DeviceAuthorizationResponse A device authorization response as defined by RFC 8628.
+type DeviceAuthorizationResponse = DeviceAuthorization
+
+// GroupMappingResultsResponse A list of group mapping outcomes.
+type GroupMappingResultsResponse = GroupMappingResults
+
 // GroupResponse A group when read.
 type GroupResponse = GroupRead
 
 // GroupsResponse A list of groups.
 type GroupsResponse = Groups
 
+// IntrospectionResponse Access token introspection data as defined by RFC 7662.
+type IntrospectionResponse = TokenIntrospection
+
 // JwksResponse JSON web key set. This data type is defined by an external 3rd party standards
 // committee. Consult the relevant documentation for further details.
 type JwksResponse = JsonWebKeySet
 
+// Oauth2ClientSecretResponse An oauth2 client secret.
+type Oauth2ClientSecretResponse = Oauth2ClientSecret
+
 // Oauth2ProviderResponse An OAuth2 provider when read.
 type Oauth2ProviderResponse = Oauth2ProviderRead
 
@@ -855,9 +1341,15 @@ type ProjectResponse = ProjectRead
 // ProjectsResponse A list of projects.
 type ProjectsResponse = Projects
 
+// PushedAuthorizationResponse A pushed authorization response as defined by RFC 9126.
+type PushedAuthorizationResponse = PushedAuthorization
+
 // QuotasResponse A list of quotas.
 type QuotasResponse = QuotasRead
 
+// RevocationsResponse Revocations since the requested time.
+type RevocationsResponse = Revocations
+
 // RolesResponse A list of roles.
 type RolesResponse = Roles
 
@@ -870,6 +1362,9 @@ type ServiceAccountResponse = ServiceAccountRead
 // ServiceAccountsResponse A list of service accounts.
 type ServiceAccountsResponse = ServiceAccounts
 
+// SessionsResponse A list of sessions.
+type SessionsResponse = Sessions
+
 // SystemOauth2ProvidersResponse A list of oauth2 providers.
 type SystemOauth2ProvidersResponse = Oauth2Providers
 
@@ -888,12 +1383,18 @@ type UsersResponse = Users
 // AllocationRequest An allocation of resources.
 type AllocationRequest = AllocationWrite
 
+// ClientRegistrationRequest Oauth2 client metadata as defined by RFC 7591.
+type ClientRegistrationRequest = ClientMetadata
+
 // CreateGroupRequest A group when created or updated.
 type CreateGroupRequest = GroupWrite
 
 // CreateProjectRequest A project when created or updated.
 type CreateProjectRequest = ProjectWrite
 
+// GroupMappingDryRunRequest An ID token to evaluate group mappings against.
+type GroupMappingDryRunRequest = GroupMappingDryRun
+
 // Oauth2ProviderRequest An OAuth2 provider when created or updated.
 type Oauth2ProviderRequest = Oauth2ProviderWrite
 
@@ -921,6 +1422,19 @@ type GetApiV1OrganizationsParams struct {
 	Email *UserEmailParameter `form:"email,omitempty" json:"email,omitempty"`
 }
 
+// GetApiV1RevocationsParams defines parameters for GetApiV1Revocations.
+type GetApiV1RevocationsParams struct {
+	// Since Only return events at or after this time.  This should be the time
+	// returned by the previous request.
+	Since SinceParameter `form:"since" json:"since"`
+}
+
+// GetOauth2V2DeviceParams defines parameters for GetOauth2V2Device.
+type GetOauth2V2DeviceParams struct {
+	// UserCode A device authorization user code.
+	UserCode *UserCodeParameter `form:"user_code,omitempty" json:"user_code,omitempty"`
+}
+
 // PostApiV1OrganizationsJSONRequestBody defines body for PostApiV1Organizations for application/json ContentType.
 type PostApiV1OrganizationsJSONRequestBody = OrganizationWrite
 
@@ -939,6 +1453,9 @@ type PostApiV1OrganizationsOrganizationIDOauth2providersJSONRequestBody = Oauth2
 // PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDJSONRequestBody defines body for PutApiV1OrganizationsOrganizationIDOauth2providersProviderID for application/json ContentType.
 type PutApiV1OrganizationsOrganizationIDOauth2providersProviderIDJSONRequestBody = Oauth2ProviderWrite
 
+// PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrun for application/json ContentType.
+type PostApiV1OrganizationsOrganizationIDOauth2providersProviderIDGroupmappingsDryrunJSONRequestBody = GroupMappingDryRun
+
 // PostApiV1OrganizationsOrganizationIDProjectsJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDProjects for application/json ContentType.
 type PostApiV1OrganizationsOrganizationIDProjectsJSONRequestBody = ProjectWrite
 
@@ -969,14 +1486,44 @@ type PutApiV1OrganizationsOrganizationIDUsersUserIDJSONRequestBody = UserWrite
 // PostOauth2V2AuthorizationFormdataRequestBody defines body for PostOauth2V2Authorization for application/x-www-form-urlencoded ContentType.
 type PostOauth2V2AuthorizationFormdataRequestBody = AuthorizationRequestOptions
 
+// PostOauth2V2DeviceFormdataRequestBody defines body for PostOauth2V2Device for application/x-www-form-urlencoded ContentType.
+type PostOauth2V2DeviceFormdataRequestBody = DeviceRequestOptions
+
+// PostOauth2V2DeviceApproveFormdataRequestBody defines body for PostOauth2V2DeviceApprove for application/x-www-form-urlencoded ContentType.
+type PostOauth2V2DeviceApproveFormdataRequestBody = DeviceApprovalRequestOptions
+
+// PostOauth2V2DeviceAuthorizationFormdataRequestBody defines body for PostOauth2V2DeviceAuthorization for application/x-www-form-urlencoded ContentType.
+type PostOauth2V2DeviceAuthorizationFormdataRequestBody = DeviceAuthorizationRequestOptions
+
+// PostOauth2V2IntrospectFormdataRequestBody defines body for PostOauth2V2Introspect for application/x-www-form-urlencoded ContentType.
+type PostOauth2V2IntrospectFormdataRequestBody = IntrospectRequestOptions
+
 // PostOauth2V2LoginFormdataRequestBody defines body for PostOauth2V2Login for application/x-www-form-urlencoded ContentType.
 type PostOauth2V2LoginFormdataRequestBody = LoginRequestOptions
 
+// PostOauth2V2LogoutFormdataRequestBody defines body for PostOauth2V2Logout for application/x-www-form-urlencoded ContentType.
+type PostOauth2V2LogoutFormdataRequestBody = LogoutRequestOptions
+
 // PostOauth2V2OnboardFormdataRequestBody defines body for PostOauth2V2Onboard for application/x-www-form-urlencoded ContentType.
 type PostOauth2V2OnboardFormdataRequestBody = OnboardRequestOptions
 
+// PostOauth2V2ParFormdataRequestBody defines body for PostOauth2V2Par for application/x-www-form-urlencoded ContentType.
+type PostOauth2V2ParFormdataRequestBody = AuthorizationRequestOptions
+
+// PostOauth2V2RegisterJSONRequestBody defines body for PostOauth2V2Register for application/json ContentType.
+type PostOauth2V2RegisterJSONRequestBody = ClientMetadata
+
+// PutOauth2V2RegisterClientIDJSONRequestBody defines body for PutOauth2V2RegisterClientID for application/json ContentType.
+type PutOauth2V2RegisterClientIDJSONRequestBody = ClientMetadata
+
+// PostOauth2V2RevokeFormdataRequestBody defines body for PostOauth2V2Revoke for application/x-www-form-urlencoded ContentType.
+type PostOauth2V2RevokeFormdataRequestBody = RevokeRequestOptions
+
 // PostOauth2V2TokenFormdataRequestBody defines body for PostOauth2V2Token for application/x-www-form-urlencoded ContentType.
 type PostOauth2V2TokenFormdataRequestBody = TokenRequestOptions
 
 // PostOauth2V2UserinfoFormdataRequestBody defines body for PostOauth2V2Userinfo for application/x-www-form-urlencoded ContentType.
 type PostOauth2V2UserinfoFormdataRequestBody = UserinfoRequestOptions
+
+// PostSamlAcsFormdataRequestBody defines body for PostSamlAcs for application/x-www-form-urlencoded ContentType.
+type PostSamlAcsFormdataRequestBody = SamlResponseOptions
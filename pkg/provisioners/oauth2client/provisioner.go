@@ -18,13 +18,34 @@ package oauth2client
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	"github.com/unikorn-cloud/core/pkg/manager"
 	"github.com/unikorn-cloud/core/pkg/provisioners"
 	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	ErrSecretConflict = errors.New("secret conflict")
+)
+
+const (
+	// defaultSecretOverlap is how long a previous secret remains valid after
+	// rotation if not specified by the client.
+	defaultSecretOverlap = 24 * time.Hour
 )
 
 // Provisioner encapsulates control plane provisioning.
@@ -33,11 +54,17 @@ type Provisioner struct {
 
 	// oauth2client is the Kubernetes oauth2client we're provisioning.
 	oauth2client unikornv1.OAuth2Client
+
+	// reader reads secrets directly from the API, so we don't need to
+	// cache, and therefore be able to list, all secrets.
+	reader client.Reader
 }
 
 // New returns a new initialized provisioner object.
-func New(_ manager.ControllerOptions) provisioners.ManagerProvisioner {
-	return &Provisioner{}
+func New(_ manager.ControllerOptions, reader client.Reader) provisioners.ManagerProvisioner {
+	return &Provisioner{
+		reader: reader,
+	}
 }
 
 // Ensure the ManagerProvisioner interface is implemented.
@@ -47,22 +74,186 @@ func (p *Provisioner) Object() unikornv1core.ManagableResourceInterface {
 	return &p.oauth2client
 }
 
+// getSecret returns the Kubernetes secret the plain text client secret is written
+// to, or nil if it doesn't exist yet.  Secrets that aren't controlled by the client
+// are never touched, otherwise we'd overwrite, and eventually garbage collect,
+// someone else's.
+func (p *Provisioner) getSecret(ctx context.Context) (*corev1.Secret, error) {
+	resource := &corev1.Secret{}
+
+	if err := p.reader.Get(ctx, client.ObjectKey{Namespace: p.oauth2client.Namespace, Name: p.oauth2client.SecretName()}, resource); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	if !metav1.IsControlledBy(resource, &p.oauth2client) {
+		return nil, fmt.Errorf("%w: secret %s is not controlled by the client", ErrSecretConflict, resource.Name)
+	}
+
+	return resource, nil
+}
+
+// secretDelivered checks whether the current secret was written out, and may
+// therefore be in use by the client.
+func (p *Provisioner) secretDelivered(ctx context.Context, existing *corev1.Secret) (bool, error) {
+	if existing == nil || p.oauth2client.Status.SecretHash == "" {
+		return false, nil
+	}
+
+	ok, err := util.VerifySecret(ctx, p.oauth2client.Status.SecretHash, string(existing.Data[unikornv1.OAuth2ClientSecretKey]))
+	if err != nil && !errors.Is(err, util.ErrSecretFormat) {
+		return false, err
+	}
+
+	return ok, nil
+}
+
+// writeSecret writes the plain text client secret to a Kubernetes secret for
+// consumption by the client, this is owned by the client so it's cleaned up
+// on deletion.
+func (p *Provisioner) writeSecret(ctx context.Context, existing *corev1.Secret, secret string) error {
+	cli, err := coreclient.ProvisionerClientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	data := map[string][]byte{
+		unikornv1.OAuth2ClientIDKey:     []byte(p.oauth2client.Name),
+		unikornv1.OAuth2ClientSecretKey: []byte(secret),
+	}
+
+	if existing != nil {
+		existing.Data = data
+
+		return cli.Update(ctx, existing)
+	}
+
+	resource := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: p.oauth2client.Namespace,
+			Name:      p.oauth2client.SecretName(),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: unikornv1.SchemeGroupVersion.String(),
+					Kind:       "OAuth2Client",
+					Name:       p.oauth2client.Name,
+					UID:        p.oauth2client.UID,
+					Controller: ptr.To(true),
+				},
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+
+	return cli.Create(ctx, resource)
+}
+
+// updateStatus persists the status immediately, rather than waiting for the
+// manager to do so after provisioning.
+func (p *Provisioner) updateStatus(ctx context.Context) error {
+	cli, err := coreclient.ProvisionerClientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return cli.Status().Update(ctx, &p.oauth2client)
+}
+
 // Provision implements the Provision interface.
+//
+//nolint:cyclop
 func (p *Provisioner) Provision(ctx context.Context) error {
-	// TODO: things like Entra will expire secrets, we may want to consider
-	// this in the long term for security.
 	// TODO: We _could_ cryptographically sign this rather than it being a
 	// PSK.
-	if p.oauth2client.Status.Secret == "" {
-		secret := make([]byte, 32)
+	status := &p.oauth2client.Status
+
+	now := time.Now()
+
+	// Migrate legacy plain text secrets, these are written out as if they were
+	// freshly generated so existing clients continue to work.
+	if status.Secret != "" && status.SecretHash == "" {
+		existing, err := p.getSecret(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := p.writeSecret(ctx, existing, status.Secret); err != nil {
+			return err
+		}
+
+		hash, err := util.HashSecret(status.Secret)
+		if err != nil {
+			return err
+		}
+
+		status.SecretHash = hash
+	}
+
+	status.Secret = ""
+
+	// Secrets are rotated when the generation is bumped, the previous secret
+	// remains valid for the overlap period so clients can be updated without
+	// downtime.  The new hash is persisted before the secret is written out, so
+	// whatever a client reads is always accepted.  The generation is only
+	// updated once the secret is written, so any failure along the way just
+	// results in another rotation.  Only secrets that were actually written
+	// out are retained, so repeated failures don't accumulate hashes.
+	if status.SecretHash == "" || status.SecretGeneration < p.oauth2client.Spec.SecretGeneration {
+		existing, err := p.getSecret(ctx)
+		if err != nil {
+			return err
+		}
 
-		if _, err := rand.Read(secret); err != nil {
+		delivered, err := p.secretDelivered(ctx, existing)
+		if err != nil {
 			return err
 		}
 
-		p.oauth2client.Status.Secret = base64.RawURLEncoding.EncodeToString(secret)
+		secret, err := util.GenerateSecret()
+		if err != nil {
+			return err
+		}
+
+		hash, err := util.HashSecret(secret)
+		if err != nil {
+			return err
+		}
+
+		if delivered {
+			overlap := defaultSecretOverlap
+
+			if p.oauth2client.Spec.SecretOverlap != nil {
+				overlap = p.oauth2client.Spec.SecretOverlap.Duration
+			}
+
+			status.PreviousSecrets = append(status.PreviousSecrets, unikornv1.OAuth2ClientSecret{
+				Hash:   status.SecretHash,
+				Expiry: metav1.NewTime(now.Add(overlap)),
+			})
+		}
+
+		status.SecretHash = hash
+
+		if err := p.updateStatus(ctx); err != nil {
+			return err
+		}
+
+		if err := p.writeSecret(ctx, existing, secret); err != nil {
+			return err
+		}
+
+		status.SecretGeneration = p.oauth2client.Spec.SecretGeneration
 	}
 
+	// Prune any expired secrets.
+	status.PreviousSecrets = slices.DeleteFunc(status.PreviousSecrets, func(secret unikornv1.OAuth2ClientSecret) bool {
+		return !now.Before(secret.Expiry.Time)
+	})
+
 	return nil
 }
 
@@ -0,0 +1,198 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2client_test
+
+import (
+	"context"
+	goerrors "errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/identity/pkg/provisioners/oauth2client"
+	"github.com/unikorn-cloud/identity/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var errInjected = goerrors.New("injected error")
+
+func getScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(s))
+	require.NoError(t, unikornv1.AddToScheme(s))
+
+	return s
+}
+
+func newOAuth2Client() *unikornv1.OAuth2Client {
+	return &unikornv1.OAuth2Client{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "client",
+			UID:       uuid.NewUUID(),
+		},
+	}
+}
+
+// TestProvisionSecretRotation checks the secret a client can read is always
+// accepted, even when rotation fails part way through.
+func TestProvisionSecretRotation(t *testing.T) {
+	t.Parallel()
+
+	resource := newOAuth2Client()
+
+	fail := false
+
+	funcs := interceptor.Funcs{
+		Create: func(ctx context.Context, cli client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if _, ok := obj.(*corev1.Secret); ok && fail {
+				return errInjected
+			}
+
+			return cli.Create(ctx, obj, opts...)
+		},
+		Update: func(ctx context.Context, cli client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if _, ok := obj.(*corev1.Secret); ok && fail {
+				return errInjected
+			}
+
+			return cli.Update(ctx, obj, opts...)
+		},
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(getScheme(t)).WithObjects(resource).WithStatusSubresource(resource).WithInterceptorFuncs(funcs).Build()
+
+	ctx := coreclient.NewContextWithProvisionerClient(context.Background(), cli)
+
+	// provision reconciles the client as the manager would, persisting the
+	// status regardless of the outcome, unless that update is lost.
+	provision := func(persist bool) error {
+		provisioner := oauth2client.New(nil, cli)
+
+		object, ok := provisioner.Object().(*unikornv1.OAuth2Client)
+		require.True(t, ok)
+
+		require.NoError(t, cli.Get(ctx, client.ObjectKeyFromObject(resource), object))
+
+		err := provisioner.Provision(ctx)
+
+		if persist {
+			require.NoError(t, cli.Status().Update(ctx, object))
+		}
+
+		return err
+	}
+
+	// accepted checks the secret a client would read is accepted.
+	accepted := func() *unikornv1.OAuth2Client {
+		object := &unikornv1.OAuth2Client{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKeyFromObject(resource), object))
+
+		secret := &corev1.Secret{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: object.Namespace, Name: object.SecretName()}, secret))
+
+		require.True(t, slices.ContainsFunc(object.SecretHashes(), func(hash string) bool {
+			ok, err := util.VerifySecret(ctx, hash, string(secret.Data[unikornv1.OAuth2ClientSecretKey]))
+
+			return err == nil && ok
+		}))
+
+		return object
+	}
+
+	require.NoError(t, provision(true))
+
+	initial := accepted()
+
+	// Failing to write the secret leaves the old one in place, and it must
+	// still be accepted, but the rotation is retried.
+	initial.Spec.SecretGeneration = 1
+	require.NoError(t, cli.Update(ctx, initial))
+
+	fail = true
+
+	// Repeated failures don't accumulate hashes of secrets that were never
+	// written out.
+	for range 3 {
+		require.ErrorIs(t, provision(true), errInjected)
+
+		failed := accepted()
+		require.Equal(t, int64(0), failed.Status.SecretGeneration)
+		require.Len(t, failed.Status.PreviousSecrets, 1)
+	}
+
+	fail = false
+
+	// Losing the final status update must not leave an unknown secret.
+	require.NoError(t, provision(false))
+	require.Equal(t, int64(0), accepted().Status.SecretGeneration)
+
+	require.NoError(t, provision(true))
+
+	rotated := accepted()
+	require.Equal(t, int64(1), rotated.Status.SecretGeneration)
+	require.NotEqual(t, initial.Status.SecretHash, rotated.Status.SecretHash)
+}
+
+// TestProvisionSecretConflict checks secrets not controlled by the client are
+// left alone.
+func TestProvisionSecretConflict(t *testing.T) {
+	t.Parallel()
+
+	resource := newOAuth2Client()
+
+	unrelated := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resource.Namespace,
+			Name:      resource.SecretName(),
+		},
+		Data: map[string][]byte{
+			"password": []byte("hunter2"),
+		},
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(getScheme(t)).WithObjects(resource, unrelated).WithStatusSubresource(resource).Build()
+
+	ctx := coreclient.NewContextWithProvisionerClient(context.Background(), cli)
+
+	provisioner := oauth2client.New(nil, cli)
+
+	object, ok := provisioner.Object().(*unikornv1.OAuth2Client)
+	require.True(t, ok)
+
+	require.NoError(t, cli.Get(ctx, client.ObjectKeyFromObject(resource), object))
+	require.ErrorIs(t, provisioner.Provision(ctx), oauth2client.ErrSecretConflict)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, cli.Get(ctx, client.ObjectKeyFromObject(unrelated), secret))
+	require.Equal(t, unrelated.Data, secret.Data)
+	require.Empty(t, secret.OwnerReferences)
+}
@@ -67,6 +67,14 @@ func New(client client.Client, namespace string, options *Options) *RBAC {
 	}
 }
 
+// IsSystemAccount checks whether the X.509 Common Name belongs to a registered
+// system account.
+func (r *RBAC) IsSystemAccount(name string) bool {
+	_, ok := r.options.SystemAccountRoleIDs[name]
+
+	return ok
+}
+
 func (r *RBAC) GetUser(ctx context.Context, subject string) (*unikornv1.User, error) {
 	result := &unikornv1.UserList{}
 
@@ -321,10 +329,85 @@ func (r *RBAC) accumulatePermissions(groups map[string]*unikornv1.Group, roles m
 	return nil
 }
 
+// intersectEndpoints returns the endpoints and operations common to both lists.
+func intersectEndpoints(a, b openapi.AclEndpoints) openapi.AclEndpoints {
+	var out openapi.AclEndpoints
+
+	for _, endpoint := range a {
+		indexFunc := func(ep openapi.AclEndpoint) bool {
+			return ep.Name == endpoint.Name
+		}
+
+		index := slices.IndexFunc(b, indexFunc)
+		if index < 0 {
+			continue
+		}
+
+		operations := slices.DeleteFunc(slices.Clone(endpoint.Operations), func(operation openapi.AclOperation) bool {
+			return !slices.Contains(b[index].Operations, operation)
+		})
+
+		if len(operations) != 0 {
+			out = append(out, openapi.AclEndpoint{
+				Name:       endpoint.Name,
+				Operations: operations,
+			})
+		}
+	}
+
+	return out
+}
+
+// intersectACL limits the subject's permissions to those of the actor.  Actors are
+// always system accounts, so only have global permissions, and these apply to every
+// scope.
+func intersectACL(subject, actor *openapi.Acl) *openapi.Acl {
+	var actorACL openapi.AclEndpoints
+
+	if actor.Global != nil {
+		actorACL = *actor.Global
+	}
+
+	acl := &openapi.Acl{}
+
+	if subject.Global != nil {
+		if endpoints := intersectEndpoints(*subject.Global, actorACL); len(endpoints) != 0 {
+			acl.Global = &endpoints
+		}
+	}
+
+	if subject.Organization != nil {
+		if endpoints := intersectEndpoints(subject.Organization.Endpoints, actorACL); len(endpoints) != 0 {
+			acl.Organization = &openapi.AclScopedEndpoints{
+				Id:        subject.Organization.Id,
+				Endpoints: endpoints,
+			}
+		}
+	}
+
+	if subject.Projects != nil {
+		var projectACLs openapi.AclScopedEndpointsList
+
+		for _, project := range *subject.Projects {
+			if endpoints := intersectEndpoints(project.Endpoints, actorACL); len(endpoints) != 0 {
+				projectACLs = append(projectACLs, openapi.AclScopedEndpoints{
+					Id:        project.Id,
+					Endpoints: endpoints,
+				})
+			}
+		}
+
+		if len(projectACLs) != 0 {
+			acl.Projects = &projectACLs
+		}
+	}
+
+	return acl
+}
+
 // GetACL returns a granular set of permissions for a user based on their scope.
-// This is used for API level access control and UX.
-//
-//nolint:cyclop,gocognit
+// This is used for API level access control and UX.  Delegated tokens are granted
+// the intersection of the subject's and the actor's permissions.
 func (r *RBAC) GetACL(ctx context.Context, organizationID string) (*openapi.Acl, error) {
 	// All the tokens introspecition info is in the context...
 	info, err := authorization.FromContext(ctx)
@@ -332,6 +415,34 @@ func (r *RBAC) GetACL(ctx context.Context, organizationID string) (*openapi.Acl,
 		return nil, err
 	}
 
+	acl, err := r.getACL(ctx, info, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Actor == "" {
+		return acl, nil
+	}
+
+	actorInfo := &authorization.Info{
+		Userinfo: &openapi.Userinfo{
+			Sub: info.Actor,
+		},
+		SystemAccount: true,
+	}
+
+	actorACL, err := r.getACL(ctx, actorInfo, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return intersectACL(acl, actorACL), nil
+}
+
+// getACL returns the permissions for the subject described by the introspection info.
+//
+//nolint:cyclop,gocognit
+func (r *RBAC) getACL(ctx context.Context, info *authorization.Info, organizationID string) (*openapi.Acl, error) {
 	roles, err := r.getRoles(ctx)
 	if err != nil {
 		return nil, err
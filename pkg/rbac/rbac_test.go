@@ -0,0 +1,108 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/identity/pkg/openapi"
+)
+
+func endpoint(name string, operations ...openapi.AclOperation) openapi.AclEndpoint {
+	return openapi.AclEndpoint{
+		Name:       name,
+		Operations: operations,
+	}
+}
+
+// TestIntersectACL checks delegated tokens are only granted permissions held by
+// both the subject and the actor, in every scope.
+func TestIntersectACL(t *testing.T) {
+	t.Parallel()
+
+	subject := &openapi.Acl{
+		Global: &openapi.AclEndpoints{
+			endpoint("identity:organizations", openapi.Read, openapi.Update),
+			endpoint("identity:users", openapi.Read),
+		},
+		Organization: &openapi.AclScopedEndpoints{
+			Id: "org",
+			Endpoints: openapi.AclEndpoints{
+				endpoint("compute:clusters", openapi.Create, openapi.Read, openapi.Delete),
+				endpoint("identity:groups", openapi.Read),
+			},
+		},
+		Projects: &openapi.AclScopedEndpointsList{
+			{
+				Id: "project-a",
+				Endpoints: openapi.AclEndpoints{
+					endpoint("compute:clusters", openapi.Read),
+				},
+			},
+			{
+				Id: "project-b",
+				Endpoints: openapi.AclEndpoints{
+					endpoint("identity:groups", openapi.Read),
+				},
+			},
+		},
+	}
+
+	actor := &openapi.Acl{
+		Global: &openapi.AclEndpoints{
+			endpoint("identity:organizations", openapi.Read),
+			endpoint("compute:clusters", openapi.Read, openapi.Create),
+			endpoint("region:regions", openapi.Read),
+		},
+	}
+
+	acl := intersectACL(subject, actor)
+
+	// Update is only held by the subject, and region:regions only by the actor.
+	require.NotNil(t, acl.Global)
+	require.Equal(t, openapi.AclEndpoints{endpoint("identity:organizations", openapi.Read)}, *acl.Global)
+
+	// Delete is only held by the subject.
+	require.NotNil(t, acl.Organization)
+	require.Equal(t, "org", acl.Organization.Id)
+	require.Equal(t, openapi.AclEndpoints{endpoint("compute:clusters", openapi.Create, openapi.Read)}, acl.Organization.Endpoints)
+
+	// Projects with no common permissions are removed entirely.
+	require.NotNil(t, acl.Projects)
+	require.Equal(t, openapi.AclScopedEndpointsList{
+		{
+			Id:        "project-a",
+			Endpoints: openapi.AclEndpoints{endpoint("compute:clusters", openapi.Read)},
+		},
+	}, *acl.Projects)
+}
+
+// TestIntersectACLNoActorPermissions checks an actor without permissions grants
+// nothing.
+func TestIntersectACLNoActorPermissions(t *testing.T) {
+	t.Parallel()
+
+	subject := &openapi.Acl{
+		Global: &openapi.AclEndpoints{
+			endpoint("identity:organizations", openapi.Read),
+		},
+	}
+
+	require.Equal(t, &openapi.Acl{}, intersectACL(subject, &openapi.Acl{}))
+}
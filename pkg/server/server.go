@@ -26,11 +26,13 @@ import (
 	"github.com/spf13/pflag"
 	"go.opentelemetry.io/otel/sdk/trace"
 
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	"github.com/unikorn-cloud/core/pkg/manager/otel"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	"github.com/unikorn-cloud/core/pkg/server/middleware/cors"
 	"github.com/unikorn-cloud/core/pkg/server/middleware/opentelemetry"
 	"github.com/unikorn-cloud/core/pkg/server/middleware/timeout"
+	unikornv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/identity/pkg/constants"
 	"github.com/unikorn-cloud/identity/pkg/handler"
 	"github.com/unikorn-cloud/identity/pkg/jose"
@@ -38,11 +40,14 @@ import (
 	openapimiddleware "github.com/unikorn-cloud/identity/pkg/middleware/openapi"
 	"github.com/unikorn-cloud/identity/pkg/middleware/openapi/local"
 	"github.com/unikorn-cloud/identity/pkg/oauth2"
+	"github.com/unikorn-cloud/identity/pkg/oauth2/sessions"
 	"github.com/unikorn-cloud/identity/pkg/openapi"
 	"github.com/unikorn-cloud/identity/pkg/rbac"
 
+	"k8s.io/client-go/rest"
 	klog "k8s.io/klog/v2"
 
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -97,7 +102,48 @@ func (s *Server) SetupOpenTelemetry(ctx context.Context) error {
 	return s.OTelOptions.Setup(ctx, trace.WithSpanProcessor(&opentelemetry.LoggingSpanProcessor{}))
 }
 
-func (s *Server) GetServer(client client.Client) (*http.Server, error) {
+// GetClient returns a caching client, along with the cache backing it, so informers
+// can be shared rather than duplicated.  The cache runs until the context is cancelled.
+func (s *Server) GetClient(ctx context.Context, config *rest.Config) (client.Client, cache.Cache, error) {
+	scheme, err := coreclient.NewScheme(unikornv1.AddToScheme)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	informers, err := cache.New(config, cache.Options{Scheme: scheme})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	go func() {
+		if err := informers.Start(ctx); err != nil {
+			log.Log.Error(err, "cache failed")
+		}
+	}()
+
+	clientOptions := client.Options{
+		Scheme: scheme,
+		Cache: &client.CacheOptions{
+			Reader:       informers,
+			Unstructured: true,
+		},
+	}
+
+	c, err := client.NewWithWatch(config, clientOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c, informers, nil
+}
+
+// getReader returns an uncached reader, for when the cache cannot be trusted to be
+// up to date.
+func (s *Server) getReader(config *rest.Config, c client.Client) (client.Reader, error) {
+	return client.New(config, client.Options{Scheme: c.Scheme()})
+}
+
+func (s *Server) GetServer(ctx context.Context, config *rest.Config, client client.Client, informers cache.Informers) (*http.Server, error) {
 	schema, err := coreapi.NewSchema(openapi.GetSwagger)
 	if err != nil {
 		return nil, err
@@ -113,12 +159,26 @@ func (s *Server) GetServer(client client.Client) (*http.Server, error) {
 
 	// Setup authn/authz
 	issuer := jose.NewJWTIssuer(client, s.Options.Namespace, &s.JoseOptions)
-	if err := issuer.Run(context.TODO(), &jose.InClusterCoordinationClientGetter{}); err != nil {
+	if err := issuer.Run(ctx, &jose.ConfigCoordinationClientGetter{Config: config}); err != nil {
+		return nil, err
+	}
+
+	reader, err := s.getReader(config, client)
+	if err != nil {
 		return nil, err
 	}
 
 	rbac := rbac.New(client, s.Options.Namespace, &s.RBACOptions)
-	oauth2 := oauth2.New(&s.OAuth2Options, s.Options.Namespace, client, issuer, rbac)
+	oauth2 := oauth2.New(&s.OAuth2Options, s.Options.Namespace, client, issuer, rbac, sessions.NewKubernetes(client, reader, s.Options.Namespace))
+
+	// Watch for changes made by other replicas that invalidate cached tokens.
+	if err := oauth2.WatchResources(ctx, informers); err != nil {
+		return nil, err
+	}
+
+	if err := oauth2.RunBackchannelLogout(ctx, informers); err != nil {
+		return nil, err
+	}
 
 	// Setup middleware.
 	authorizer := local.NewAuthorizer(oauth2, rbac)
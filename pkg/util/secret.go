@@ -0,0 +1,156 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+var (
+	ErrSecretHashFormat = errors.New("secret hash format error")
+
+	ErrSecretFormat = errors.New("secret format error")
+)
+
+const (
+	// Argon2id parameters as recommended by OWASP, these are encoded in the
+	// hash so can be changed without invalidating existing hashes.
+	argon2Time    = 2
+	argon2Memory  = 19 * 1024
+	argon2Threads = 1
+	argon2KeyLen  = 32
+	argon2SaltLen = 16
+
+	// secretLen is the number of random bytes in a generated secret.
+	secretLen = 32
+
+	// verifyMemoryBudget is how much memory, in KiB, may be used by concurrent
+	// verifications.  This must fit comfortably within the pod's memory limit
+	// alongside everything else, so is kept deliberately small.
+	verifyMemoryBudget = 40 * 1024
+)
+
+// verifySemaphore bounds the number of concurrent verifications, each of which
+// allocates argon2Memory KiB, so a flood of bogus credentials cannot exhaust
+// memory or starve other requests of CPU.
+//
+//nolint:gochecknoglobals
+var verifySemaphore = make(chan struct{}, verifyMemoryBudget/argon2Memory)
+
+// GenerateSecret creates a new random secret.
+func GenerateSecret() (string, error) {
+	secret := make([]byte, secretLen)
+
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// secretWellFormed cheaply checks the secret could have been generated by
+// GenerateSecret, so obvious garbage is rejected before any hashing.
+func secretWellFormed(secret string) bool {
+	if len(secret) == 0 || len(secret) > base64.RawURLEncoding.EncodedLen(secretLen) {
+		return false
+	}
+
+	_, err := base64.RawURLEncoding.DecodeString(secret)
+
+	return err == nil
+}
+
+// HashSecret returns an argon2id hash of the secret in PHC string format.
+func HashSecret(secret string) (string, error) {
+	salt := make([]byte, argon2SaltLen)
+
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(secret), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+
+	encoding := base64.RawStdEncoding
+
+	hash := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, argon2Memory, argon2Time, argon2Threads, encoding.EncodeToString(salt), encoding.EncodeToString(key))
+
+	return hash, nil
+}
+
+// VerifySecret checks the secret matches the hash in constant time.  Malformed
+// secrets are rejected up front, and the number of concurrent verifications is
+// limited, waiting for a slot until the context is cancelled.
+//
+//nolint:cyclop
+func VerifySecret(ctx context.Context, hash, secret string) (bool, error) {
+	if !secretWellFormed(secret) {
+		return false, ErrSecretFormat
+	}
+
+	// Expect "", "argon2id", "v=N", "m=N,t=N,p=N", salt, key.
+	fields := strings.Split(hash, "$")
+	if len(fields) != 6 || fields[1] != "argon2id" {
+		return false, fmt.Errorf("%w: unsupported algorithm", ErrSecretHashFormat)
+	}
+
+	var version int
+
+	if _, err := fmt.Sscanf(fields[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, fmt.Errorf("%w: unsupported version", ErrSecretHashFormat)
+	}
+
+	var memory, time uint32
+
+	var threads uint8
+
+	if _, err := fmt.Sscanf(fields[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, fmt.Errorf("%w: invalid parameters", ErrSecretHashFormat)
+	}
+
+	encoding := base64.RawStdEncoding
+
+	salt, err := encoding.DecodeString(fields[4])
+	if err != nil {
+		return false, fmt.Errorf("%w: invalid salt", ErrSecretHashFormat)
+	}
+
+	key, err := encoding.DecodeString(fields[5])
+	if err != nil {
+		return false, fmt.Errorf("%w: invalid key", ErrSecretHashFormat)
+	}
+
+	select {
+	case verifySemaphore <- struct{}{}:
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+
+	defer func() { <-verifySemaphore }()
+
+	//nolint:gosec
+	actual := argon2.IDKey([]byte(secret), salt, time, memory, threads, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(key, actual) == 1, nil
+}
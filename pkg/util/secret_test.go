@@ -0,0 +1,62 @@
+/*
+Copyright 2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/identity/pkg/util"
+)
+
+// TestVerifySecret checks generated secrets verify, and malformed secrets are
+// rejected without being hashed.
+func TestVerifySecret(t *testing.T) {
+	t.Parallel()
+
+	secret, err := util.GenerateSecret()
+	require.NoError(t, err)
+
+	hash, err := util.HashSecret(secret)
+	require.NoError(t, err)
+
+	ok, err := util.VerifySecret(context.Background(), hash, secret)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	other, err := util.GenerateSecret()
+	require.NoError(t, err)
+
+	ok, err = util.VerifySecret(context.Background(), hash, other)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = util.VerifySecret(context.Background(), hash, "")
+	require.ErrorIs(t, err, util.ErrSecretFormat)
+
+	_, err = util.VerifySecret(context.Background(), hash, secret+"a")
+	require.ErrorIs(t, err, util.ErrSecretFormat)
+
+	_, err = util.VerifySecret(context.Background(), hash, strings.Repeat("a", 1<<20))
+	require.ErrorIs(t, err, util.ErrSecretFormat)
+
+	_, err = util.VerifySecret(context.Background(), hash, "not/base64+url")
+	require.ErrorIs(t, err, util.ErrSecretFormat)
+}